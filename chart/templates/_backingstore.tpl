@@ -31,3 +31,12 @@
 {{- end -}}
 {{- end -}}
 
+
+{{/*
+  keep deployed etcd for backing store migration?
+*/}}
+{{- define "vcluster.etcd.deploy.migrate" -}}
+{{- if and .Values.controlPlane.backingStore.migration.enabled .Values.controlPlane.backingStore.migration.source.deployedEtcd -}}
+{{- true -}}
+{{- end -}}
+{{- end -}}
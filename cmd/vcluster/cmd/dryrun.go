@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/controllers"
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	"github.com/loft-sh/vcluster/pkg/dryrun"
+	"github.com/loft-sh/vcluster/pkg/pro"
+	"github.com/loft-sh/vcluster/pkg/setup"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
+	util "github.com/loft-sh/vcluster/pkg/util/context"
+	"github.com/loft-sh/vcluster/pkg/util/dryrunclient"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog/v2"
+)
+
+type DryRunOptions struct {
+	Config string
+
+	File string
+}
+
+func NewDryRunCommand() *cobra.Command {
+	options := &DryRunOptions{}
+	cmd := &cobra.Command{
+		Use:   "dry-run",
+		Short: "Print the host objects the syncers would write for the given virtual manifests",
+		Args:  cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) (err error) {
+			return ExecuteDryRun(cobraCmd.Context(), options, os.Stdout)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Config, "config", "/var/vcluster/config.yaml", "The path where to find the vCluster config to load")
+	cmd.Flags().StringVarP(&options.File, "file", "f", "-", "The virtual manifests to preview, use - for stdin")
+	return cmd
+}
+
+func ExecuteDryRun(ctx context.Context, options *DryRunOptions, out io.Writer) error {
+	manifests, err := readManifests(options.File)
+	if err != nil {
+		return err
+	}
+
+	// parse vCluster config
+	vConfig, err := config.ParseConfig(options.Config, os.Getenv("VCLUSTER_NAME"), nil)
+	if err != nil {
+		return err
+	}
+
+	vConfig.DryRun = true
+	vConfig.ControlPlaneConfig, vConfig.ControlPlaneNamespace, vConfig.ControlPlaneService, vConfig.WorkloadConfig, vConfig.WorkloadNamespace, vConfig.WorkloadService, err = pro.GetRemoteClient(vConfig)
+	if err != nil {
+		return err
+	}
+
+	err = setup.InitAndValidateConfig(ctx, vConfig)
+	if err != nil {
+		return err
+	}
+
+	// build a controller context that only records writes
+	recorder := dryrunclient.NewRecorder()
+	controllerCtx, err := setup.NewDryRunControllerContext(ctx, vConfig, recorder)
+	if err != nil {
+		return fmt.Errorf("create controller context: %w", err)
+	}
+
+	syncers, err := controllers.Create(controllerCtx)
+	if err != nil {
+		return fmt.Errorf("instantiate controllers: %w", err)
+	}
+	exporters, err := generic.NewExporters(controllerCtx)
+	if err != nil {
+		return fmt.Errorf("instantiate exporters: %w", err)
+	}
+	for _, exporter := range exporters {
+		syncers = append(syncers, exporter)
+	}
+
+	err = controllers.RegisterIndices(controllerCtx, syncers)
+	if err != nil {
+		return fmt.Errorf("register indices: %w", err)
+	}
+
+	// start the caches, no controllers are registered so nothing is reconciled
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	controllerCtx.Context = ctx
+	go func() {
+		if err := controllerCtx.LocalManager.Start(ctx); err != nil {
+			klog.Errorf("Error starting local manager: %v", err)
+		}
+	}()
+	go func() {
+		if err := controllerCtx.VirtualManager.Start(ctx); err != nil {
+			klog.Errorf("Error starting virtual manager: %v", err)
+		}
+	}()
+	if !controllerCtx.LocalManager.GetCache().WaitForCacheSync(ctx) || !controllerCtx.VirtualManager.GetCache().WaitForCacheSync(ctx) {
+		return errors.New("wait for caches to sync")
+	}
+
+	return previewManifests(controllerCtx, recorder, syncers, manifests, out)
+}
+
+func previewManifests(controllerCtx *config.ControllerContext, recorder *dryrunclient.Recorder, syncers []syncertypes.Object, manifests []*unstructured.Unstructured, out io.Writer) error {
+	registerCtx := util.ToRegisterContext(controllerCtx)
+
+	failed := 0
+	for _, manifest := range manifests {
+		result, err := dryrun.Preview(registerCtx, recorder, syncers, manifest)
+		if err != nil {
+			_, _ = fmt.Fprintf(out, "# %s %s: error: %v\n", manifest.GetKind(), manifest.GetName(), err)
+			failed++
+			continue
+		}
+
+		err = result.Print(out)
+		if err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d manifests could not be previewed", failed, len(manifests))
+	}
+
+	return nil
+}
+
+func readManifests(path string) ([]*unstructured.Unstructured, error) {
+	var in io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		in = file
+	}
+
+	manifests := []*unstructured.Unstructured{}
+	decoder := yaml.NewYAMLOrJSONDecoder(in, 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		err := decoder.Decode(&obj.Object)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("decode manifests: %w", err)
+		} else if len(obj.Object) == 0 {
+			continue
+		}
+
+		if obj.IsList() {
+			err = obj.EachListItem(func(item runtime.Object) error {
+				manifests = append(manifests, item.(*unstructured.Unstructured))
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		manifests = append(manifests, obj)
+	}
+
+	return manifests, nil
+}
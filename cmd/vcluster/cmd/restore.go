@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/loft-sh/vcluster/pkg/backingstore"
+	"github.com/loft-sh/vcluster/pkg/setup"
+	"github.com/loft-sh/vcluster/pkg/snapshot"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type RestoreOptions struct {
+	Config string
+
+	Input     string
+	SkipCerts bool
+}
+
+func NewRestoreCommand() *cobra.Command {
+	options := &RestoreOptions{}
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Stage a snapshot that replaces the backing store and certificates on the next start",
+		Args:  cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) (err error) {
+			return ExecuteRestore(cobraCmd.Context(), options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Config, "config", "/var/vcluster/config.yaml", "The path where to find the vCluster config to load")
+	cmd.Flags().StringVar(&options.Input, "input", "-", "The path to read the snapshot from, use - for stdin")
+	cmd.Flags().BoolVar(&options.SkipCerts, "skip-certs", false, "If enabled, the certificates secret will not be restored")
+	return cmd
+}
+
+func ExecuteRestore(ctx context.Context, options *RestoreOptions) error {
+	vConfig, certsSecretClient, err := loadSnapshotConfig(options.Config)
+	if err != nil {
+		return err
+	}
+
+	var in io.Reader = os.Stdin
+	if options.Input != "-" {
+		file, err := os.Open(options.Input)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		in = file
+	}
+
+	// connect to the backing store
+	etcdClient, closeClient, err := backingstore.NewClient(ctx, vConfig, backingstore.StoreFromConfig(vConfig), backingstore.ClientOptions{
+		KineSocket: snapshotKineSocket,
+		Running:    true,
+	})
+	if err != nil {
+		return fmt.Errorf("connect to backing store: %w", err)
+	}
+	defer closeClient()
+
+	// the api server is still running, so we only stage the snapshot here and
+	// apply it on the next start before the api server is started
+	archive, err := snapshot.Stage(ctx, etcdClient, in)
+	if err != nil {
+		return err
+	}
+
+	if !options.SkipCerts && len(archive.Certs) > 0 {
+		err = restoreCerts(ctx, certsSecretClient, vConfig.Name, vConfig.ControlPlaneNamespace, archive.Certs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return setup.SetRestorePending(ctx, certsSecretClient, vConfig.Name, vConfig.ControlPlaneNamespace, true)
+}
+
+// restoreCerts replaces the certificates secret, the certificates will be picked up on the next start
+func restoreCerts(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string, certs map[string][]byte) error {
+	secretName := name + "-certs"
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		_, err = kubeClient.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretName,
+				Namespace: namespace,
+			},
+			Data: certs,
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("create certs secret: %w", err)
+		}
+
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("get certs secret: %w", err)
+	}
+
+	secret.Data = certs
+	_, err = kubeClient.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("update certs secret: %w", err)
+	}
+
+	return nil
+}
@@ -57,5 +57,9 @@ func BuildRoot() *cobra.Command {
 	// add top level commands
 	rootCmd.AddCommand(NewStartCommand())
 	rootCmd.AddCommand(NewCpCommand())
+	rootCmd.AddCommand(NewSnapshotCommand())
+	rootCmd.AddCommand(NewRestoreCommand())
+	rootCmd.AddCommand(NewDryRunCommand())
+	rootCmd.AddCommand(NewSleepProxyCommand())
 	return rootCmd
 }
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/lifecycle"
+	"github.com/loft-sh/vcluster/pkg/util/clienthelper"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type SleepProxyOptions struct {
+	Name      string
+	Namespace string
+
+	ListenAddress   string
+	TargetPort      int
+	AfterInactivity string
+}
+
+func NewSleepProxyCommand() *cobra.Command {
+	options := &SleepProxyOptions{}
+	cmd := &cobra.Command{
+		Use:   "sleep-proxy",
+		Short: "Start a proxy that puts the vCluster to sleep when unused and wakes it up on new requests",
+		Args:  cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) (err error) {
+			return ExecuteSleepProxy(cobraCmd.Context(), options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Name, "name", os.Getenv("VCLUSTER_NAME"), "The name of the vCluster")
+	cmd.Flags().StringVar(&options.Namespace, "namespace", "", "The namespace of the vCluster, defaults to the current namespace")
+	cmd.Flags().StringVar(&options.ListenAddress, "listen-address", ":8443", "The address the proxy listens on")
+	cmd.Flags().IntVar(&options.TargetPort, "target-port", 8443, "The port of the vCluster pods to forward connections to")
+	cmd.Flags().StringVar(&options.AfterInactivity, "after-inactivity", "1h", "The duration without activity after which the vCluster is put to sleep")
+	return cmd
+}
+
+func ExecuteSleepProxy(ctx context.Context, options *SleepProxyOptions) error {
+	if options.Name == "" {
+		return fmt.Errorf("please specify the vCluster name via --name")
+	}
+
+	afterInactivity, err := time.ParseDuration(options.AfterInactivity)
+	if err != nil {
+		return fmt.Errorf("parse after inactivity: %w", err)
+	}
+
+	namespace := options.Namespace
+	if namespace == "" {
+		namespace, err = clienthelper.CurrentNamespace()
+		if err != nil {
+			return fmt.Errorf("get current namespace: %w", err)
+		}
+	}
+
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("get kube config: %w", err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("create kube client: %w", err)
+	}
+
+	return lifecycle.StartWakeProxy(ctx, kubeClient, lifecycle.WakeProxyOptions{
+		Name:            options.Name,
+		Namespace:       namespace,
+		ListenAddress:   options.ListenAddress,
+		TargetPort:      options.TargetPort,
+		AfterInactivity: afterInactivity,
+	}, log.GetInstance())
+}
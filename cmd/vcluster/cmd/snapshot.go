@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/loft-sh/vcluster/pkg/backingstore"
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/pro"
+	"github.com/loft-sh/vcluster/pkg/snapshot"
+	"github.com/spf13/cobra"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// snapshotKineSocket is used if a temporary kine is required to reach the backing store
+const snapshotKineSocket = "/tmp/vcluster-snapshot-kine.sock"
+
+type SnapshotOptions struct {
+	Config string
+
+	Output string
+}
+
+func NewSnapshotCommand() *cobra.Command {
+	options := &SnapshotOptions{}
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Write a snapshot of the backing store and certificates",
+		Args:  cobra.NoArgs,
+		RunE: func(cobraCmd *cobra.Command, _ []string) (err error) {
+			return ExecuteSnapshot(cobraCmd.Context(), options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Config, "config", "/var/vcluster/config.yaml", "The path where to find the vCluster config to load")
+	cmd.Flags().StringVar(&options.Output, "output", "-", "The path to write the snapshot to, use - for stdout")
+	return cmd
+}
+
+func ExecuteSnapshot(ctx context.Context, options *SnapshotOptions) error {
+	vConfig, certsSecretClient, err := loadSnapshotConfig(options.Config)
+	if err != nil {
+		return err
+	}
+
+	// get the certificates
+	certs := map[string][]byte{}
+	secret, err := certsSecretClient.CoreV1().Secrets(vConfig.ControlPlaneNamespace).Get(ctx, vConfig.Name+"-certs", metav1.GetOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("get certs secret: %w", err)
+	} else if err == nil {
+		certs = secret.Data
+	}
+
+	// connect to the backing store
+	etcdClient, closeClient, err := backingstore.NewClient(ctx, vConfig, backingstore.StoreFromConfig(vConfig), backingstore.ClientOptions{
+		KineSocket: snapshotKineSocket,
+		Running:    true,
+	})
+	if err != nil {
+		return fmt.Errorf("connect to backing store: %w", err)
+	}
+	defer closeClient()
+
+	var out io.Writer = os.Stdout
+	if options.Output != "-" {
+		file, err := os.Create(options.Output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		out = file
+	}
+
+	return snapshot.Save(ctx, etcdClient, &snapshot.Metadata{
+		Name:         vConfig.Name,
+		Distro:       vConfig.Distro(),
+		BackingStore: string(vConfig.BackingStoreType()),
+	}, certs, out)
+}
+
+func loadSnapshotConfig(path string) (*config.VirtualClusterConfig, kubernetes.Interface, error) {
+	vConfig, err := config.ParseConfig(path, os.Getenv("VCLUSTER_NAME"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vConfig.ControlPlaneConfig, vConfig.ControlPlaneNamespace, vConfig.ControlPlaneService, vConfig.WorkloadConfig, vConfig.WorkloadNamespace, vConfig.WorkloadService, err = pro.GetRemoteClient(vConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(vConfig.ControlPlaneConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vConfig, kubeClient, nil
+}
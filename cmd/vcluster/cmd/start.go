@@ -105,12 +105,27 @@ func ExecuteStart(ctx context.Context, options *StartOptions) error {
 		return fmt.Errorf("start integrations: %w", err)
 	}
 
+	// track activity for sleep mode
+	setup.StartActivityTracking(controllerCtx)
+
+	// reject writes if the vCluster is frozen
+	frozen, err := setup.StartFrozen(controllerCtx)
+	if err != nil {
+		return fmt.Errorf("start frozen: %w", err)
+	}
+
 	// start proxy
 	err = setup.StartProxy(controllerCtx)
 	if err != nil {
 		return fmt.Errorf("start proxy: %w", err)
 	}
 
+	// restart once the certificates were renewed
+	err = setup.WatchCertificates(controllerCtx)
+	if err != nil {
+		return fmt.Errorf("watch certificates: %w", err)
+	}
+
 	// should start embedded coredns?
 	if vConfig.ControlPlane.CoreDNS.Embedded {
 		// write vCluster kubeconfig to /data/vcluster/admin.conf
@@ -134,12 +149,14 @@ func ExecuteStart(ctx context.Context, options *StartOptions) error {
 		return fmt.Errorf("connect to platform: %w", err)
 	}
 
-	// start leader election + controllers
-	err = StartLeaderElection(controllerCtx, func() error {
-		return setup.StartControllers(controllerCtx)
-	})
-	if err != nil {
-		return fmt.Errorf("start controllers: %w", err)
+	// start leader election + controllers, a frozen vCluster doesn't sync
+	if !frozen {
+		err = StartLeaderElection(controllerCtx, func() error {
+			return setup.StartControllers(controllerCtx)
+		})
+		if err != nil {
+			return fmt.Errorf("start controllers: %w", err)
+		}
 	}
 
 	<-controllerCtx.StopChan
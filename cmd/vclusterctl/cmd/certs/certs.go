@@ -0,0 +1,25 @@
+package certs
+
+import (
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewCertsCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	certsCmd := &cobra.Command{
+		Use:   "certs",
+		Short: "vCluster certs subcommand",
+		Long: `#######################################################
+#################### vcluster certs ###################
+#######################################################
+Check and rotate the certificates of a virtual cluster
+control plane
+#######################################################
+		`,
+		Args: cobra.NoArgs,
+	}
+
+	certsCmd.AddCommand(NewCheckCmd(globalFlags))
+	certsCmd.AddCommand(NewRotateCmd(globalFlags))
+	return certsCmd
+}
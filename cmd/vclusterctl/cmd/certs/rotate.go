@@ -0,0 +1,65 @@
+package certs
+
+import (
+	"context"
+	"time"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/spf13/cobra"
+)
+
+// RotateCmd holds the cmd flags
+type RotateCmd struct {
+	*flags.GlobalFlags
+	cli.CertsRotateOptions
+
+	Log log.Logger
+}
+
+// NewRotateCmd creates a new command
+func NewRotateCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &RotateCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "rotate" + util.VClusterNameOnlyUseLine,
+		Short: "Renews the virtual cluster certificates",
+		Long: `#######################################################
+################ vcluster certs rotate ################
+#######################################################
+Renews all leaf certificates and kubeconfigs stored in
+the certs secret of a virtual cluster. With --ca the
+certificate authorities are rotated as well, the old
+certificate authorities are still trusted for the
+given overlap period. If controlPlane.advanced.certRotation
+is enabled, the virtual cluster restarts to pick up the
+new certificates, otherwise it needs to be restarted
+manually.
+
+Example:
+vcluster certs rotate test --namespace test
+vcluster certs rotate test --ca --ca-overlap 168h
+#######################################################
+	`,
+		Args:              util.VClusterNameOnlyValidator,
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().BoolVar(&cmd.CA, "ca", false, "If enabled, rotates the certificate authorities as well")
+	cobraCmd.Flags().DurationVar(&cmd.CAOverlap, "ca-overlap", 7*24*time.Hour, "The period in which the old certificate authorities are still trusted")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *RotateCmd) Run(ctx context.Context, args []string) error {
+	return cli.CertsRotateHelm(ctx, cmd.GlobalFlags, args[0], &cmd.CertsRotateOptions, cmd.Log)
+}
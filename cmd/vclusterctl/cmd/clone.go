@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/spf13/cobra"
+)
+
+// CloneCmd holds the cmd flags
+type CloneCmd struct {
+	*flags.GlobalFlags
+	cli.CloneOptions
+
+	Log log.Logger
+}
+
+// NewCloneCmd creates a new command
+func NewCloneCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &CloneCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	useLine, validator := util.NamedPositionalArgsValidator(true, true, "SOURCE_VCLUSTER_NAME", "TARGET_VCLUSTER_NAME")
+	cobraCmd := &cobra.Command{
+		Use:   "clone" + useLine,
+		Short: "Clones a virtual cluster",
+		Long: `#######################################################
+#################### vcluster clone ###################
+#######################################################
+Clone takes a snapshot of the backing store of a virtual
+cluster, installs the same chart version with the same
+values under a new name and restores the snapshot there.
+The clone gets its own certificates and syncs its objects
+to the host cluster under its own name, so both virtual
+clusters can run side by side. The source keeps running.
+Pods without a controller, persistent volume claims, the
+contents of persistent volumes and service account token
+secrets, which are signed by the key of the source, are
+not cloned.
+
+Example:
+vcluster clone test test-copy --namespace test
+vcluster clone test test-copy --namespace test --to-namespace other --exclude-namespace dev --exclude-secrets
+#######################################################
+	`,
+		Args:              validator,
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().StringVar(&cmd.ToNamespace, "to-namespace", "", "The namespace to clone the virtual cluster to. Defaults to the namespace of the source virtual cluster")
+	cobraCmd.Flags().StringVar(&cmd.ChartRepo, "chart-repo", constants.LoftChartRepo, "The virtual cluster chart repo to use")
+	cobraCmd.Flags().StringSliceVar(&cmd.ExcludeNamespaces, "exclude-namespace", []string{}, "Virtual cluster namespaces whose objects should not be cloned")
+	cobraCmd.Flags().BoolVar(&cmd.ExcludeSecrets, "exclude-secrets", false, "If enabled, secrets will not be cloned")
+	cobraCmd.Flags().BoolVar(&cmd.ExcludeWorkloads, "exclude-workloads", false, "If enabled, pods, deployments, statefulsets, daemonsets, jobs and cronjobs will not be cloned")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *CloneCmd) Run(ctx context.Context, args []string) error {
+	return cli.CloneHelm(ctx, cmd.GlobalFlags, args[0], args[1], &cmd.CloneOptions, cmd.Log)
+}
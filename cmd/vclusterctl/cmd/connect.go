@@ -45,6 +45,8 @@ vcluster connect test --namespace test
 # Open a new bash with the vcluster KUBECONFIG defined
 vcluster connect test -n test -- bash
 vcluster connect test -n test -- kubectl get ns
+# Use an OIDC id token instead of the default client cert / key
+vcluster connect test -n test --oidc
 #######################################################
 	`,
 		Args:              nameValidator,
@@ -97,6 +99,12 @@ func (cmd *ConnectCmd) validateFlags() error {
 	if cmd.ServiceAccountClusterRole != "" && cmd.ServiceAccount == "" {
 		return fmt.Errorf("expected --service-account to be defined as well")
 	}
+	if cmd.OIDC && cmd.ServiceAccount != "" {
+		return fmt.Errorf("--oidc and --service-account cannot be used together")
+	}
+	if !cmd.OIDC && (cmd.OIDCIssuerURL != "" || cmd.OIDCClientID != "" || cmd.OIDCClientSecret != "" || len(cmd.OIDCExtraScopes) > 0) {
+		return fmt.Errorf("expected --oidc to be defined as well")
+	}
 
 	return nil
 }
@@ -0,0 +1,68 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/spf13/cobra"
+)
+
+// BundleCmd holds the cmd flags
+type BundleCmd struct {
+	*flags.GlobalFlags
+	cli.DebugBundleOptions
+
+	Log log.Logger
+}
+
+// NewBundleCmd creates a new command
+func NewBundleCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &BundleCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "bundle" + util.VClusterNameOnlyUseLine,
+		Short: "Collects diagnostic information of a virtual cluster into an archive",
+		Long: `#######################################################
+################ vcluster debug bundle ################
+#######################################################
+Bundle collects the control plane logs including previous
+restarts, the config, the host objects synced by the
+virtual cluster, an inventory of the virtual cluster
+objects, events, nodes and versions into a single
+tar.gz archive.
+
+Secret and config map values, keys and environment
+variables that look like credentials, last applied
+configurations, private keys and bearer tokens are
+redacted. Additional rules can be added via --redact-key
+and --redact-value.
+
+Example:
+vcluster debug bundle test --namespace test
+vcluster debug bundle test --redact-key '(?i)license' --redact-value 'internal\.example\.com'
+#######################################################
+	`,
+		Args:              util.VClusterNameOnlyValidator,
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().StringVarP(&cmd.Output, "output", "o", "", "The file to write the bundle to. Defaults to VCLUSTER_NAME-TIMESTAMP.debug.tar.gz")
+	cobraCmd.Flags().StringArrayVar(&cmd.RedactKeys, "redact-key", []string{}, "Additional regular expression for keys whose values should be redacted in structured data")
+	cobraCmd.Flags().StringArrayVar(&cmd.RedactValues, "redact-value", []string{}, "Additional regular expression for values that should be redacted everywhere")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *BundleCmd) Run(ctx context.Context, args []string) error {
+	return cli.DebugBundleHelm(ctx, cmd.GlobalFlags, args[0], &cmd.DebugBundleOptions, cmd.Log)
+}
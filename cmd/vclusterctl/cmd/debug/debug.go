@@ -0,0 +1,23 @@
+package debug
+
+import (
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/spf13/cobra"
+)
+
+func NewDebugCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	debugCmd := &cobra.Command{
+		Use:   "debug",
+		Short: "vCluster debug subcommand",
+		Long: `#######################################################
+#################### vcluster debug ###################
+#######################################################
+Collect diagnostic information of a virtual cluster
+#######################################################
+		`,
+		Args: cobra.NoArgs,
+	}
+
+	debugCmd.AddCommand(NewBundleCmd(globalFlags))
+	return debugCmd
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/spf13/cobra"
+)
+
+// DescribeCmd holds the cmd flags
+type DescribeCmd struct {
+	*flags.GlobalFlags
+	cli.DescribeOptions
+
+	Log log.Logger
+}
+
+// NewDescribeCmd creates a new command
+func NewDescribeCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &DescribeCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "describe" + util.VClusterNameOnlyUseLine,
+		Short: "Describes a virtual cluster",
+		Long: `#######################################################
+################## vcluster describe ##################
+#######################################################
+Describe shows the effective configuration, distro and
+backing store, enabled syncers, plugin states, certificate
+expiry, deploy status, synced host resources and recent
+warning events of a virtual cluster.
+
+Example:
+vcluster describe test --namespace test
+vcluster describe test --namespace test --output yaml
+#######################################################
+	`,
+		Args:              util.VClusterNameOnlyValidator,
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().StringVarP(&cmd.Output, "output", "o", "table", "Choose the format of the output. [table|json|yaml]")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *DescribeCmd) Run(ctx context.Context, args []string) error {
+	return cli.DescribeHelm(ctx, cmd.GlobalFlags, args[0], &cmd.DescribeOptions, cmd.Log)
+}
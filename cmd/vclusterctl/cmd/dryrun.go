@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/spf13/cobra"
+)
+
+// DryRunCmd holds the cmd flags
+type DryRunCmd struct {
+	*flags.GlobalFlags
+	cli.DryRunOptions
+
+	Log log.Logger
+}
+
+// NewDryRunCmd creates a new command
+func NewDryRunCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &DryRunCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "dry-run" + util.VClusterNameOnlyUseLine,
+		Short: "Preview the host objects a virtual cluster would sync",
+		Long: `#######################################################
+################### vcluster dry-run ##################
+#######################################################
+Dry-run runs the enabled syncers of a virtual cluster
+against the given virtual manifests and prints the host
+objects that would be written. If a host object already
+exists, a diff against it is printed instead. Nothing is
+written to the host or virtual cluster.
+
+Example:
+vcluster dry-run test --namespace test -f pod.yaml
+cat deployment.yaml | vcluster dry-run test -f -
+#######################################################
+	`,
+		Args:              util.VClusterNameOnlyValidator,
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().StringSliceVarP(&cmd.Files, "file", "f", []string{}, "The virtual manifests to preview, use - for stdin")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *DryRunCmd) Run(ctx context.Context, args []string) error {
+	return cli.DryRunHelm(ctx, cmd.GlobalFlags, args[0], &cmd.DryRunOptions, cmd.Log)
+}
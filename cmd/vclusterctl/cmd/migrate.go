@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/spf13/cobra"
+)
+
+// MigrateCmd holds the cmd flags
+type MigrateCmd struct {
+	*flags.GlobalFlags
+	cli.MigrateOptions
+
+	Log log.Logger
+}
+
+// NewMigrateCmd creates a new command
+func NewMigrateCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &MigrateCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "migrate" + util.VClusterNameOnlyUseLine,
+		Short: "Migrates a virtual cluster to another host cluster",
+		Long: `#######################################################
+################### vcluster migrate ##################
+#######################################################
+Migrate stops the workloads of a virtual cluster, starts
+it read-only without syncing, takes a snapshot of the
+backing store and the certificates and pauses it. It then
+installs the same chart version with the same values into
+the target kube context and restores the snapshot there.
+Workloads are synced to the new host cluster afterwards.
+Pods without a controller and the contents of persistent
+volumes are not moved.
+
+Example:
+vcluster migrate test --namespace test --to-context other-cluster
+vcluster migrate test --namespace test --to-context other-cluster --to-namespace other --delete-source
+#######################################################
+	`,
+		Args:              util.VClusterNameOnlyValidator,
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().StringVar(&cmd.ToContext, "to-context", "", "The kube context of the host cluster to migrate the virtual cluster to")
+	cobraCmd.Flags().StringVar(&cmd.ToNamespace, "to-namespace", "", "The namespace to migrate the virtual cluster to. Defaults to the current namespace of the virtual cluster")
+	cobraCmd.Flags().StringVar(&cmd.ChartRepo, "chart-repo", constants.LoftChartRepo, "The virtual cluster chart repo to use")
+	cobraCmd.Flags().BoolVar(&cmd.DeleteSource, "delete-source", false, "If enabled, the source virtual cluster will be deleted after a successful migration")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *MigrateCmd) Run(ctx context.Context, args []string) error {
+	return cli.MigrateHelm(ctx, cmd.GlobalFlags, args[0], &cmd.MigrateOptions, cmd.Log)
+}
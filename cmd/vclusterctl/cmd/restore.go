@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/spf13/cobra"
+)
+
+// RestoreCmd holds the cmd flags
+type RestoreCmd struct {
+	*flags.GlobalFlags
+	cli.RestoreOptions
+
+	Log log.Logger
+}
+
+// NewRestoreCmd creates a new command
+func NewRestoreCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &RestoreCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "restore" + util.VClusterNameOnlyUseLine,
+		Short: "Restores a virtual cluster from a snapshot",
+		Long: `#######################################################
+################### vcluster restore ##################
+#######################################################
+Restore will replace the backing store contents and the
+certificates of an existing virtual cluster with the
+contents of a snapshot taken by vcluster snapshot. The
+snapshot is validated and staged first, then the virtual
+cluster is stopped and applies the snapshot on its next
+start before the api server is started.
+
+Example:
+vcluster restore test --namespace test --input test.snapshot.gz
+#######################################################
+	`,
+		Args:              util.VClusterNameOnlyValidator,
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().StringVarP(&cmd.Input, "input", "i", "", "The snapshot file to restore from")
+	cobraCmd.Flags().BoolVar(&cmd.SkipCerts, "skip-certs", false, "If enabled, the certificates of the virtual cluster will not be replaced")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *RestoreCmd) Run(ctx context.Context, args []string) error {
+	return cli.RestoreHelm(ctx, cmd.GlobalFlags, args[0], &cmd.RestoreOptions, cmd.Log)
+}
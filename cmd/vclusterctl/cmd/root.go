@@ -11,8 +11,10 @@ import (
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/loft-sh/log"
+	cmdcerts "github.com/loft-sh/vcluster/cmd/vclusterctl/cmd/certs"
 	"github.com/loft-sh/vcluster/cmd/vclusterctl/cmd/convert"
 	"github.com/loft-sh/vcluster/cmd/vclusterctl/cmd/credits"
+	cmddebug "github.com/loft-sh/vcluster/cmd/vclusterctl/cmd/debug"
 	cmdplatform "github.com/loft-sh/vcluster/cmd/vclusterctl/cmd/platform"
 	"github.com/loft-sh/vcluster/cmd/vclusterctl/cmd/platform/set"
 	cmdtelemetry "github.com/loft-sh/vcluster/cmd/vclusterctl/cmd/telemetry"
@@ -116,9 +118,18 @@ func BuildRoot(log log.Logger) (*cobra.Command, *flags.GlobalFlags, error) {
 	rootCmd.AddCommand(NewConnectCmd(globalFlags))
 	rootCmd.AddCommand(NewCreateCmd(globalFlags))
 	rootCmd.AddCommand(NewListCmd(globalFlags))
+	rootCmd.AddCommand(NewDescribeCmd(globalFlags))
 	rootCmd.AddCommand(NewDeleteCmd(globalFlags))
 	rootCmd.AddCommand(NewPauseCmd(globalFlags))
 	rootCmd.AddCommand(NewResumeCmd(globalFlags))
+	rootCmd.AddCommand(NewSnapshotCmd(globalFlags))
+	rootCmd.AddCommand(NewRestoreCmd(globalFlags))
+	rootCmd.AddCommand(NewMigrateCmd(globalFlags))
+	rootCmd.AddCommand(NewCloneCmd(globalFlags))
+	rootCmd.AddCommand(NewDryRunCmd(globalFlags))
+	rootCmd.AddCommand(NewUsageCmd(globalFlags))
+	rootCmd.AddCommand(cmdcerts.NewCertsCmd(globalFlags))
+	rootCmd.AddCommand(cmddebug.NewDebugCmd(globalFlags))
 	rootCmd.AddCommand(NewDisconnectCmd(globalFlags))
 	rootCmd.AddCommand(NewUpgradeCmd())
 	rootCmd.AddCommand(use.NewUseCmd(globalFlags))
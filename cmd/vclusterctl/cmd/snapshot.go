@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/cli/util"
+	"github.com/spf13/cobra"
+)
+
+// SnapshotCmd holds the cmd flags
+type SnapshotCmd struct {
+	*flags.GlobalFlags
+	cli.SnapshotOptions
+
+	Log log.Logger
+}
+
+// NewSnapshotCmd creates a new command
+func NewSnapshotCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &SnapshotCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "snapshot" + util.VClusterNameOnlyUseLine,
+		Short: "Snapshot a virtual cluster",
+		Long: `#######################################################
+################## vcluster snapshot ##################
+#######################################################
+Snapshot will stream the backing store (embedded database,
+external database or etcd) as well as the certificates
+of a virtual cluster into a compressed archive that can
+be restored via vcluster restore.
+
+Example:
+vcluster snapshot test --namespace test --output test.snapshot.gz
+#######################################################
+	`,
+		Args:              util.VClusterNameOnlyValidator,
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().StringVarP(&cmd.Output, "output", "o", "", "The file to write the snapshot to. Defaults to VCLUSTER_NAME-TIMESTAMP.snapshot.gz")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *SnapshotCmd) Run(ctx context.Context, args []string) error {
+	return cli.SnapshotHelm(ctx, cmd.GlobalFlags, args[0], &cmd.SnapshotOptions, cmd.Log)
+}
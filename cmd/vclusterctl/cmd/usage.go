@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli"
+	"github.com/loft-sh/vcluster/pkg/cli/completion"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/spf13/cobra"
+)
+
+// UsageCmd holds the cmd flags
+type UsageCmd struct {
+	*flags.GlobalFlags
+	cli.UsageOptions
+
+	Log log.Logger
+}
+
+// NewUsageCmd creates a new command
+func NewUsageCmd(globalFlags *flags.GlobalFlags) *cobra.Command {
+	cmd := &UsageCmd{
+		GlobalFlags: globalFlags,
+		Log:         log.GetInstance(),
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "usage [VCLUSTER_NAME]",
+		Short: "Shows the host resources consumed by virtual clusters",
+		Long: `#######################################################
+#################### vcluster usage ###################
+#######################################################
+Usage aggregates the requests, limits and actual usage
+of all pods synced by a virtual cluster as well as its
+persistent volume claims and load balancer services in
+the host cluster. If a virtual cluster name is given,
+the usage is grouped by virtual namespace, otherwise
+the total usage of all virtual clusters is shown.
+
+Example:
+vcluster usage
+vcluster usage test --namespace test
+vcluster usage --output json
+#######################################################
+	`,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: completion.NewValidVClusterNameFunc(globalFlags),
+		RunE: func(cobraCmd *cobra.Command, args []string) error {
+			return cmd.Run(cobraCmd.Context(), args)
+		},
+	}
+
+	cobraCmd.Flags().StringVar(&cmd.Output, "output", "table", "Choose the format of the output. [table|json]")
+	return cobraCmd
+}
+
+// Run executes the functionality
+func (cmd *UsageCmd) Run(ctx context.Context, args []string) error {
+	vClusterName := ""
+	if len(args) > 0 {
+		vClusterName = args[0]
+	}
+
+	return cli.UsageHelm(ctx, cmd.GlobalFlags, vClusterName, &cmd.UsageOptions, cmd.Log)
+}
@@ -126,6 +126,24 @@ type MetricsServer struct {
 
 	// Pods defines if metrics-server pods api should get proxied from host to virtual cluster.
 	Pods bool `json:"pods,omitempty"`
+
+	// CustomMetrics defines if the custom metrics api (custom.metrics.k8s.io) should get proxied from host to virtual cluster. This can
+	// be enabled independently of the metrics-server and allows horizontal pod autoscalers within the vCluster to use metrics of a
+	// host adapter such as the prometheus-adapter.
+	CustomMetrics MetricsServerAPI `json:"customMetrics,omitempty"`
+
+	// ExternalMetrics defines if the external metrics api (external.metrics.k8s.io) should get proxied from host to virtual cluster. This
+	// can be enabled independently of the metrics-server.
+	ExternalMetrics MetricsServerAPI `json:"externalMetrics,omitempty"`
+}
+
+// MetricsServerAPI holds configuration for an additional metrics api that should get proxied from host to virtual cluster.
+type MetricsServerAPI struct {
+	// Enabled signals if the metrics api should get proxied.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// APIService holds information about where to find the service serving the metrics api. Defaults to prometheus-adapter/monitoring.
+	APIService APIService `json:"apiService,omitempty"`
 }
 
 // APIService holds configuration related to the api server
@@ -229,6 +247,15 @@ func ValidateChanges(oldCfg, newCfg *Config) error {
 	oldDistro, newDistro := oldCfg.Distro(), newCfg.Distro()
 	oldBackingStore, newBackingStore := oldCfg.BackingStoreType(), newCfg.BackingStoreType()
 
+	// backing store changes are allowed if vCluster should migrate the data
+	if newCfg.ControlPlane.BackingStore.Migration.Enabled {
+		if err := ValidateStoreMigration(oldBackingStore, newBackingStore); err != nil {
+			return err
+		}
+
+		oldBackingStore = newBackingStore
+	}
+
 	return ValidateStoreAndDistroChanges(newBackingStore, oldBackingStore, newDistro, oldDistro)
 }
 
@@ -250,6 +277,21 @@ func ValidateStoreAndDistroChanges(currentStoreType, previousStoreType StoreType
 	return nil
 }
 
+// ValidateStoreMigration checks whether the data of the previous store can be migrated into the current store.
+func ValidateStoreMigration(previousStoreType, currentStoreType StoreType) error {
+	if previousStoreType == currentStoreType {
+		return nil
+	}
+
+	for _, storeType := range []StoreType{previousStoreType, currentStoreType} {
+		if storeType == StoreTypeEmbeddedEtcd {
+			return fmt.Errorf("migrating from %s to %s is not supported, please use controlPlane.backingStore.etcd.embedded.migrateFromDeployedEtcd instead", previousStoreType, currentStoreType)
+		}
+	}
+
+	return nil
+}
+
 func (c *Config) IsProFeatureEnabled() bool {
 	if len(c.Networking.ResolveDNS) > 0 {
 		return true
@@ -352,37 +394,40 @@ type SyncToHost struct {
 	ConfigMaps SyncAllResource `json:"configMaps,omitempty"`
 
 	// Ingresses defines if ingresses created within the virtual cluster should get synced to the host cluster.
-	Ingresses EnableSwitch `json:"ingresses,omitempty"`
+	Ingresses EnableSwitchWithPatches `json:"ingresses,omitempty"`
+
+	// GatewayAPI defines if Gateway API routes created within the virtual cluster should get synced to the host cluster.
+	GatewayAPI SyncGatewayAPI `json:"gatewayAPI,omitempty"`
 
 	// Services defines if services created within the virtual cluster should get synced to the host cluster.
-	Services EnableSwitch `json:"services,omitempty"`
+	Services EnableSwitchWithPatches `json:"services,omitempty"`
 
 	// Endpoints defines if endpoints created within the virtual cluster should get synced to the host cluster.
-	Endpoints EnableSwitch `json:"endpoints,omitempty"`
+	Endpoints EnableSwitchWithPatches `json:"endpoints,omitempty"`
 
 	// NetworkPolicies defines if network policies created within the virtual cluster should get synced to the host cluster.
-	NetworkPolicies EnableSwitch `json:"networkPolicies,omitempty"`
+	NetworkPolicies EnableSwitchWithPatches `json:"networkPolicies,omitempty"`
 
 	// PersistentVolumeClaims defines if persistent volume claims created within the virtual cluster should get synced to the host cluster.
-	PersistentVolumeClaims EnableSwitch `json:"persistentVolumeClaims,omitempty"`
+	PersistentVolumeClaims EnableSwitchWithPatches `json:"persistentVolumeClaims,omitempty"`
 
 	// PersistentVolumes defines if persistent volumes created within the virtual cluster should get synced to the host cluster.
-	PersistentVolumes EnableSwitch `json:"persistentVolumes,omitempty"`
+	PersistentVolumes EnableSwitchWithPatches `json:"persistentVolumes,omitempty"`
 
 	// VolumeSnapshots defines if volume snapshots created within the virtual cluster should get synced to the host cluster.
-	VolumeSnapshots EnableSwitch `json:"volumeSnapshots,omitempty"`
+	VolumeSnapshots EnableSwitchWithPatches `json:"volumeSnapshots,omitempty"`
 
 	// StorageClasses defines if storage classes created within the virtual cluster should get synced to the host cluster.
-	StorageClasses EnableSwitch `json:"storageClasses,omitempty"`
+	StorageClasses EnableSwitchWithPatches `json:"storageClasses,omitempty"`
 
 	// ServiceAccounts defines if service accounts created within the virtual cluster should get synced to the host cluster.
-	ServiceAccounts EnableSwitch `json:"serviceAccounts,omitempty"`
+	ServiceAccounts EnableSwitchWithPatches `json:"serviceAccounts,omitempty"`
 
 	// PodDisruptionBudgets defines if pod disruption budgets created within the virtual cluster should get synced to the host cluster.
-	PodDisruptionBudgets EnableSwitch `json:"podDisruptionBudgets,omitempty"`
+	PodDisruptionBudgets EnableSwitchWithPatches `json:"podDisruptionBudgets,omitempty"`
 
 	// PriorityClasses defines if priority classes created within the virtual cluster should get synced to the host cluster.
-	PriorityClasses EnableSwitch `json:"priorityClasses,omitempty"`
+	PriorityClasses EnableSwitchWithPatches `json:"priorityClasses,omitempty"`
 }
 
 type SyncFromHost struct {
@@ -390,22 +435,80 @@ type SyncFromHost struct {
 	Nodes SyncNodes `json:"nodes,omitempty"`
 
 	// Events defines if events should get synced from the host cluster to the virtual cluster, but not back.
-	Events EnableSwitch `json:"events,omitempty"`
+	Events EnableSwitchWithPatches `json:"events,omitempty"`
 
 	// IngressClasses defines if ingress classes should get synced from the host cluster to the virtual cluster, but not back.
-	IngressClasses EnableSwitch `json:"ingressClasses,omitempty"`
+	IngressClasses SyncFromHostIngressClasses `json:"ingressClasses,omitempty"`
 
 	// StorageClasses defines if storage classes should get synced from the host cluster to the virtual cluster, but not back. If auto, is automatically enabled when the virtual scheduler is enabled.
-	StorageClasses EnableAutoSwitch `json:"storageClasses,omitempty"`
+	StorageClasses SyncFromHostStorageClasses `json:"storageClasses,omitempty"`
 
 	// CSINodes defines if csi nodes should get synced from the host cluster to the virtual cluster, but not back. If auto, is automatically enabled when the virtual scheduler is enabled.
-	CSINodes EnableAutoSwitch `json:"csiNodes,omitempty"`
+	CSINodes EnableAutoSwitchWithPatches `json:"csiNodes,omitempty"`
 
 	// CSIDrivers defines if csi drivers should get synced from the host cluster to the virtual cluster, but not back. If auto, is automatically enabled when the virtual scheduler is enabled.
-	CSIDrivers EnableAutoSwitch `json:"csiDrivers,omitempty"`
+	CSIDrivers EnableAutoSwitchWithPatches `json:"csiDrivers,omitempty"`
 
 	// CSIStorageCapacities defines if csi storage capacities should get synced from the host cluster to the virtual cluster, but not back. If auto, is automatically enabled when the virtual scheduler is enabled.
-	CSIStorageCapacities EnableAutoSwitch `json:"csiStorageCapacities,omitempty"`
+	CSIStorageCapacities EnableAutoSwitchWithPatches `json:"csiStorageCapacities,omitempty"`
+}
+
+type SyncFromHostIngressClasses struct {
+	// Enabled defines if this option should be enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Patches patch the resource according to the provided specification.
+	Patches []*Patch `json:"patches,omitempty"`
+
+	// Mappings map ingress class names within the virtual cluster to ingress classes of the host cluster.
+	Mappings ClassMappings `json:"mappings,omitempty"`
+}
+
+type SyncFromHostStorageClasses struct {
+	// Enabled defines if this option should be enabled.
+	Enabled StrBool `json:"enabled,omitempty" jsonschema:"oneof_type=string;boolean"`
+
+	// Patches patch the resource according to the provided specification.
+	Patches []*Patch `json:"patches,omitempty"`
+
+	// Mappings map storage class names within the virtual cluster to storage classes of the host cluster.
+	Mappings ClassMappings `json:"mappings,omitempty"`
+}
+
+type ClassMappings struct {
+	// ByName maps class names within the virtual cluster to class names in the host cluster. If set, only the
+	// mapped classes are imported from the host cluster and objects that reference any other class are rejected.
+	ByName map[string]string `json:"byName,omitempty"`
+
+	// Default is the host class that is used for objects within the virtual cluster that do not reference a class.
+	Default string `json:"default,omitempty"`
+}
+
+// Enabled returns true if any class mapping is configured
+func (c ClassMappings) Enabled() bool {
+	return len(c.ByName) > 0 || c.Default != ""
+}
+
+// HostName returns the host class for the given virtual class. An empty virtual class resolves to the default class.
+// The second return value is false if the class is not mapped.
+func (c ClassMappings) HostName(virtualName string) (string, bool) {
+	if virtualName == "" {
+		return c.Default, c.Default != ""
+	}
+
+	hostName, ok := c.ByName[virtualName]
+	return hostName, ok
+}
+
+// VirtualName returns the virtual class the given host class is mapped to
+func (c ClassMappings) VirtualName(hostName string) (string, bool) {
+	for virtualName, mappedHostName := range c.ByName {
+		if mappedHostName == hostName {
+			return virtualName, true
+		}
+	}
+
+	return "", false
 }
 
 type EnableAutoSwitch struct {
@@ -418,12 +521,41 @@ type EnableSwitch struct {
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+type EnableSwitchWithPatches struct {
+	// Enabled defines if this option should be enabled.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Patches patch the resource according to the provided specification.
+	Patches []*Patch `json:"patches,omitempty"`
+}
+
+type EnableAutoSwitchWithPatches struct {
+	// Enabled defines if this option should be enabled.
+	Enabled StrBool `json:"enabled,omitempty" jsonschema:"oneof_type=string;boolean"`
+
+	// Patches patch the resource according to the provided specification.
+	Patches []*Patch `json:"patches,omitempty"`
+}
+
+type SyncGatewayAPI struct {
+	// Enabled defines if HTTPRoutes, GRPCRoutes and TCPRoutes should get synced to the host cluster. This also imports
+	// the GatewayClasses of the host cluster into the virtual cluster. Requires the Gateway API CRDs in the host cluster.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Gateways defines if Gateways created within the virtual cluster should get synced to the host cluster as well. If disabled,
+	// routes reference Gateways of the host cluster directly.
+	Gateways EnableSwitch `json:"gateways,omitempty"`
+}
+
 type SyncAllResource struct {
 	// Enabled defines if this option should be enabled.
 	Enabled bool `json:"enabled,omitempty"`
 
 	// All defines if all resources of that type should get synced or only the necessary ones that are needed.
 	All bool `json:"all,omitempty"`
+
+	// Patches patch the resource according to the provided specification.
+	Patches []*Patch `json:"patches,omitempty"`
 }
 
 type SyncPods struct {
@@ -434,9 +566,17 @@ type SyncPods struct {
 	// a certain image that is used within the virtual cluster to be another image on the host cluster
 	TranslateImage map[string]string `json:"translateImage,omitempty"`
 
+	// RewriteImages rewrites the images of all pods synced to the host cluster based on rules, e.g. to pull all images
+	// from a registry mirror. Images that are found in translateImage are not rewritten by these rules.
+	RewriteImages SyncPodsRewriteImages `json:"rewriteImages,omitempty"`
+
 	// EnforceTolerations will add the specified tolerations to all pods synced by the virtual cluster.
 	EnforceTolerations []string `json:"enforceTolerations,omitempty"`
 
+	// Enforce overrides scheduling and security settings of all pods synced by the virtual cluster. This can be used to pin
+	// the workloads of a virtual cluster to a certain node pool of the host cluster.
+	Enforce SyncPodsEnforce `json:"enforce,omitempty"`
+
 	// UseSecretsForSATokens will use secrets to save the generated service account tokens by virtual cluster instead of using a
 	// pod annotation.
 	UseSecretsForSATokens bool `json:"useSecretsForSATokens,omitempty"`
@@ -445,6 +585,81 @@ type SyncPods struct {
 	// a small container to each stateful set pod that will initially rewrite the /etc/hosts file to match the FQDN expected by
 	// the virtual cluster.
 	RewriteHosts SyncRewriteHosts `json:"rewriteHosts,omitempty"`
+
+	// WorkloadLabels adds labels with the kind and name of the owning virtual workload (e.g. Deployment, StatefulSet or DaemonSet) to
+	// the pods synced to the host cluster. This allows host cluster tooling to group pods by their virtual workload, while
+	// the virtual cluster controllers still own the workloads.
+	WorkloadLabels EnableSwitch `json:"workloadLabels,omitempty"`
+
+	// Patches patch the resource according to the provided specification.
+	Patches []*Patch `json:"patches,omitempty"`
+}
+
+type SyncPodsRewriteImages struct {
+	// Rules are evaluated in order against the fully qualified image (e.g. docker.io/library/nginx:1.25) and
+	// the first matching rule is applied.
+	Rules []ImageRewriteRule `json:"rules,omitempty"`
+
+	// Digests pins image tags to digests.
+	Digests ImageDigests `json:"digests,omitempty"`
+}
+
+type ImageRewriteRule struct {
+	// From is the image pattern to match. A single "*" can be used as wildcard, e.g. docker.io/* matches all images from docker hub.
+	// If regex is true, this is a regular expression that needs to match the whole image instead.
+	From string `json:"from,omitempty"`
+
+	// To is the rewritten image. A "*" is replaced with the part of the image that was matched by the wildcard, e.g.
+	// mirror.corp/dockerhub/*. If regex is true, capture groups can be referenced via $1, $2, etc.
+	To string `json:"to,omitempty"`
+
+	// Regex defines if from is a regular expression.
+	Regex bool `json:"regex,omitempty"`
+}
+
+type ImageDigests struct {
+	// ConfigMap is the name of a config map within the host namespace of the virtual cluster that is used to pin
+	// images to digests. Each value of the config map holds a yaml map of images (after rewriting) to digests, e.g.
+	// mirror.corp/dockerhub/library/nginx:1.25: sha256:...
+	ConfigMap string `json:"configMap,omitempty"`
+}
+
+type SyncPodsEnforce struct {
+	SyncPodsEnforceSettings `json:",inline"`
+
+	// Namespaces override the enforced settings for pods within virtual namespaces that match the given labels. If multiple
+	// entries match a namespace, they are applied in order.
+	Namespaces []SyncPodsEnforceNamespace `json:"namespaces,omitempty"`
+}
+
+type SyncPodsEnforceNamespace struct {
+	// Selector selects the virtual namespaces by their labels.
+	Selector Selector `json:"selector,omitempty"`
+
+	SyncPodsEnforceSettings `json:",inline"`
+}
+
+type SyncPodsEnforceSettings struct {
+	// NodeSelector is merged into the node selector of the pods on the host cluster.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// NodeAffinity is a node selector that is added to the required node affinity of the pods on the host cluster. Node selector
+	// terms of the pod are combined with the terms specified here, so that both need to match.
+	NodeAffinity map[string]interface{} `json:"nodeAffinity,omitempty"`
+
+	// RuntimeClassName is the host runtime class that is set for the pods on the host cluster.
+	RuntimeClassName string `json:"runtimeClassName,omitempty"`
+
+	// PriorityClassName is the host priority class that is set for the pods on the host cluster.
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// SchedulerName is the host scheduler that is set for the pods on the host cluster.
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// SecurityContext is merged into the pod security context of the pods on the host cluster. Fields that also exist on
+	// the container security context, such as runAsUser, runAsNonRoot, privileged or allowPrivilegeEscalation, are
+	// merged into the security context of every container as well, so containers can't override them.
+	SecurityContext map[string]interface{} `json:"securityContext,omitempty"`
 }
 
 type SyncRewriteHosts struct {
@@ -475,6 +690,13 @@ type SyncNodes struct {
 
 	// Selector can be used to define more granular what nodes should get synced from the host cluster to the virtual cluster.
 	Selector SyncNodeSelector `json:"selector,omitempty"`
+
+	// Privacy hides the names and addresses of host nodes from the virtual cluster. If enabled, nodes, pods and kubelet
+	// routes will use stable hashed aliases instead of the real host node names and pods will not expose the host IP.
+	Privacy EnableSwitch `json:"privacy,omitempty"`
+
+	// Patches patch the resource according to the provided specification.
+	Patches []*Patch `json:"patches,omitempty"`
 }
 
 type SyncNodeSelector struct {
@@ -595,7 +817,6 @@ type Plugin struct {
 	ReadinessProbe map[string]interface{} `json:"readinessProbe,omitempty"`
 	StartupProbe   map[string]interface{} `json:"startupProbe,omitempty"`
 	WorkingDir     string                 `json:"workingDir,omitempty"`
-	Optional       bool                   `json:"optional,omitempty"`
 }
 
 type Plugins struct {
@@ -628,6 +849,10 @@ type Plugins struct {
 
 	// VolumeMounts are extra volume mounts for the init container
 	VolumeMounts []interface{} `json:"volumeMounts,omitempty"`
+
+	// Optional defines if vCluster should keep running in a degraded state if the plugin fails to start or crashes.
+	// vCluster will try to restart the plugin in the background.
+	Optional bool `json:"optional,omitempty"`
 }
 
 type PluginsRBAC struct {
@@ -893,6 +1118,41 @@ type BackingStore struct {
 
 	// Database defines that a database backend should be used as the backend for the virtual cluster. This uses a project called kine under the hood which is a shim for bridging Kubernetes and relational databases.
 	Database Database `json:"database,omitempty"`
+
+	// Migration defines if and how vCluster should migrate the data of the previously used backing store into the configured one.
+	Migration BackingStoreMigration `json:"migration,omitempty"`
+}
+
+type BackingStoreMigration struct {
+	// Enabled defines if vCluster should copy all data from the previously used backing store into the configured one on startup, if the backing store has changed.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// DryRun only reports the data that would be migrated without writing anything. vCluster will not start while this is enabled and a migration is pending.
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// SkipVerify skips comparing the data of both backing stores after the migration.
+	SkipVerify bool `json:"skipVerify,omitempty"`
+
+	// Source defines how to connect to the previously used backing store.
+	Source BackingStoreMigrationSource `json:"source,omitempty"`
+}
+
+type BackingStoreMigrationSource struct {
+	// DeployedEtcd keeps the etcd deployed by the helm chart running, so its data can be migrated into the configured backing store.
+	DeployedEtcd bool `json:"deployedEtcd,omitempty"`
+
+	// DataSource is the kine dataSource of the previously used database. This is required when migrating from an external database
+	// and optional for the embedded database.
+	DataSource string `json:"dataSource,omitempty"`
+
+	// KeyFile is the key file to use for the previously used database. This is optional.
+	KeyFile string `json:"keyFile,omitempty"`
+
+	// CertFile is the cert file to use for the previously used database. This is optional.
+	CertFile string `json:"certFile,omitempty"`
+
+	// CaFile is the ca file to use for the previously used database. This is optional.
+	CaFile string `json:"caFile,omitempty"`
 }
 
 type Database struct {
@@ -1170,6 +1430,150 @@ type ControlPlaneAdvanced struct {
 
 	// GlobalMetadata is metadata that will be added to all resources deployed by Helm.
 	GlobalMetadata ControlPlaneGlobalMetadata `json:"globalMetadata,omitempty"`
+
+	// Audit defines audit logging for the requests served by the vCluster syncer proxy.
+	Audit ControlPlaneAudit `json:"audit,omitempty"`
+
+	// CertRotation defines if the certificates of the vCluster control plane should be renewed before they expire.
+	CertRotation ControlPlaneCertRotation `json:"certRotation,omitempty"`
+
+	// OIDC defines if the virtual cluster api server should accept tokens issued by an OpenID Connect provider.
+	OIDC ControlPlaneOIDC `json:"oidc,omitempty"`
+}
+
+type ControlPlaneOIDC struct {
+	// Enabled defines if OIDC id tokens should be accepted by the virtual cluster api server.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// IssuerURL is the URL of the OpenID Connect provider. Only https is allowed. The provider needs to serve its discovery document under /.well-known/openid-configuration.
+	IssuerURL string `json:"issuerURL,omitempty"`
+
+	// ClientID is the client id all tokens must be issued for (aud claim).
+	ClientID string `json:"clientID,omitempty"`
+
+	// UsernameClaim is the claim used as the username of the user.
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+
+	// UsernamePrefix is prepended to the username to prevent clashes with other authentication strategies. Usernames with the reserved system: prefix are rejected.
+	UsernamePrefix string `json:"usernamePrefix,omitempty"`
+
+	// GroupsClaim is the claim used as the groups of the user. The claim can be either a string or an array of strings.
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+
+	// GroupsPrefix is prepended to all groups to prevent clashes with other authentication strategies. Groups with the reserved system: prefix are rejected.
+	GroupsPrefix string `json:"groupsPrefix,omitempty"`
+
+	// RequiredClaims are claims that must be present in the token with exactly the given value.
+	RequiredClaims map[string]string `json:"requiredClaims,omitempty"`
+
+	// CA is the PEM encoded certificate authority used to verify the connection to the issuer. If empty, the system roots are used.
+	CA string `json:"ca,omitempty"`
+}
+
+type ControlPlaneCertRotation struct {
+	// Enabled defines if the leaf certificates and kubeconfigs in the vCluster certs secret should be renewed automatically. The control plane replicas are restarted one after another after a renewal.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// RenewBeforeDays is the amount of days before expiry at which a certificate is renewed.
+	RenewBeforeDays int `json:"renewBeforeDays,omitempty"`
+
+	// CA defines if the certificate authorities should be rotated as well.
+	CA CertRotationCA `json:"ca,omitempty"`
+}
+
+type CertRotationCA struct {
+	// Enabled defines if expiring certificate authorities should be rotated automatically. The old certificate authority is still trusted during the overlap period, so clients have time to pick up the new one.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// OverlapDays is the amount of days the old certificate authority is still trusted after a rotation.
+	OverlapDays int `json:"overlapDays,omitempty"`
+}
+
+type ControlPlaneAudit struct {
+	// Enabled defines if audit events should be recorded for the requests served by the vCluster syncer proxy.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Policy is the audit policy that defines which events are recorded. If no rules are specified, the metadata of all requests is recorded.
+	Policy AuditPolicy `json:"policy,omitempty"`
+
+	// Log configures the audit log file backend.
+	Log AuditLog `json:"log,omitempty"`
+
+	// Webhook configures the audit webhook backend.
+	Webhook AuditWebhook `json:"webhook,omitempty"`
+}
+
+type AuditPolicy struct {
+	// OmitStages is a list of stages for which no events are created.
+	OmitStages []string `json:"omitStages,omitempty"`
+
+	// Rules specify the audit level a request should be recorded at. A request may match multiple rules, in which case the FIRST matching rule is used.
+	Rules []AuditPolicyRule `json:"rules,omitempty"`
+}
+
+type AuditPolicyRule struct {
+	// Level that requests matching this rule are recorded at. Can be None, Metadata, Request or RequestResponse.
+	Level string `json:"level,omitempty"`
+
+	// Users (by authenticated user name) this rule applies to. An empty list implies every user.
+	Users []string `json:"users,omitempty"`
+
+	// UserGroups this rule applies to. A user is considered matching if it is a member of any of the UserGroups. An empty list implies every user group.
+	UserGroups []string `json:"userGroups,omitempty"`
+
+	// Verbs this rule applies to. An empty list implies every verb.
+	Verbs []string `json:"verbs,omitempty"`
+
+	// Resources this rule applies to. An empty list implies all resources in all api groups.
+	Resources []AuditGroupResources `json:"resources,omitempty"`
+
+	// Namespaces this rule applies to. The empty string "" matches non-namespaced resources. An empty list implies every namespace.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NonResourceURLs is a set of URL paths that should be audited. "*"s are allowed, but only as the full, final step in the path.
+	NonResourceURLs []string `json:"nonResourceURLs,omitempty"`
+
+	// OmitStages is a list of stages for which no events are created.
+	OmitStages []string `json:"omitStages,omitempty"`
+
+	// OmitManagedFields indicates whether to omit the managed fields of the request and response bodies from being written to the API audit log.
+	OmitManagedFields bool `json:"omitManagedFields,omitempty"`
+}
+
+type AuditGroupResources struct {
+	// Group is the name of the API group that contains the resources. The empty string represents the core API group.
+	Group string `json:"group,omitempty"`
+
+	// Resources is a list of resources this rule applies to. For example: 'pods' matches pods and 'pods/log' matches the log subresource of pods.
+	Resources []string `json:"resources,omitempty"`
+
+	// ResourceNames is a list of resource instance names that the policy matches.
+	ResourceNames []string `json:"resourceNames,omitempty"`
+}
+
+type AuditLog struct {
+	// Enabled defines if audit events should be written to a log file.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Path is the file the audit events are written to.
+	Path string `json:"path,omitempty"`
+
+	// MaxAge is the maximum number of days to retain old audit log files.
+	MaxAge int `json:"maxAge,omitempty"`
+
+	// MaxBackups is the maximum number of old audit log files to retain.
+	MaxBackups int `json:"maxBackups,omitempty"`
+
+	// MaxSize is the maximum size in megabytes of the audit log file before it gets rotated.
+	MaxSize int `json:"maxSize,omitempty"`
+}
+
+type AuditWebhook struct {
+	// Enabled defines if audit events should be sent to a webhook.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Config is the kubeconfig that defines how to reach the webhook.
+	Config string `json:"config,omitempty"`
 }
 
 type ControlPlaneHeadlessService struct {
@@ -1674,6 +2078,29 @@ type Experimental struct {
 
 	// DenyProxyRequests denies certain requests in the vCluster proxy.
 	DenyProxyRequests []DenyRule `json:"denyProxyRequests,omitempty" product:"pro"`
+
+	// SleepMode puts the vCluster to sleep after a period of inactivity and wakes it up again on the next request.
+	SleepMode ExperimentalSleepMode `json:"sleepMode,omitempty"`
+}
+
+type ExperimentalSleepMode struct {
+	// Enabled specifies if the vCluster should go to sleep automatically. If enabled, Helm will deploy a small proxy in front of
+	// the vCluster control plane that wakes up the vCluster as soon as a new request comes in.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// AfterInactivity is the duration without any requests to the vCluster after which the vCluster goes to sleep, e.g. 1h or 30m.
+	AfterInactivity string `json:"afterInactivity,omitempty"`
+
+	// IgnoreServiceAccounts specifies if requests from service accounts within the vCluster should not count as activity.
+	IgnoreServiceAccounts bool `json:"ignoreServiceAccounts,omitempty"`
+
+	// Proxy configures the proxy that wakes up the vCluster.
+	Proxy ExperimentalSleepModeProxy `json:"proxy,omitempty"`
+}
+
+type ExperimentalSleepModeProxy struct {
+	// Resources are the resources of the proxy container.
+	Resources map[string]interface{} `json:"resources,omitempty"`
 }
 
 func (e Experimental) JSONSchemaExtend(base *jsonschema.Schema) {
@@ -1913,6 +2340,11 @@ type Patch struct {
 	// Value is the new value to be set to the path
 	Value interface{} `json:"value,omitempty" yaml:"value,omitempty"`
 
+	// Expression is a CEL expression that computes the new value for the path if the operation is expression.
+	// The expression can access the patched object as object, the other object as source and the current
+	// value at the path as value. If the expression evaluates to null, the path is removed.
+	Expression string `json:"expression,omitempty" yaml:"expression,omitempty"`
+
 	// Regex - is regular expresion used to identify the Name,
 	// and optionally Namespace, parts of the field value that
 	// will be replaced with the rewritten Name and/or Namespace
@@ -1943,6 +2375,7 @@ const (
 	PatchTypeAdd            PatchType = "add"
 	PatchTypeReplace        PatchType = "replace"
 	PatchTypeRemove         PatchType = "remove"
+	PatchTypeExpression     PatchType = "expression"
 )
 
 type PatchCondition struct {
@@ -1960,6 +2393,11 @@ type PatchCondition struct {
 
 	// Empty means that the path value should be empty or unset
 	Empty *bool `json:"empty,omitempty" yaml:"empty,omitempty"`
+
+	// Expression is a CEL expression that needs to evaluate to true. The expression can access the
+	// object as object, the other object (e.g. the virtual object for a patch on the host object) as source
+	// and the selected value as value.
+	Expression string `json:"expression,omitempty" yaml:"expression,omitempty"`
 }
 
 type PatchSync struct {
@@ -342,3 +342,80 @@ func TestConfig_IsProFeatureEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateChanges(t *testing.T) {
+	embeddedDatabase := func(migrate bool) *Config {
+		return &Config{
+			ControlPlane: ControlPlane{
+				BackingStore: BackingStore{
+					Database:  Database{Embedded: DatabaseKine{Enabled: true}},
+					Migration: BackingStoreMigration{Enabled: migrate},
+				},
+			},
+		}
+	}
+	deployedEtcd := func(migrate bool) *Config {
+		return &Config{
+			ControlPlane: ControlPlane{
+				BackingStore: BackingStore{
+					Etcd:      Etcd{Deploy: EtcdDeploy{Enabled: true}},
+					Migration: BackingStoreMigration{Enabled: migrate},
+				},
+			},
+		}
+	}
+	embeddedEtcd := func(migrate bool) *Config {
+		return &Config{
+			ControlPlane: ControlPlane{
+				BackingStore: BackingStore{
+					Etcd:      Etcd{Embedded: EtcdEmbedded{Enabled: true}},
+					Migration: BackingStoreMigration{Enabled: migrate},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		old     *Config
+		new     *Config
+		wantErr bool
+	}{
+		{
+			name: "No changes",
+			old:  embeddedDatabase(false),
+			new:  embeddedDatabase(false),
+		},
+		{
+			name:    "Store change without migration",
+			old:     embeddedDatabase(false),
+			new:     deployedEtcd(false),
+			wantErr: true,
+		},
+		{
+			name: "Store change with migration",
+			old:  embeddedDatabase(false),
+			new:  deployedEtcd(true),
+		},
+		{
+			name: "Store change back with migration",
+			old:  deployedEtcd(true),
+			new:  embeddedDatabase(true),
+		},
+		{
+			name:    "Store change to embedded etcd with migration",
+			old:     embeddedDatabase(false),
+			new:     embeddedEtcd(true),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateChanges(tt.old, tt.new)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateChanges() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package oidcauthenticator
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/request/bearertoken"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+// DiscoveryPath is the path of the OpenID Connect discovery document relative to the issuer
+const DiscoveryPath = "/.well-known/openid-configuration"
+
+// systemPrefix is reserved for kubernetes identities such as system:masters and is never accepted from tokens
+const systemPrefix = "system:"
+
+// keySetRefreshInterval is the minimum interval between two key set refreshes
+var keySetRefreshInterval = time.Second * 10
+
+var allowedAlgorithms = map[string]bool{
+	string(jose.RS256): true,
+	string(jose.RS384): true,
+	string(jose.RS512): true,
+	string(jose.ES256): true,
+	string(jose.ES384): true,
+	string(jose.ES512): true,
+	string(jose.PS256): true,
+	string(jose.PS384): true,
+	string(jose.PS512): true,
+}
+
+type Options struct {
+	// IssuerURL is the url of the OpenID Connect provider
+	IssuerURL string
+
+	// ClientID is the audience the tokens need to be issued for
+	ClientID string
+
+	// UsernameClaim is the claim used as username, defaults to sub
+	UsernameClaim string
+
+	// UsernamePrefix is prepended to the username. Usernames that end up with the system: prefix are rejected.
+	UsernamePrefix string
+
+	// GroupsClaim is the claim used as groups
+	GroupsClaim string
+
+	// GroupsPrefix is prepended to every group. Groups that end up with the system: prefix are rejected.
+	GroupsPrefix string
+
+	// RequiredClaims need to be present in the token with the exact value
+	RequiredClaims map[string]string
+
+	// CA is the PEM encoded certificate authority of the issuer
+	CA []byte
+}
+
+// New creates a new request authenticator that verifies OpenID Connect id tokens. Tokens that are not JWTs or
+// are issued by a different issuer are ignored, so the authenticator can be used together with others.
+func New(options Options) (authenticator.Request, error) {
+	tokenAuthenticator, err := NewTokenAuthenticator(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return bearertoken.New(tokenAuthenticator), nil
+}
+
+// NewTokenAuthenticator creates a new token authenticator that verifies OpenID Connect id tokens
+func NewTokenAuthenticator(options Options) (authenticator.Token, error) {
+	if options.IssuerURL == "" {
+		return nil, errors.New("oidc issuer url is required")
+	} else if !strings.HasPrefix(options.IssuerURL, "https://") {
+		return nil, fmt.Errorf("oidc issuer url %s must use https", options.IssuerURL)
+	} else if options.ClientID == "" {
+		return nil, errors.New("oidc client id is required")
+	}
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = "sub"
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(options.CA) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(options.CA) {
+			return nil, errors.New("oidc ca does not contain any valid certificates")
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &oidcAuthenticator{
+		options: options,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   time.Second * 30,
+		},
+	}, nil
+}
+
+type oidcAuthenticator struct {
+	options Options
+	client  *http.Client
+
+	keysLock    sync.Mutex
+	keys        *jose.JSONWebKeySet
+	lastRefresh time.Time
+}
+
+type discoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+func (o *oidcAuthenticator) AuthenticateToken(ctx context.Context, token string) (*authenticator.Response, bool, error) {
+	parsedToken, err := jwt.ParseSigned(token)
+	if err != nil {
+		// not a jwt, let other authenticators handle it
+		return nil, false, nil
+	}
+
+	// check if the token was issued by our issuer, otherwise let other authenticators handle it
+	unverifiedClaims := jwt.Claims{}
+	err = parsedToken.UnsafeClaimsWithoutVerification(&unverifiedClaims)
+	if err != nil || unverifiedClaims.Issuer != o.options.IssuerURL {
+		return nil, false, nil
+	} else if len(parsedToken.Headers) != 1 {
+		return nil, false, errors.New("oidc: token must have exactly one signature")
+	} else if !allowedAlgorithms[parsedToken.Headers[0].Algorithm] {
+		return nil, false, fmt.Errorf("oidc: token signed with unsupported algorithm %s", parsedToken.Headers[0].Algorithm)
+	}
+
+	// verify the signature
+	key, err := o.getKey(ctx, parsedToken.Headers[0].KeyID)
+	if err != nil {
+		return nil, false, err
+	}
+	claims := jwt.Claims{}
+	rawClaims := map[string]interface{}{}
+	err = parsedToken.Claims(key, &claims, &rawClaims)
+	if err != nil {
+		return nil, false, fmt.Errorf("oidc: verify token: %w", err)
+	}
+
+	// verify the standard claims, ValidateWithLeeway only checks exp and iat if they are present
+	if claims.Expiry == nil {
+		return nil, false, errors.New("oidc: token has no expiry")
+	} else if claims.IssuedAt == nil {
+		return nil, false, errors.New("oidc: token has no issued at time")
+	}
+	err = claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:   o.options.IssuerURL,
+		Audience: jwt.Audience{o.options.ClientID},
+		Time:     time.Now(),
+	}, jwt.DefaultLeeway)
+	if err != nil {
+		return nil, false, fmt.Errorf("oidc: validate token: %w", err)
+	}
+	for claim, value := range o.options.RequiredClaims {
+		actual, ok := rawClaims[claim].(string)
+		if !ok || actual != value {
+			return nil, false, fmt.Errorf("oidc: required claim %s does not match %s", claim, value)
+		}
+	}
+
+	// map the claims to the user
+	username, ok := rawClaims[o.options.UsernameClaim].(string)
+	if !ok || username == "" {
+		return nil, false, fmt.Errorf("oidc: username claim %s is missing or not a string", o.options.UsernameClaim)
+	}
+	if o.options.UsernameClaim == "email" {
+		if verified, ok := rawClaims["email_verified"]; ok && verified != true {
+			return nil, false, fmt.Errorf("oidc: email %s is not verified", username)
+		}
+	}
+	username = o.options.UsernamePrefix + username
+	if strings.HasPrefix(username, systemPrefix) {
+		return nil, false, fmt.Errorf("oidc: username %s uses the reserved prefix %s", username, systemPrefix)
+	}
+	groups, err := o.groups(rawClaims)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &authenticator.Response{
+		User: &user.DefaultInfo{
+			Name:   username,
+			UID:    claims.Subject,
+			Groups: groups,
+		},
+	}, true, nil
+}
+
+func (o *oidcAuthenticator) groups(rawClaims map[string]interface{}) ([]string, error) {
+	if o.options.GroupsClaim == "" {
+		return nil, nil
+	}
+
+	groups := []string{}
+	switch value := rawClaims[o.options.GroupsClaim].(type) {
+	case nil:
+	case string:
+		groups = append(groups, o.options.GroupsPrefix+value)
+	case []interface{}:
+		for _, group := range value {
+			groupString, ok := group.(string)
+			if !ok {
+				return nil, fmt.Errorf("oidc: groups claim %s contains a non string value", o.options.GroupsClaim)
+			}
+
+			groups = append(groups, o.options.GroupsPrefix+groupString)
+		}
+	default:
+		return nil, fmt.Errorf("oidc: groups claim %s is neither a string nor an array", o.options.GroupsClaim)
+	}
+	for _, group := range groups {
+		if strings.HasPrefix(group, systemPrefix) {
+			return nil, fmt.Errorf("oidc: group %s uses the reserved prefix %s", group, systemPrefix)
+		}
+	}
+
+	return groups, nil
+}
+
+// getKey returns the signing key with the given id and refreshes the key set if the key is unknown, which
+// happens after the provider rotated its keys.
+func (o *oidcAuthenticator) getKey(ctx context.Context, keyID string) (*jose.JSONWebKey, error) {
+	o.keysLock.Lock()
+	defer o.keysLock.Unlock()
+
+	if o.keys != nil {
+		if key := findKey(o.keys, keyID); key != nil {
+			return key, nil
+		} else if time.Since(o.lastRefresh) < keySetRefreshInterval {
+			return nil, fmt.Errorf("oidc: signing key %q not found", keyID)
+		}
+	}
+
+	keys, err := o.fetchKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: fetch signing keys: %w", err)
+	}
+	o.keys = keys
+	o.lastRefresh = time.Now()
+
+	key := findKey(o.keys, keyID)
+	if key == nil {
+		return nil, fmt.Errorf("oidc: signing key %q not found", keyID)
+	}
+
+	return key, nil
+}
+
+func (o *oidcAuthenticator) fetchKeys(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	discovery := &discoveryDocument{}
+	err := o.getJSON(ctx, strings.TrimSuffix(o.options.IssuerURL, "/")+DiscoveryPath, discovery)
+	if err != nil {
+		return nil, err
+	} else if discovery.Issuer != o.options.IssuerURL {
+		return nil, fmt.Errorf("issuer %s in discovery document does not match %s", discovery.Issuer, o.options.IssuerURL)
+	} else if discovery.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing jwks_uri")
+	}
+
+	keys := &jose.JSONWebKeySet{}
+	err = o.getJSON(ctx, discovery.JWKSURI, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (o *oidcAuthenticator) getJSON(ctx context.Context, url string, into interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: unexpected status code %d: %s", url, resp.StatusCode, string(body))
+	}
+
+	return json.Unmarshal(body, into)
+}
+
+func findKey(keys *jose.JSONWebKeySet, keyID string) *jose.JSONWebKey {
+	for i := range keys.Keys {
+		key := &keys.Keys[i]
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		} else if keyID == "" || key.KeyID == keyID {
+			return key
+		}
+	}
+
+	return nil
+}
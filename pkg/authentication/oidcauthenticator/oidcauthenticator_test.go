@@ -0,0 +1,204 @@
+package oidcauthenticator
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+	"gotest.tools/v3/assert"
+)
+
+type fakeIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+
+	issuer := &fakeIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc(DiscoveryPath, func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(discoveryDocument{
+			Issuer:  issuer.server.URL,
+			JWKSURI: issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       &key.PublicKey,
+			KeyID:     "test",
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}}})
+	})
+	issuer.server = httptest.NewTLSServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (f *fakeIssuer) ca() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: f.server.Certificate().Raw})
+}
+
+func (f *fakeIssuer) token(t *testing.T, claims jwt.Claims, extraClaims map[string]interface{}) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: f.key}, (&jose.SignerOptions{}).WithHeader("kid", "test"))
+	assert.NilError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).Claims(extraClaims).CompactSerialize()
+	assert.NilError(t, err)
+	return token
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	tokenAuthenticator, err := NewTokenAuthenticator(Options{
+		IssuerURL:      issuer.server.URL,
+		ClientID:       "vcluster",
+		UsernameClaim:  "email",
+		UsernamePrefix: "oidc:",
+		GroupsClaim:    "groups",
+		GroupsPrefix:   "oidc:",
+		RequiredClaims: map[string]string{"tenant": "test"},
+		CA:             issuer.ca(),
+	})
+	assert.NilError(t, err)
+
+	validClaims := func() jwt.Claims {
+		return jwt.Claims{
+			Issuer:   issuer.server.URL,
+			Subject:  "1234",
+			Audience: jwt.Audience{"vcluster"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+		}
+	}
+	extraClaims := map[string]interface{}{
+		"email":          "jane@example.com",
+		"email_verified": true,
+		"groups":         []string{"admins", "devs"},
+		"tenant":         "test",
+	}
+
+	// valid token
+	response, ok, err := tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, validClaims(), extraClaims))
+	assert.NilError(t, err)
+	assert.Assert(t, ok)
+	assert.Equal(t, response.User.GetName(), "oidc:jane@example.com")
+	assert.Equal(t, response.User.GetUID(), "1234")
+	assert.DeepEqual(t, response.User.GetGroups(), []string{"oidc:admins", "oidc:devs"})
+
+	// wrong audience
+	claims := validClaims()
+	claims.Audience = jwt.Audience{"other"}
+	_, ok, err = tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, claims, extraClaims))
+	assert.ErrorContains(t, err, "audience")
+	assert.Assert(t, !ok)
+
+	// expired
+	claims = validClaims()
+	claims.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	_, ok, err = tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, claims, extraClaims))
+	assert.ErrorContains(t, err, "expired")
+	assert.Assert(t, !ok)
+
+	// no expiry
+	claims = validClaims()
+	claims.Expiry = nil
+	_, ok, err = tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, claims, extraClaims))
+	assert.ErrorContains(t, err, "no expiry")
+	assert.Assert(t, !ok)
+
+	// no issued at time
+	claims = validClaims()
+	claims.IssuedAt = nil
+	_, ok, err = tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, claims, extraClaims))
+	assert.ErrorContains(t, err, "no issued at time")
+	assert.Assert(t, !ok)
+
+	// required claim mismatch
+	_, ok, err = tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, validClaims(), map[string]interface{}{
+		"email":  "jane@example.com",
+		"tenant": "other",
+	}))
+	assert.ErrorContains(t, err, "tenant")
+	assert.Assert(t, !ok)
+
+	// other issuers and non jwt tokens are left to other authenticators
+	claims = validClaims()
+	claims.Issuer = "https://other.example.com"
+	_, ok, err = tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, claims, extraClaims))
+	assert.NilError(t, err)
+	assert.Assert(t, !ok)
+	_, ok, err = tokenAuthenticator.AuthenticateToken(context.TODO(), "not-a-jwt")
+	assert.NilError(t, err)
+	assert.Assert(t, !ok)
+}
+
+func TestAuthenticateTokenSystemPrefix(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	tokenAuthenticator, err := NewTokenAuthenticator(Options{
+		IssuerURL:   issuer.server.URL,
+		ClientID:    "vcluster",
+		GroupsClaim: "groups",
+		CA:          issuer.ca(),
+	})
+	assert.NilError(t, err)
+
+	claims := jwt.Claims{
+		Issuer:   issuer.server.URL,
+		Subject:  "1234",
+		Audience: jwt.Audience{"vcluster"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}
+
+	// groups such as system:masters must not be accepted from the issuer
+	_, ok, err := tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, claims, map[string]interface{}{
+		"groups": []string{"devs", "system:masters"},
+	}))
+	assert.ErrorContains(t, err, "system:masters")
+	assert.Assert(t, !ok)
+
+	// the same applies to usernames
+	claims.Subject = "system:admin"
+	_, ok, err = tokenAuthenticator.AuthenticateToken(context.TODO(), issuer.token(t, claims, nil))
+	assert.ErrorContains(t, err, "system:admin")
+	assert.Assert(t, !ok)
+}
+
+func TestAuthenticateTokenUnknownKey(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	tokenAuthenticator, err := NewTokenAuthenticator(Options{
+		IssuerURL: issuer.server.URL,
+		ClientID:  "vcluster",
+		CA:        issuer.ca(),
+	})
+	assert.NilError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: otherKey}, (&jose.SignerOptions{}).WithHeader("kid", "test"))
+	assert.NilError(t, err)
+	token, err := jwt.Signed(signer).Claims(jwt.Claims{
+		Issuer:   issuer.server.URL,
+		Subject:  "1234",
+		Audience: jwt.Audience{"vcluster"},
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}).CompactSerialize()
+	assert.NilError(t, err)
+
+	_, ok, err := tokenAuthenticator.AuthenticateToken(context.TODO(), token)
+	assert.ErrorContains(t, err, "verify token")
+	assert.Assert(t, !ok)
+}
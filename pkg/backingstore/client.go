@@ -0,0 +1,160 @@
+package backingstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/etcd"
+	"github.com/loft-sh/vcluster/pkg/k8s"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/klog/v2"
+)
+
+const (
+	k3sDataSource = "sqlite:///data/server/db/state.db?_journal=WAL&cache=shared&_busy_timeout=30000"
+	k0sDataSource = "sqlite:///data/k0s/db/state.db?_journal=WAL&cache=shared&_busy_timeout=30000"
+	k8sDataSource = "sqlite:///data/state.db?_journal=WAL&cache=shared&_busy_timeout=30000"
+)
+
+// Store describes a backing store of a virtual cluster
+type Store struct {
+	// Type is the type of the backing store
+	Type vclusterconfig.StoreType
+
+	// Database holds the kine options for database backing stores. If the data source is empty
+	// for an embedded database, the default sqlite database of the distro is used.
+	Database vclusterconfig.DatabaseKine
+}
+
+// StoreFromConfig returns the currently configured backing store of the virtual cluster
+func StoreFromConfig(vConfig *config.VirtualClusterConfig) Store {
+	store := Store{
+		Type:     vConfig.BackingStoreType(),
+		Database: vConfig.ControlPlane.BackingStore.Database.Embedded,
+	}
+	if store.Type == vclusterconfig.StoreTypeExternalDatabase {
+		store.Database = vConfig.ControlPlane.BackingStore.Database.External
+	}
+
+	return store
+}
+
+// ClientOptions holds options for connecting to a backing store
+type ClientOptions struct {
+	// KineSocket is the unix socket a temporary kine listens on, if one is required to reach the backing store
+	KineSocket string
+
+	// Running signals that the virtual cluster is running, which means the kine started by the k8s distro can be reused
+	Running bool
+}
+
+// NewClient returns an etcd client connected to the given backing store of the virtual cluster.
+// For database backing stores a temporary kine is started if required, which is stopped
+// when the returned close func is called.
+func NewClient(ctx context.Context, vConfig *config.VirtualClusterConfig, store Store, options ClientOptions) (*clientv3.Client, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	endpoint, certificates, err := endpointForStore(ctx, vConfig, store, options)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	_, err = etcd.WaitForEtcdClient(ctx, certificates, endpoint)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	// WaitForEtcdClient closes the client after the health check, so we need a new one
+	etcdClient, err := etcd.GetEtcdClient(ctx, certificates, endpoint)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	return etcdClient, func() {
+		_ = etcdClient.Close()
+		cancel()
+	}, nil
+}
+
+func endpointForStore(ctx context.Context, vConfig *config.VirtualClusterConfig, store Store, options ClientOptions) (string, *etcd.Certificates, error) {
+	distro := vConfig.Distro()
+	switch store.Type {
+	case vclusterconfig.StoreTypeEmbeddedEtcd, vclusterconfig.StoreTypeExternalEtcd:
+		pkiDir := "/data/pki"
+		if distro == vclusterconfig.K0SDistro {
+			pkiDir = "/data/k0s/pki"
+		}
+
+		endpoint := "https://127.0.0.1:2379"
+		if store.Type == vclusterconfig.StoreTypeExternalEtcd {
+			endpoint = "https://" + vConfig.Name + "-etcd:2379"
+		}
+
+		return endpoint, &etcd.Certificates{
+			CaCert:     filepath.Join(pkiDir, "etcd", "ca.crt"),
+			ServerCert: filepath.Join(pkiDir, "apiserver-etcd-client.crt"),
+			ServerKey:  filepath.Join(pkiDir, "apiserver-etcd-client.key"),
+		}, nil
+	case vclusterconfig.StoreTypeExternalDatabase:
+		if store.Database.DataSource == "" {
+			return "", nil, fmt.Errorf("data source for external database is missing")
+		}
+	case vclusterconfig.StoreTypeEmbeddedDatabase:
+		if store.Database.DataSource != "" {
+			break
+		}
+
+		switch distro {
+		case vclusterconfig.K8SDistro, vclusterconfig.EKSDistro:
+			// reuse the kine that is started by the vCluster itself
+			if options.Running {
+				return k8s.KineEndpoint, nil, nil
+			}
+
+			store.Database.DataSource = k8sDataSource
+		case vclusterconfig.K3SDistro:
+			store.Database.DataSource = k3sDataSource
+		case vclusterconfig.K0SDistro:
+			store.Database.DataSource = k0sDataSource
+		default:
+			return "", nil, fmt.Errorf("unsupported distro %s", distro)
+		}
+	default:
+		return "", nil, fmt.Errorf("unsupported backing store %s", store.Type)
+	}
+
+	if options.KineSocket == "" {
+		return "", nil, fmt.Errorf("kine socket is required to connect to %s", store.Type)
+	}
+
+	return startKine(ctx, store.Database, options.KineSocket), nil, nil
+}
+
+// startKine starts a kine for the given database that is stopped when ctx is done
+func startKine(ctx context.Context, database vclusterconfig.DatabaseKine, socket string) string {
+	_ = os.Remove(socket)
+
+	args := []string{}
+	args = append(args, "/usr/local/bin/kine")
+	args = append(args, "--endpoint="+database.DataSource)
+	args = append(args, "--ca-file="+database.CaFile)
+	args = append(args, "--key-file="+database.KeyFile)
+	args = append(args, "--cert-file="+database.CertFile)
+	args = append(args, "--metrics-bind-address=0")
+	args = append(args, "--listen-address=unix://"+socket)
+
+	go func() {
+		err := k8s.RunCommand(ctx, args, "kine")
+		if err != nil && ctx.Err() == nil {
+			klog.FromContext(ctx).Error(err, "run kine", "socket", socket)
+		}
+	}()
+
+	return "unix://" + socket
+}
@@ -0,0 +1,122 @@
+package backingstore
+
+import (
+	"context"
+	"fmt"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// RegistryPrefix is the etcd key prefix kubernetes stores its objects under
+	RegistryPrefix = "/registry/"
+
+	// pageSize is the amount of keys that are requested from the backing store at once
+	pageSize = 500
+)
+
+// List calls fn for every kubernetes key within the backing store at the given revision
+func List(ctx context.Context, etcdClient *clientv3.Client, revision int64, fn func(key, value []byte) error) error {
+	return ListPrefix(ctx, etcdClient, RegistryPrefix, revision, fn)
+}
+
+// ListPrefix calls fn for every key with the given prefix within the backing store at the given revision
+func ListPrefix(ctx context.Context, etcdClient *clientv3.Client, prefix string, revision int64, fn func(key, value []byte) error) error {
+	start := prefix
+	rangeEnd := clientv3.GetPrefixRangeEnd(prefix)
+	for {
+		options := []clientv3.OpOption{clientv3.WithRange(rangeEnd), clientv3.WithLimit(pageSize)}
+		if revision > 0 {
+			options = append(options, clientv3.WithRev(revision))
+		}
+
+		resp, err := etcdClient.Get(ctx, start, options...)
+		if err != nil {
+			return fmt.Errorf("list keys: %w", err)
+		}
+
+		for _, kv := range resp.Kvs {
+			err = fn(kv.Key, kv.Value)
+			if err != nil {
+				return err
+			}
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return nil
+		}
+
+		start = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+// DeleteAll deletes all kubernetes keys within the backing store
+func DeleteAll(ctx context.Context, etcdClient *clientv3.Client) error {
+	return DeletePrefix(ctx, etcdClient, RegistryPrefix)
+}
+
+// DeletePrefix deletes all keys with the given prefix within the backing store
+func DeletePrefix(ctx context.Context, etcdClient *clientv3.Client, prefix string) error {
+	for {
+		resp, err := etcdClient.Get(ctx, prefix, clientv3.WithRange(clientv3.GetPrefixRangeEnd(prefix)), clientv3.WithLimit(pageSize), clientv3.WithKeysOnly())
+		if err != nil {
+			return fmt.Errorf("list keys: %w", err)
+		} else if len(resp.Kvs) == 0 {
+			return nil
+		}
+
+		// kine only supports deletes within transactions, so we use the same
+		// compare and delete pattern as the kubernetes api server
+		for _, kv := range resp.Kvs {
+			_, err = etcdClient.Txn(ctx).
+				If(clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision)).
+				Then(clientv3.OpDelete(string(kv.Key))).
+				Else(clientv3.OpGet(string(kv.Key))).
+				Commit()
+			if err != nil {
+				return fmt.Errorf("delete key %s: %w", string(kv.Key), err)
+			}
+		}
+	}
+}
+
+// Create creates the given key within the backing store if it does not exist yet
+func Create(ctx context.Context, etcdClient *clientv3.Client, key, value []byte) error {
+	resp, err := etcdClient.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(string(key)), "=", 0)).
+		Then(clientv3.OpPut(string(key), string(value))).
+		Commit()
+	if err != nil {
+		return fmt.Errorf("create key %s: %w", string(key), err)
+	} else if !resp.Succeeded {
+		return fmt.Errorf("create key %s: key already exists", string(key))
+	}
+
+	return nil
+}
+
+// Put creates or replaces the given key within the backing store. Like deletes, kine only supports
+// writes within transactions, so we use the compare and put pattern of the kubernetes api server.
+func Put(ctx context.Context, etcdClient *clientv3.Client, key, value []byte) error {
+	for {
+		resp, err := etcdClient.Get(ctx, string(key), clientv3.WithKeysOnly())
+		if err != nil {
+			return fmt.Errorf("get key %s: %w", string(key), err)
+		}
+
+		modRevision := int64(0)
+		if len(resp.Kvs) > 0 {
+			modRevision = resp.Kvs[0].ModRevision
+		}
+
+		txnResp, err := etcdClient.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(string(key)), "=", modRevision)).
+			Then(clientv3.OpPut(string(key), string(value))).
+			Else(clientv3.OpGet(string(key))).
+			Commit()
+		if err != nil {
+			return fmt.Errorf("put key %s: %w", string(key), err)
+		} else if txnResp.Succeeded {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package backingstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/klog/v2"
+)
+
+// MigrationMarkerKey is written into the target backing store before any data is copied. It allows a failed
+// migration to clean up its own leftovers without touching data that was written by anyone else.
+const MigrationMarkerKey = "/vcluster/backingstore-migration"
+
+// MigrateOptions holds the options for migrating data between backing stores
+type MigrateOptions struct {
+	// DryRun only counts the keys that would be migrated without writing anything
+	DryRun bool
+
+	// SkipVerify skips comparing source and target after the migration
+	SkipVerify bool
+}
+
+// Migrate copies all kubernetes keys from the source into the target backing store and returns
+// the amount of migrated keys per resource. Existing keys in the target are only removed if they were written by a
+// previous attempt of the migration, otherwise the migration fails.
+func Migrate(ctx context.Context, source, target *clientv3.Client, options MigrateOptions) (map[string]int, error) {
+	logger := klog.FromContext(ctx)
+
+	// pin the revision so we copy a consistent state
+	resp, err := source.Get(ctx, RegistryPrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return nil, fmt.Errorf("get source revision: %w", err)
+	}
+	revision := resp.Header.Revision
+
+	summary := map[string]int{}
+	if options.DryRun {
+		err = List(ctx, source, revision, func(key, _ []byte) error {
+			summary[ResourceFromKey(key)]++
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return summary, nil
+	}
+
+	// clean up the target, but only if the keys are a leftover from a previous attempt of this migration. Any other
+	// data could belong to someone else, e.g. if the target is a shared database.
+	resp, err = target.Get(ctx, RegistryPrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return nil, fmt.Errorf("count target keys: %w", err)
+	} else if resp.Count > 0 {
+		markerResp, err := target.Get(ctx, MigrationMarkerKey, clientv3.WithCountOnly())
+		if err != nil {
+			return nil, fmt.Errorf("get migration marker: %w", err)
+		} else if markerResp.Count == 0 {
+			return nil, fmt.Errorf("target backing store already contains %d keys that were not written by a previous migration, please make sure the target is empty", resp.Count)
+		}
+
+		logger.Info("Target backing store contains keys of a previous migration attempt, deleting them before migrating", "keys", resp.Count)
+		err = DeleteAll(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// mark the target, so a failed migration can be retried
+	err = Put(ctx, target, []byte(MigrationMarkerKey), []byte(strconv.FormatInt(revision, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("write migration marker: %w", err)
+	}
+
+	err = List(ctx, source, revision, func(key, value []byte) error {
+		summary[ResourceFromKey(key)]++
+		return Create(ctx, target, key, value)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("copy keys: %w", err)
+	}
+
+	if !options.SkipVerify {
+		err = Verify(ctx, source, target, revision)
+		if err != nil {
+			return nil, fmt.Errorf("verify migration: %w", err)
+		}
+	}
+
+	return summary, nil
+}
+
+// Verify checks that every kubernetes key of the source at the given revision exists with the
+// same value in the target and that the target does not contain any other keys.
+func Verify(ctx context.Context, source, target *clientv3.Client, revision int64) error {
+	count := int64(0)
+	err := List(ctx, source, revision, func(key, value []byte) error {
+		resp, err := target.Get(ctx, string(key))
+		if err != nil {
+			return fmt.Errorf("get key %s: %w", string(key), err)
+		} else if len(resp.Kvs) == 0 {
+			return fmt.Errorf("key %s is missing in target", string(key))
+		} else if !bytes.Equal(resp.Kvs[0].Value, value) {
+			return fmt.Errorf("value of key %s differs in target", string(key))
+		}
+
+		count++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := target.Get(ctx, RegistryPrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return fmt.Errorf("count target keys: %w", err)
+	} else if resp.Count != count {
+		return fmt.Errorf("target has %d keys, but expected %d", resp.Count, count)
+	}
+
+	return nil
+}
+
+// ResourceFromKey returns the resource a kubernetes key belongs to, e.g. pods for /registry/pods/default/my-pod
+// or apiregistration.k8s.io/apiservices for /registry/apiregistration.k8s.io/apiservices/v1.apps
+func ResourceFromKey(key []byte) string {
+	segments := strings.Split(strings.TrimPrefix(string(key), RegistryPrefix), "/")
+	if len(segments) > 1 && strings.Contains(segments[0], ".") {
+		return segments[0] + "/" + segments[1]
+	}
+
+	return segments[0]
+}
+
+// NamespaceFromKey returns the namespace a kubernetes key belongs to, e.g. default for /registry/pods/default/my-pod
+// or an empty string for cluster scoped objects such as /registry/namespaces/default
+func NamespaceFromKey(key []byte) string {
+	segments := strings.Split(strings.TrimPrefix(string(key), RegistryPrefix), "/")
+	if len(segments) > 1 && strings.Contains(segments[0], ".") {
+		segments = segments[1:]
+	} else if len(segments) > 1 && segments[0] == "services" {
+		// services are stored as /registry/services/specs/<namespace>/<name>
+		segments = segments[1:]
+	}
+	if len(segments) != 3 {
+		return ""
+	}
+
+	return segments[1]
+}
@@ -0,0 +1,235 @@
+package backingstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"gotest.tools/v3/assert"
+)
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	source, stopSource, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stopSource()
+	target, stopTarget, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stopTarget()
+
+	sourceKeys := map[string]string{
+		"/registry/pods/default/a":                             "a",
+		"/registry/pods/default/b":                             "b",
+		"/registry/namespaces/default":                         "default",
+		"/registry/apiregistration.k8s.io/apiservices/v1.apps": "apps",
+	}
+	putKeys(t, source, sourceKeys)
+	putKeys(t, source, map[string]string{"/other/key": "other"})
+
+	// leftovers of a previous failed migration
+	putKeys(t, target, map[string]string{
+		"/registry/pods/default/a":     "old",
+		"/registry/pods/default/stale": "stale",
+		MigrationMarkerKey:             "1",
+	})
+
+	// a dry run only counts the keys
+	summary, err := Migrate(ctx, source, target, MigrateOptions{DryRun: true})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, summary, map[string]int{"pods": 2, "namespaces": 1, "apiregistration.k8s.io/apiservices": 1})
+	assert.DeepEqual(t, getKeys(t, target, "/registry/"), map[string]string{
+		"/registry/pods/default/a":     "old",
+		"/registry/pods/default/stale": "stale",
+	})
+
+	summary, err = Migrate(ctx, source, target, MigrateOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, summary, map[string]int{"pods": 2, "namespaces": 1, "apiregistration.k8s.io/apiservices": 1})
+	assert.DeepEqual(t, getKeys(t, target, "/registry/"), sourceKeys)
+	assert.DeepEqual(t, getKeys(t, target, "/other/"), map[string]string{})
+}
+
+func TestMigrateNonEmptyTarget(t *testing.T) {
+	ctx := context.Background()
+	source, stopSource, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stopSource()
+	target, stopTarget, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stopTarget()
+
+	putKeys(t, source, map[string]string{"/registry/pods/default/a": "a"})
+
+	// data that was not written by a migration must not be touched
+	targetKeys := map[string]string{"/registry/pods/other/b": "b"}
+	putKeys(t, target, targetKeys)
+
+	_, err = Migrate(ctx, source, target, MigrateOptions{})
+	assert.ErrorContains(t, err, "target backing store already contains 1 keys that were not written by a previous migration")
+	assert.DeepEqual(t, getKeys(t, target, "/registry/"), targetKeys)
+	assert.DeepEqual(t, getKeys(t, target, MigrationMarkerKey), map[string]string{})
+}
+
+func TestMigratePaging(t *testing.T) {
+	ctx := context.Background()
+	source, stopSource, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stopSource()
+	target, stopTarget, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stopTarget()
+
+	// more keys than fit into a single page
+	sourceKeys := map[string]string{}
+	for i := 0; i < pageSize*2+1; i++ {
+		sourceKeys[fmt.Sprintf("/registry/configmaps/default/cm-%d", i)] = fmt.Sprintf("value-%d", i)
+	}
+	putKeys(t, source, sourceKeys)
+
+	summary, err := Migrate(ctx, source, target, MigrateOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, summary, map[string]int{"configmaps": pageSize*2 + 1})
+	assert.DeepEqual(t, getKeys(t, target, "/registry/"), sourceKeys)
+}
+
+func TestVerify(t *testing.T) {
+	testCases := []struct {
+		name       string
+		targetKeys map[string]string
+		wantErr    string
+	}{
+		{
+			name: "equal",
+			targetKeys: map[string]string{
+				"/registry/pods/default/a":     "a",
+				"/registry/namespaces/default": "default",
+			},
+		},
+		{
+			name: "missing key",
+			targetKeys: map[string]string{
+				"/registry/pods/default/a": "a",
+			},
+			wantErr: "key /registry/namespaces/default is missing in target",
+		},
+		{
+			name: "different value",
+			targetKeys: map[string]string{
+				"/registry/pods/default/a":     "b",
+				"/registry/namespaces/default": "default",
+			},
+			wantErr: "value of key /registry/pods/default/a differs in target",
+		},
+		{
+			name: "additional key",
+			targetKeys: map[string]string{
+				"/registry/pods/default/a":     "a",
+				"/registry/pods/default/b":     "b",
+				"/registry/namespaces/default": "default",
+			},
+			wantErr: "target has 3 keys, but expected 2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			source, stopSource, err := testingutil.StartEtcd()
+			assert.NilError(t, err)
+			defer stopSource()
+			target, stopTarget, err := testingutil.StartEtcd()
+			assert.NilError(t, err)
+			defer stopTarget()
+
+			putKeys(t, source, map[string]string{
+				"/registry/pods/default/a":     "a",
+				"/registry/namespaces/default": "default",
+			})
+			resp, err := source.Get(ctx, RegistryPrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+			assert.NilError(t, err)
+			revision := resp.Header.Revision
+
+			// changes after the revision are not verified
+			putKeys(t, source, map[string]string{"/registry/pods/default/c": "c"})
+
+			putKeys(t, target, testCase.targetKeys)
+			err = Verify(ctx, source, target, revision)
+			if testCase.wantErr == "" {
+				assert.NilError(t, err)
+			} else {
+				assert.Error(t, err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteAll(t *testing.T) {
+	ctx := context.Background()
+	etcdClient, stop, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stop()
+
+	keys := map[string]string{}
+	for i := 0; i < pageSize+1; i++ {
+		keys[fmt.Sprintf("/registry/secrets/default/secret-%d", i)] = "secret"
+	}
+	putKeys(t, etcdClient, keys)
+	putKeys(t, etcdClient, map[string]string{"/other/key": "other"})
+
+	assert.NilError(t, DeleteAll(ctx, etcdClient))
+	assert.DeepEqual(t, getKeys(t, etcdClient, "/registry/"), map[string]string{})
+	assert.DeepEqual(t, getKeys(t, etcdClient, "/other/"), map[string]string{"/other/key": "other"})
+}
+
+func TestResourceFromKey(t *testing.T) {
+	testCases := map[string]string{
+		"/registry/pods/default/my-pod":                          "pods",
+		"/registry/namespaces/default":                           "namespaces",
+		"/registry/apiregistration.k8s.io/apiservices/v1.apps":   "apiregistration.k8s.io/apiservices",
+		"/registry/cert-manager.io/certificates/default/my-cert": "cert-manager.io/certificates",
+		"/registry/masterleases":                                 "masterleases",
+		"/registry/services/specs/kube-system/kube-dns":          "services",
+		"/registry/example.com":                                  "example.com",
+	}
+
+	for key, expected := range testCases {
+		assert.Equal(t, ResourceFromKey([]byte(key)), expected, key)
+	}
+}
+
+func TestNamespaceFromKey(t *testing.T) {
+	testCases := map[string]string{
+		"/registry/pods/default/my-pod":                          "default",
+		"/registry/namespaces/default":                           "",
+		"/registry/apiregistration.k8s.io/apiservices/v1.apps":   "",
+		"/registry/cert-manager.io/certificates/default/my-cert": "default",
+		"/registry/masterleases":                                 "",
+		"/registry/services/specs/kube-system/kube-dns":          "kube-system",
+		"/registry/services/endpoints/kube-system/kube-dns":      "kube-system",
+	}
+
+	for key, expected := range testCases {
+		assert.Equal(t, NamespaceFromKey([]byte(key)), expected, key)
+	}
+}
+
+func putKeys(t *testing.T, etcdClient *clientv3.Client, keys map[string]string) {
+	for key, value := range keys {
+		_, err := etcdClient.Put(context.Background(), key, value)
+		assert.NilError(t, err)
+	}
+}
+
+func getKeys(t *testing.T, etcdClient *clientv3.Client, prefix string) map[string]string {
+	resp, err := etcdClient.Get(context.Background(), prefix, clientv3.WithPrefix())
+	assert.NilError(t, err)
+
+	keys := map[string]string{}
+	for _, kv := range resp.Kvs {
+		keys[string(kv.Key)] = string(kv.Value)
+	}
+
+	return keys
+}
@@ -0,0 +1,228 @@
+package certs
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+var (
+	// RotationCheckInterval is the interval in which the certificates are checked for expiry
+	RotationCheckInterval = time.Hour
+
+	// WatchInterval is the interval in which the certs secret is checked for changes
+	WatchInterval = time.Minute
+
+	// RestartTimeout is the maximum time a replica waits for the previous replica to restart after a renewal
+	RestartTimeout = time.Minute * 5
+)
+
+// WatchEnabled returns true if the control plane of the given config renews its certificates and restarts itself
+// to pick up renewed certificates. k0s manages its own certificates.
+func WatchEnabled(vConfig *vclusterconfig.Config) bool {
+	return vConfig.ControlPlane.Advanced.CertRotation.Enabled && vConfig.Distro() != vclusterconfig.K0SDistro
+}
+
+// StartRotationController periodically renews the expiring certificates in the certs secret. This should only
+// run in the leader to avoid conflicting renewals.
+func StartRotationController(ctx context.Context, currentNamespaceClient kubernetes.Interface, currentNamespace, vClusterName string, options RotateOptions) {
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		err := rotateCertsSecret(ctx, currentNamespaceClient, currentNamespace, vClusterName, options)
+		if err != nil {
+			klog.Errorf("Error rotating certificates: %v", err)
+		}
+	}, RotationCheckInterval)
+}
+
+// StartExpiryWarning periodically warns about certificates in the certs secret that expire within renewBefore. This
+// is used instead of the rotation controller if automatic renewal is disabled, so expiring certificates don't go
+// unnoticed.
+func StartExpiryWarning(ctx context.Context, currentNamespaceClient kubernetes.Interface, currentNamespace, vClusterName string, renewBefore time.Duration) {
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		secret, err := currentNamespaceClient.CoreV1().Secrets(currentNamespace).Get(ctx, CertsSecretName(vClusterName), metav1.GetOptions{})
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				klog.V(1).Infof("Error retrieving certs secret: %v", err)
+			}
+			return
+		}
+
+		expiring, err := expiringCertificates(secret, time.Now().Add(renewBefore))
+		if err != nil {
+			klog.Errorf("Error checking certificates: %v", err)
+			return
+		}
+
+		for _, cert := range expiring {
+			klog.Warningf("Certificate %s expires at %s and automatic renewal is disabled, please rotate the certificates with 'vcluster certs rotate' or enable controlPlane.advanced.certRotation", cert.Name, cert.NotAfter.Format(time.RFC3339))
+		}
+	}, RotationCheckInterval)
+}
+
+// expiringCertificates returns the certificates in the certs secret that expire before the given time
+func expiringCertificates(secret *corev1.Secret, before time.Time) ([]CertificateInfo, error) {
+	certificates, err := CheckCertificates(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	expiring := []CertificateInfo{}
+	for _, cert := range certificates {
+		if cert.NotAfter.Before(before) {
+			expiring = append(expiring, cert)
+		}
+	}
+
+	return expiring, nil
+}
+
+// WatchCertsSecret calls onChange once the certificates in the certs secret differ from the ones the
+// control plane was started with, which happens after a rotation.
+func WatchCertsSecret(ctx context.Context, currentNamespaceClient kubernetes.Interface, currentNamespace, vClusterName string, onChange func()) error {
+	secret, err := currentNamespaceClient.CoreV1().Secrets(currentNamespace).Get(ctx, CertsSecretName(vClusterName), metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	startHash := CertificatesHash(secret)
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		secret, err := currentNamespaceClient.CoreV1().Secrets(currentNamespace).Get(ctx, CertsSecretName(vClusterName), metav1.GetOptions{})
+		if err != nil {
+			klog.V(1).Infof("Error retrieving certs secret: %v", err)
+			return
+		} else if CertificatesHash(secret) == startHash {
+			return
+		}
+
+		onChange()
+	}, WatchInterval)
+	return nil
+}
+
+func rotateCertsSecret(ctx context.Context, currentNamespaceClient kubernetes.Interface, currentNamespace, vClusterName string, options RotateOptions) error {
+	secret, err := currentNamespaceClient.CoreV1().Secrets(currentNamespace).Get(ctx, CertsSecretName(vClusterName), metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	rotated, err := RotateCertificates(secret, options)
+	if err != nil {
+		return err
+	} else if len(rotated) == 0 {
+		return nil
+	}
+
+	_, err = currentNamespaceClient.CoreV1().Secrets(currentNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	klog.Infof("Successfully renewed certificates %v in secret %s/%s", rotated, currentNamespace, secret.Name)
+	return nil
+}
+
+// WaitForPreviousReplica staggers the restarts of a highly available control plane after a renewal. Replicas
+// restart in the order of their statefulSet ordinals and wait until the replica before them was restarted and is
+// ready again, so the virtual cluster api server stays available. changedAt is the time the renewal was noticed.
+func WaitForPreviousReplica(ctx context.Context, currentNamespaceClient kubernetes.Interface, currentNamespace, vClusterName, podName string, changedAt time.Time) {
+	podList, err := currentNamespaceClient.CoreV1().Pods(currentNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=vcluster,release=" + vClusterName,
+	})
+	if err != nil {
+		klog.Infof("Error listing control plane replicas, restarting without waiting: %v", err)
+		return
+	}
+
+	previousPodName := previousReplica(podList.Items, podName)
+	if previousPodName == "" {
+		return
+	}
+
+	// the previous replica might have noticed the renewal up to one watch interval earlier
+	restartedAfter := changedAt.Add(-WatchInterval)
+	klog.Infof("Waiting for replica %s to pick up the renewed certificates", previousPodName)
+	err = wait.PollUntilContextTimeout(ctx, time.Second*5, RestartTimeout, true, func(ctx context.Context) (bool, error) {
+		pod, err := currentNamespaceClient.CoreV1().Pods(currentNamespace).Get(ctx, previousPodName, metav1.GetOptions{})
+		if err != nil {
+			// the replica might be recreated right now
+			klog.V(1).Infof("Error retrieving replica %s: %v", previousPodName, err)
+			return false, nil
+		}
+
+		return podRestartedAfter(pod, restartedAfter), nil
+	})
+	if err != nil {
+		klog.Infof("Replica %s did not restart within %s, restarting anyway", previousPodName, RestartTimeout.String())
+	}
+}
+
+// previousReplica returns the name of the replica that restarts right before the given one or an empty string
+// if the given replica restarts first
+func previousReplica(pods []corev1.Pod, podName string) string {
+	ordinal, ok := replicaOrdinal(podName)
+	if !ok {
+		return ""
+	}
+
+	previousPodName := ""
+	previousOrdinal := -1
+	for _, pod := range pods {
+		podOrdinal, ok := replicaOrdinal(pod.Name)
+		if ok && podOrdinal < ordinal && podOrdinal > previousOrdinal {
+			previousPodName = pod.Name
+			previousOrdinal = podOrdinal
+		}
+	}
+
+	return previousPodName
+}
+
+// replicaOrdinal returns the statefulSet ordinal of the given pod name
+func replicaOrdinal(podName string) (int, bool) {
+	idx := strings.LastIndex(podName, "-")
+	if idx == -1 {
+		return 0, false
+	}
+
+	ordinal, err := strconv.Atoi(podName[idx+1:])
+	if err != nil {
+		return 0, false
+	}
+
+	return ordinal, true
+}
+
+// podRestartedAfter returns true if the pod is ready and was started or had its containers restarted after the
+// given time
+func podRestartedAfter(pod *corev1.Pod, restartedAfter time.Time) bool {
+	ready := false
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			ready = condition.Status == corev1.ConditionTrue
+		}
+	}
+	if !ready {
+		return false
+	}
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Running != nil && containerStatus.State.Running.StartedAt.After(restartedAfter) {
+			return true
+		}
+	}
+
+	return false
+}
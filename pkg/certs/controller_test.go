@@ -0,0 +1,108 @@
+package certs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newControlPlanePod(name string, ready bool, startedAt time.Time) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+			Labels:    map[string]string{"app": "vcluster", "release": "vcluster"},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "syncer",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(startedAt)}},
+			}},
+		},
+	}
+}
+
+func TestPreviousReplica(t *testing.T) {
+	pods := []corev1.Pod{
+		*newControlPlanePod("vcluster-1", true, time.Now()),
+		*newControlPlanePod("vcluster-0", true, time.Now()),
+		*newControlPlanePod("vcluster-2", true, time.Now()),
+	}
+
+	assert.Equal(t, previousReplica(pods, "vcluster-0"), "")
+	assert.Equal(t, previousReplica(pods, "vcluster-1"), "vcluster-0")
+	assert.Equal(t, previousReplica(pods, "vcluster-2"), "vcluster-1")
+
+	// replicas are ordered by their ordinal instead of their name
+	pods = append(pods, *newControlPlanePod("vcluster-10", true, time.Now()))
+	assert.Equal(t, previousReplica(pods, "vcluster-10"), "vcluster-2")
+	assert.Equal(t, previousReplica(pods, "vcluster-2"), "vcluster-1")
+}
+
+func TestWaitForPreviousReplica(t *testing.T) {
+	changedAt := time.Now()
+
+	// the previous replica was already restarted after the renewal
+	kubeClient := fake.NewSimpleClientset(
+		newControlPlanePod("vcluster-0", true, changedAt.Add(time.Second)),
+		newControlPlanePod("vcluster-1", true, changedAt.Add(-time.Hour)),
+	)
+	done := make(chan struct{})
+	go func() {
+		WaitForPreviousReplica(context.TODO(), kubeClient, "test", "vcluster", "vcluster-1", changedAt)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second * 10):
+		t.Fatal("expected replica to restart without waiting")
+	}
+
+	// the previous replica still runs with the old certificates
+	kubeClient = fake.NewSimpleClientset(
+		newControlPlanePod("vcluster-0", true, changedAt.Add(-time.Hour)),
+		newControlPlanePod("vcluster-1", true, changedAt.Add(-time.Hour)),
+	)
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+	WaitForPreviousReplica(ctx, kubeClient, "test", "vcluster", "vcluster-1", changedAt)
+	assert.Assert(t, ctx.Err() != nil, "expected replica to wait for the previous replica")
+}
+
+func TestWatchEnabled(t *testing.T) {
+	vConfig := &vclusterconfig.Config{}
+	assert.Assert(t, !WatchEnabled(vConfig))
+
+	vConfig.ControlPlane.Advanced.CertRotation.Enabled = true
+	assert.Assert(t, WatchEnabled(vConfig))
+
+	vConfig.ControlPlane.Distro.K0S.Enabled = true
+	assert.Assert(t, !WatchEnabled(vConfig))
+}
+
+func TestExpiringCertificates(t *testing.T) {
+	secret := newTestCertsSecret(t)
+	certificates, err := CheckCertificates(secret)
+	assert.NilError(t, err)
+	assert.Assert(t, len(certificates) > 0)
+
+	expiring, err := expiringCertificates(secret, time.Now())
+	assert.NilError(t, err)
+	assert.Equal(t, len(expiring), 0)
+
+	expiring, err = expiringCertificates(secret, time.Now().Add(time.Hour*24*365*200))
+	assert.NilError(t, err)
+	assert.Equal(t, len(expiring), len(certificates))
+}
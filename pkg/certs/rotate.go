@@ -0,0 +1,388 @@
+package certs
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/keyutil"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// CAOverlapUntilAnnotation is set on the certs secret while the old certificate authorities are still trusted
+	CAOverlapUntilAnnotation = "vcluster.loft.sh/ca-overlap-until"
+)
+
+// CertificateInfo describes a single certificate within the certs secret
+type CertificateInfo struct {
+	// Name is the key of the certificate in the certs secret
+	Name string `json:"name"`
+
+	// CommonName is the common name of the certificate subject
+	CommonName string `json:"commonName"`
+
+	// NotAfter is the time the certificate expires
+	NotAfter time.Time `json:"notAfter"`
+
+	// IsCA is true if the certificate is a certificate authority
+	IsCA bool `json:"isCA,omitempty"`
+
+	// KubeConfig is true if the certificate is the client certificate of a kubeconfig
+	KubeConfig bool `json:"kubeConfig,omitempty"`
+}
+
+// RotateOptions define which certificates are renewed by RotateCertificates
+type RotateOptions struct {
+	// RenewBefore renews certificates that expire within the given duration
+	RenewBefore time.Duration
+
+	// Force renews all leaf certificates regardless of their expiry
+	Force bool
+
+	// RotateCA rotates certificate authorities that expire within RenewBefore or all of them if Force is set
+	RotateCA bool
+
+	// CAOverlap is the period in which the old certificate authority is still trusted after a rotation
+	CAOverlap time.Duration
+}
+
+// CertsSecretName returns the name of the secret the control plane certificates are stored in
+func CertsSecretName(vClusterName string) string {
+	return vClusterName + "-certs"
+}
+
+// CheckCertificates returns the certificates and kubeconfig client certificates within the certs secret
+func CheckCertificates(secret *corev1.Secret) ([]CertificateInfo, error) {
+	names := make([]string, 0, len(secret.Data))
+	for name := range secret.Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	retCerts := []CertificateInfo{}
+	for _, name := range names {
+		switch {
+		case strings.HasSuffix(name, ".crt"):
+			certs, err := certutil.ParseCertsPEM(secret.Data[name])
+			if err != nil {
+				return nil, fmt.Errorf("parse %s: %w", name, err)
+			}
+
+			// there might be multiple certificate authorities during a rotation
+			for _, cert := range certs {
+				retCerts = append(retCerts, CertificateInfo{
+					Name:       name,
+					CommonName: cert.Subject.CommonName,
+					NotAfter:   cert.NotAfter,
+					IsCA:       cert.IsCA,
+				})
+			}
+		case strings.HasSuffix(name, ".conf"):
+			kubeConfig, err := clientcmd.Load(secret.Data[name])
+			if err != nil {
+				return nil, fmt.Errorf("parse %s: %w", name, err)
+			}
+
+			for _, authInfo := range kubeConfig.AuthInfos {
+				if len(authInfo.ClientCertificateData) == 0 {
+					continue
+				}
+
+				cert, err := parseCert(authInfo.ClientCertificateData)
+				if err != nil {
+					return nil, fmt.Errorf("parse client certificate of %s: %w", name, err)
+				}
+
+				retCerts = append(retCerts, CertificateInfo{
+					Name:       name,
+					CommonName: cert.Subject.CommonName,
+					NotAfter:   cert.NotAfter,
+					KubeConfig: true,
+				})
+			}
+		}
+	}
+
+	return retCerts, nil
+}
+
+// RotateCertificates renews the expiring certificates and kubeconfigs in the certs secret and returns
+// the names of the renewed entries. Only the secret object is modified, it's up to the caller to update it.
+func RotateCertificates(secret *corev1.Secret, options RotateOptions) ([]string, error) {
+	now := time.Now()
+	rotated := []string{}
+	caRotated := map[string]bool{}
+	caBundleChanged := map[string]bool{}
+
+	// stop trusting the old certificate authorities once the overlap period is over
+	if overlapUntil, ok := secret.Annotations[CAOverlapUntilAnnotation]; ok {
+		until, err := time.Parse(time.RFC3339, overlapUntil)
+		if err != nil || now.After(until) {
+			for _, ca := range certificateAuthorities() {
+				crtName := certMap[ca.BaseName+".crt"]
+				certs, err := certutil.ParseCertsPEM(secret.Data[crtName])
+				if err != nil || len(certs) < 2 {
+					continue
+				}
+
+				secret.Data[crtName] = EncodeCertPEM(certs[0])
+				caBundleChanged[ca.Name] = true
+				rotated = append(rotated, crtName)
+			}
+
+			delete(secret.Annotations, CAOverlapUntilAnnotation)
+		}
+	}
+
+	for _, ca := range certificateAuthorities() {
+		crtName, keyName := certMap[ca.BaseName+".crt"], certMap[ca.BaseName+".key"]
+		if len(secret.Data[crtName]) == 0 || len(secret.Data[keyName]) == 0 {
+			continue
+		}
+
+		caCert, err := parseCert(secret.Data[crtName])
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", crtName, err)
+		}
+		caKey, err := parseKey(secret.Data[keyName])
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", keyName, err)
+		}
+
+		caExpiring := expiresWithin(caCert, now, options.RenewBefore)
+		if caExpiring && !options.RotateCA {
+			klog.Warningf("Certificate authority %s expires at %s, but certificate authority rotation is disabled", crtName, caCert.NotAfter.Format(time.RFC3339))
+		}
+
+		rotateCA := options.RotateCA && (options.Force || caExpiring)
+		if rotateCA {
+			newCACert, newCAKey, err := NewCertificateAuthority(&CertConfig{
+				Config: certutil.Config{
+					CommonName:   caCert.Subject.CommonName,
+					Organization: caCert.Subject.Organization,
+				},
+				PublicKeyAlgorithm: caCert.PublicKeyAlgorithm,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("rotate %s: %w", crtName, err)
+			}
+			encodedKey, err := keyutil.MarshalPrivateKeyToPEM(newCAKey)
+			if err != nil {
+				return nil, fmt.Errorf("encode %s: %w", keyName, err)
+			}
+
+			// trust the old and the new certificate authority during the overlap period
+			secret.Data[crtName] = append(EncodeCertPEM(newCACert), EncodeCertPEM(caCert)...)
+			secret.Data[keyName] = encodedKey
+			if secret.Annotations == nil {
+				secret.Annotations = map[string]string{}
+			}
+			secret.Annotations[CAOverlapUntilAnnotation] = now.Add(options.CAOverlap).UTC().Format(time.RFC3339)
+
+			caCert, caKey = newCACert, newCAKey
+			caRotated[ca.Name] = true
+			caBundleChanged[ca.Name] = true
+			rotated = append(rotated, crtName)
+		}
+
+		for _, leaf := range certificatesSignedBy(ca.Name) {
+			leafCrtName, leafKeyName := certMap[leaf.BaseName+".crt"], certMap[leaf.BaseName+".key"]
+			if len(secret.Data[leafCrtName]) == 0 {
+				continue
+			}
+
+			leafCert, err := parseCert(secret.Data[leafCrtName])
+			if err != nil {
+				return nil, fmt.Errorf("parse %s: %w", leafCrtName, err)
+			} else if !rotateCA && !options.Force && !expiresWithin(leafCert, now, options.RenewBefore) {
+				continue
+			}
+
+			newCert, encodedKey, err := renewCertificate(leafCert, caCert, caKey)
+			if err != nil {
+				return nil, fmt.Errorf("renew %s: %w", leafCrtName, err)
+			}
+
+			secret.Data[leafCrtName] = EncodeCertPEM(newCert)
+			secret.Data[leafKeyName] = encodedKey
+			rotated = append(rotated, leafCrtName)
+		}
+	}
+
+	// renew the kubeconfigs, these are signed by the kubernetes certificate authority
+	caCrtName, caKeyName := certMap[CACertName], certMap[CAKeyName]
+	for _, kubeConfigName := range []string{AdminKubeConfigFileName, ControllerManagerKubeConfigFileName, SchedulerKubeConfigFileName} {
+		if len(secret.Data[kubeConfigName]) == 0 || len(secret.Data[caCrtName]) == 0 || len(secret.Data[caKeyName]) == 0 {
+			continue
+		}
+
+		rootCAName := KubeadmCertRootCA().Name
+		renewed, err := renewKubeConfig(secret, kubeConfigName, caRotated[rootCAName], caBundleChanged[rootCAName], now, options)
+		if err != nil {
+			return nil, fmt.Errorf("renew %s: %w", kubeConfigName, err)
+		} else if renewed {
+			rotated = append(rotated, kubeConfigName)
+		}
+	}
+
+	return rotated, nil
+}
+
+// CertificatesHash returns a hash over all certificates, keys and kubeconfigs in the certs secret
+func CertificatesHash(secret *corev1.Secret) string {
+	names := []string{}
+	for _, name := range certMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		hash.Write([]byte(name))
+		hash.Write(secret.Data[name])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func renewKubeConfig(secret *corev1.Secret, kubeConfigName string, caRotated, caBundleChanged bool, now time.Time, options RotateOptions) (bool, error) {
+	kubeConfig, err := clientcmd.Load(secret.Data[kubeConfigName])
+	if err != nil {
+		return false, err
+	}
+
+	caCert, err := parseCert(secret.Data[certMap[CACertName]])
+	if err != nil {
+		return false, err
+	}
+	caKey, err := parseKey(secret.Data[certMap[CAKeyName]])
+	if err != nil {
+		return false, err
+	}
+
+	renewed := false
+	for _, authInfo := range kubeConfig.AuthInfos {
+		if len(authInfo.ClientCertificateData) == 0 {
+			continue
+		}
+
+		clientCert, err := parseCert(authInfo.ClientCertificateData)
+		if err != nil {
+			return false, err
+		} else if !caRotated && !options.Force && !expiresWithin(clientCert, now, options.RenewBefore) {
+			continue
+		}
+
+		newCert, encodedKey, err := renewCertificate(clientCert, caCert, caKey)
+		if err != nil {
+			return false, err
+		}
+
+		authInfo.ClientCertificateData = EncodeCertPEM(newCert)
+		authInfo.ClientKeyData = encodedKey
+		renewed = true
+	}
+	if caBundleChanged {
+		for _, cluster := range kubeConfig.Clusters {
+			cluster.CertificateAuthorityData = secret.Data[certMap[CACertName]]
+		}
+		renewed = true
+	}
+	if !renewed {
+		return false, nil
+	}
+
+	out, err := clientcmd.Write(*kubeConfig)
+	if err != nil {
+		return false, err
+	}
+
+	secret.Data[kubeConfigName] = out
+	return true, nil
+}
+
+// renewCertificate creates a new certificate and key with the same subject, alternative names and usages
+func renewCertificate(cert *x509.Certificate, caCert *x509.Certificate, caKey crypto.Signer) (*x509.Certificate, []byte, error) {
+	newCert, newKey, err := NewCertAndKey(caCert, caKey, &CertConfig{
+		Config: certutil.Config{
+			CommonName:   cert.Subject.CommonName,
+			Organization: cert.Subject.Organization,
+			AltNames: certutil.AltNames{
+				DNSNames: cert.DNSNames,
+				IPs:      cert.IPAddresses,
+			},
+			Usages: cert.ExtKeyUsage,
+		},
+		PublicKeyAlgorithm: cert.PublicKeyAlgorithm,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encodedKey, err := keyutil.MarshalPrivateKeyToPEM(newKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newCert, encodedKey, nil
+}
+
+func certificateAuthorities() Certificates {
+	retCerts := Certificates{}
+	for _, cert := range GetDefaultCertList() {
+		if cert.CAName == "" {
+			retCerts = append(retCerts, cert)
+		}
+	}
+
+	return retCerts
+}
+
+func certificatesSignedBy(caName string) Certificates {
+	retCerts := Certificates{}
+	for _, cert := range GetDefaultCertList() {
+		if cert.CAName == caName {
+			retCerts = append(retCerts, cert)
+		}
+	}
+
+	return retCerts
+}
+
+func expiresWithin(cert *x509.Certificate, now time.Time, duration time.Duration) bool {
+	return cert.NotAfter.Before(now.Add(duration))
+}
+
+// parseCert returns the first certificate of the given pem data
+func parseCert(data []byte) (*x509.Certificate, error) {
+	certs, err := certutil.ParseCertsPEM(bytes.TrimSpace(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return certs[0], nil
+}
+
+func parseKey(data []byte) (crypto.Signer, error) {
+	key, err := keyutil.ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("private key is not a signer")
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,108 @@
+package certs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func newTestCertsSecret(t *testing.T) *corev1.Secret {
+	certificateDir := t.TempDir()
+	err := generateCertificates("10.96.0.0/12", "test", certificateDir, "cluster.local", []string{"localhost"})
+	assert.NilError(t, err)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: CertsSecretName("test")},
+		Data:       map[string][]byte{},
+	}
+	for fromName, toName := range certMap {
+		data, err := os.ReadFile(filepath.Join(certificateDir, fromName))
+		assert.NilError(t, err)
+		secret.Data[toName] = data
+	}
+
+	return secret
+}
+
+func TestRotateCertificates(t *testing.T) {
+	secret := newTestCertsSecret(t)
+	before := secret.DeepCopy()
+
+	// nothing expires within a day
+	rotated, err := RotateCertificates(secret, RotateOptions{RenewBefore: 24 * time.Hour})
+	assert.NilError(t, err)
+	assert.Equal(t, len(rotated), 0)
+	assert.Equal(t, CertificatesHash(secret), CertificatesHash(before))
+
+	// everything expires within 20 years
+	rotated, err = RotateCertificates(secret, RotateOptions{RenewBefore: 20 * 365 * 24 * time.Hour})
+	assert.NilError(t, err)
+	assert.Assert(t, len(rotated) > 0)
+	assert.Assert(t, CertificatesHash(secret) != CertificatesHash(before))
+	assert.Assert(t, bytes.Equal(secret.Data[CACertName], before.Data[CACertName]), "ca should not be rotated")
+	assert.Assert(t, !bytes.Equal(secret.Data[APIServerCertName], before.Data[APIServerCertName]), "apiserver cert should be renewed")
+
+	// renewed certificates keep their subject and alternative names and are signed by the same ca
+	caCert, err := parseCert(secret.Data[CACertName])
+	assert.NilError(t, err)
+	oldCert, err := parseCert(before.Data[APIServerCertName])
+	assert.NilError(t, err)
+	newCert, err := parseCert(secret.Data[APIServerCertName])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, newCert.DNSNames, oldCert.DNSNames)
+	assert.Equal(t, newCert.Subject.CommonName, oldCert.Subject.CommonName)
+	assert.NilError(t, VerifyCertChain(newCert, nil, caCert))
+
+	kubeConfig, err := clientcmd.Load(secret.Data[AdminKubeConfigFileName])
+	assert.NilError(t, err)
+	for _, authInfo := range kubeConfig.AuthInfos {
+		clientCert, err := parseCert(authInfo.ClientCertificateData)
+		assert.NilError(t, err)
+		assert.NilError(t, VerifyCertChain(clientCert, nil, caCert))
+	}
+}
+
+func TestRotateCertificateAuthorities(t *testing.T) {
+	secret := newTestCertsSecret(t)
+	oldCACert, err := parseCert(secret.Data[CACertName])
+	assert.NilError(t, err)
+
+	rotated, err := RotateCertificates(secret, RotateOptions{Force: true, RotateCA: true, CAOverlap: time.Hour})
+	assert.NilError(t, err)
+	assert.Assert(t, len(rotated) > 0)
+	assert.Assert(t, secret.Annotations[CAOverlapUntilAnnotation] != "")
+
+	// the old ca is still trusted
+	caCerts, err := certutil.ParseCertsPEM(secret.Data[CACertName])
+	assert.NilError(t, err)
+	assert.Equal(t, len(caCerts), 2)
+	assert.Assert(t, caCerts[1].Equal(oldCACert))
+	apiServerCert, err := parseCert(secret.Data[APIServerCertName])
+	assert.NilError(t, err)
+	assert.NilError(t, VerifyCertChain(apiServerCert, nil, caCerts[0]))
+
+	kubeConfig, err := clientcmd.Load(secret.Data[AdminKubeConfigFileName])
+	assert.NilError(t, err)
+	for _, cluster := range kubeConfig.Clusters {
+		assert.Assert(t, bytes.Equal(cluster.CertificateAuthorityData, secret.Data[CACertName]))
+	}
+
+	// stop trusting the old ca after the overlap period
+	secret.Annotations[CAOverlapUntilAnnotation] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	_, err = RotateCertificates(secret, RotateOptions{})
+	assert.NilError(t, err)
+	_, ok := secret.Annotations[CAOverlapUntilAnnotation]
+	assert.Assert(t, !ok)
+	caCerts, err = certutil.ParseCertsPEM(secret.Data[CACertName])
+	assert.NilError(t, err)
+	assert.Equal(t, len(caCerts), 1)
+	assert.NilError(t, VerifyCertChain(apiServerCert, nil, caCerts[0]))
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/log/table"
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/certs"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type CertsCheckOptions struct {
+	Output string
+}
+
+type CertsRotateOptions struct {
+	CA        bool
+	CAOverlap time.Duration
+}
+
+// CertsCheckHelm prints the expiry of all certificates of the given virtual cluster
+func CertsCheckHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *CertsCheckOptions, log log.Logger) error {
+	_, secret, err := getCertsSecret(ctx, globalFlags, vClusterName, log)
+	if err != nil {
+		return err
+	}
+
+	certInfos, err := certs.CheckCertificates(secret)
+	if err != nil {
+		return err
+	}
+
+	if options.Output == "json" {
+		out, err := json.MarshalIndent(certInfos, "", "    ")
+		if err != nil {
+			return fmt.Errorf("json marshal certificates: %w", err)
+		}
+
+		log.WriteString(logrus.InfoLevel, string(out)+"\n")
+		return nil
+	} else if options.Output != "" && options.Output != "table" {
+		return fmt.Errorf("unsupported output format %s, please use table or json", options.Output)
+	}
+
+	header := []string{"NAME", "COMMON NAME", "EXPIRES", "RESIDUAL TIME", "CA", "KUBECONFIG"}
+	values := [][]string{}
+	for _, certInfo := range certInfos {
+		residualTime := "expired"
+		if time.Until(certInfo.NotAfter) > 0 {
+			residualTime = duration.HumanDuration(time.Until(certInfo.NotAfter))
+		}
+
+		values = append(values, []string{
+			certInfo.Name,
+			certInfo.CommonName,
+			certInfo.NotAfter.Format(time.RFC3339),
+			residualTime,
+			boolToString(certInfo.IsCA),
+			boolToString(certInfo.KubeConfig),
+		})
+	}
+
+	table.PrintTable(log, header, values)
+	if overlapUntil, ok := secret.Annotations[certs.CAOverlapUntilAnnotation]; ok {
+		log.Infof("The previous certificate authorities are still trusted until %s", overlapUntil)
+	}
+
+	return nil
+}
+
+// CertsRotateHelm renews all leaf certificates and kubeconfigs and optionally the certificate authorities of the
+// given virtual cluster. The control plane picks up the new certificates by restarting itself.
+func CertsRotateHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *CertsRotateOptions, log log.Logger) error {
+	kubeClient, secret, err := getCertsSecret(ctx, globalFlags, vClusterName, log)
+	if err != nil {
+		return err
+	}
+
+	rotated, err := certs.RotateCertificates(secret, certs.RotateOptions{
+		Force:     true,
+		RotateCA:  options.CA,
+		CAOverlap: options.CAOverlap,
+	})
+	if err != nil {
+		return fmt.Errorf("rotate certificates: %w", err)
+	}
+
+	_, err = kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("update certs secret: %w", err)
+	}
+
+	log.Donef("Successfully renewed %d certificates of vcluster %s in namespace %s", len(rotated), vClusterName, secret.Namespace)
+	if certsWatched(ctx, kubeClient, vClusterName, secret.Namespace) {
+		log.Info("The vcluster control plane will restart shortly to pick up the new certificates")
+	} else {
+		log.Infof("Please restart the vcluster control plane to pick up the new certificates, e.g. via 'kubectl rollout restart statefulset %s -n %s'", vClusterName, secret.Namespace)
+	}
+	if options.CA {
+		log.Infof("The previous certificate authorities are trusted until %s, please make sure to update kubeconfigs that were retrieved before, e.g. by running 'vcluster connect %s' again", secret.Annotations[certs.CAOverlapUntilAnnotation], vClusterName)
+	}
+
+	return nil
+}
+
+// certsWatched returns true if the control plane restarts itself after its certificates were renewed
+func certsWatched(ctx context.Context, kubeClient kubernetes.Interface, vClusterName, namespace string) bool {
+	configSecret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, "vc-config-"+vClusterName, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+
+	vConfig := &vclusterconfig.Config{}
+	err = yaml.Unmarshal(configSecret.Data["config.yaml"], vConfig)
+	if err != nil {
+		return false
+	}
+
+	return certs.WatchEnabled(vConfig)
+}
+
+func getCertsSecret(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, log log.Logger) (kubernetes.Interface, *corev1.Secret, error) {
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret, err := kubeClient.CoreV1().Secrets(vCluster.Namespace).Get(ctx, certs.CertsSecretName(vCluster.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get certs secret: %w", err)
+	}
+
+	return kubeClient, secret, nil
+}
+
+func boolToString(value bool) string {
+	if value {
+		return "True"
+	}
+
+	return ""
+}
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/backingstore"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/snapshot"
+	"github.com/loft-sh/vcluster/pkg/util/servicecidr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+type CloneOptions struct {
+	ToNamespace       string
+	ChartRepo         string
+	ExcludeNamespaces []string
+	ExcludeSecrets    bool
+	ExcludeWorkloads  bool
+}
+
+// cloneWorkloadResources are the resources that are left out of a clone if workloads are excluded
+var cloneWorkloadResources = []string{
+	"pods",
+	"deployments",
+	"replicasets",
+	"statefulsets",
+	"daemonsets",
+	"jobs",
+	"cronjobs",
+	"controllerrevisions",
+}
+
+// cloneHostBoundResources are always left out of a clone, because they reference state of the source
+// that is not valid for the clone. Volumes are bound to the host volumes of the source, events and leases
+// refer to source objects and control plane instances.
+var cloneHostBoundResources = []string{
+	"events",
+	"leases",
+	"masterleases",
+	"persistentvolumes",
+	"persistentvolumeclaims",
+}
+
+// CloneHelm duplicates the virtual cluster into a new virtual cluster with the given name. It takes a snapshot of
+// the source virtual cluster, installs the same chart version with the same values under the new name and restores
+// the filtered snapshot there. The clone gets its own certificates and syncs all objects to the host cluster under
+// its own name, so source and clone can run side by side.
+func CloneHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName, targetName string, options *CloneOptions, log log.Logger) error {
+	if slices.Contains(options.ExcludeNamespaces, "default") || slices.Contains(options.ExcludeNamespaces, "kube-system") {
+		return fmt.Errorf("namespaces default and kube-system cannot be excluded")
+	}
+
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+	if err != nil {
+		return err
+	} else if vCluster.Status != find.StatusRunning {
+		return fmt.Errorf("vcluster %s/%s is %s, please make sure it is running before cloning it", vCluster.Namespace, vCluster.Name, vCluster.Status)
+	}
+
+	sourceFlags := *globalFlags
+	sourceFlags.Context = vCluster.Context
+	sourceFlags.Namespace = vCluster.Namespace
+	targetFlags := sourceFlags
+	if options.ToNamespace != "" {
+		targetFlags.Namespace = options.ToNamespace
+	}
+	if targetName == vCluster.Name && targetFlags.Namespace == vCluster.Namespace {
+		return fmt.Errorf("source and target of the clone are the same, please specify a different name or --to-namespace")
+	}
+	_, err = find.GetVCluster(ctx, targetFlags.Context, targetName, targetFlags.Namespace, log)
+	if err == nil {
+		return fmt.Errorf("vcluster %s/%s already exists", targetFlags.Namespace, targetName)
+	}
+
+	// retrieve the chart and values of the source
+	_, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return err
+	}
+	createOptions, cleanup, err := migrateCreateOptions(ctx, kubeClient, vCluster, options.ChartRepo)
+	if cleanup != nil {
+		defer cleanup()
+	}
+	if err != nil {
+		return err
+	}
+	serviceCIDRCleanup, err := cloneServiceCIDRValues(ctx, kubeClient, vCluster, createOptions, log)
+	if serviceCIDRCleanup != nil {
+		defer serviceCIDRCleanup()
+	}
+	if err != nil {
+		return err
+	}
+
+	// snapshot the backing store of the source, which keeps running
+	snapshotFile, err := os.CreateTemp("", vCluster.Name+"-*.snapshot.gz")
+	if err != nil {
+		return fmt.Errorf("create temp snapshot file: %w", err)
+	}
+	_ = snapshotFile.Close()
+	defer os.Remove(snapshotFile.Name())
+
+	err = SnapshotHelm(ctx, &sourceFlags, vCluster.Name, &SnapshotOptions{Output: snapshotFile.Name()}, log)
+	if err != nil {
+		return err
+	}
+
+	filteredFile, err := cloneFilterSnapshot(snapshotFile.Name(), options, log)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(filteredFile)
+
+	// the certificates of the source are left out, so the clone keeps the certificates generated during installation
+	err = migrateToTarget(ctx, &targetFlags, targetName, createOptions, &RestoreOptions{
+		Input:     filteredFile,
+		SkipCerts: true,
+	}, log)
+	if err != nil {
+		return fmt.Errorf("%w\n- Use `vcluster delete %s -n %s` to remove the incomplete clone", err, targetName, targetFlags.Namespace)
+	}
+
+	log.Donef("Successfully cloned vcluster %s/%s to %s/%s", vCluster.Namespace, vCluster.Name, targetFlags.Namespace, targetName)
+	return nil
+}
+
+// cloneServiceCIDRValues detects the service cidr of the host cluster and adds it as values file to the create
+// options, so the clone does not inherit a service cidr the source was configured with. The returned function
+// removes the temporary values file.
+func cloneServiceCIDRValues(ctx context.Context, kubeClient kubernetes.Interface, vCluster *find.VCluster, createOptions *CreateOptions, log log.Logger) (func(), error) {
+	// source and clone share the host cluster, so we can detect the service cidr within the source namespace
+	// that exists already
+	serviceCIDR, warning := servicecidr.GetServiceCIDR(ctx, kubeClient, vCluster.Namespace)
+	if warning != "" {
+		log.Warn(warning)
+	}
+	if serviceCIDR == servicecidr.FallbackCIDR && warning != "" {
+		// detection failed, the clone will try again on startup
+		return nil, nil
+	}
+
+	valuesFile, err := os.CreateTemp("", vCluster.Name+"-*.service-cidr.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("create temp values file: %w", err)
+	}
+	cleanup := func() {
+		_ = os.Remove(valuesFile.Name())
+	}
+	_, err = fmt.Fprintf(valuesFile, "serviceCIDR: %q\n", serviceCIDR)
+	_ = valuesFile.Close()
+	if err != nil {
+		return cleanup, fmt.Errorf("write temp values file: %w", err)
+	}
+
+	createOptions.Values = append(createOptions.Values, valuesFile.Name())
+	return cleanup, nil
+}
+
+// cloneFilterSnapshot writes a copy of the given snapshot without the excluded keys and returns its path
+func cloneFilterSnapshot(input string, options *CloneOptions, log log.Logger) (string, error) {
+	in, err := os.Open(input)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+
+	out, err := os.CreateTemp("", "clone-*.snapshot.gz")
+	if err != nil {
+		return "", fmt.Errorf("create temp snapshot file: %w", err)
+	}
+	defer out.Close()
+
+	excluded, err := snapshot.Filter(in, out, cloneExcludeFunc(options))
+	if err != nil {
+		_ = os.Remove(out.Name())
+		return "", fmt.Errorf("filter snapshot: %w", err)
+	}
+
+	log.Debugf("Left out %d keys of the snapshot", excluded)
+	return out.Name(), nil
+}
+
+// cloneExcludeFunc returns a function that decides whether a backing store key is left out of the clone
+func cloneExcludeFunc(options *CloneOptions) func(key, value []byte) bool {
+	return func(key, value []byte) bool {
+		resource := backingstore.ResourceFromKey(key)
+		namespace := backingstore.NamespaceFromKey(key)
+		if slices.Contains(cloneHostBoundResources, resource) {
+			return true
+		}
+
+		// the root ca config maps are published again with the certificate of the clone
+		if resource == "configmaps" && bytes.HasSuffix(key, []byte("/kube-root-ca.crt")) {
+			return true
+		}
+
+		if resource == "namespaces" {
+			namespace = string(bytes.TrimPrefix(key, []byte(backingstore.RegistryPrefix+"namespaces/")))
+		}
+		if namespace != "" && slices.Contains(options.ExcludeNamespaces, namespace) {
+			return true
+		}
+
+		if resource == "secrets" && (options.ExcludeSecrets || isServiceAccountTokenSecret(value)) {
+			return true
+		}
+
+		return options.ExcludeWorkloads && slices.Contains(cloneWorkloadResources, resource)
+	}
+}
+
+// isServiceAccountTokenSecret returns true if the given backing store value is a legacy service account token
+// secret. These tokens are signed by the service account key of the source and are invalid within the clone,
+// which gets its own certificates.
+func isServiceAccountTokenSecret(value []byte) bool {
+	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(value, nil, nil)
+	if err != nil {
+		return false
+	}
+
+	secret, ok := obj.(*corev1.Secret)
+	return ok && secret.Type == corev1.SecretTypeServiceAccountToken
+}
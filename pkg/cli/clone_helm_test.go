@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer/protobuf"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestCloneExcludeFunc(t *testing.T) {
+	tokenSecret := encodeSecret(t, corev1.SecretTypeServiceAccountToken)
+	opaqueSecret := encodeSecret(t, corev1.SecretTypeOpaque)
+
+	testCases := []struct {
+		name     string
+		options  CloneOptions
+		key      string
+		value    []byte
+		expected bool
+	}{
+		{
+			name: "keep pod",
+			key:  "/registry/pods/default/test",
+		},
+		{
+			name:     "exclude events",
+			key:      "/registry/events/default/test.123",
+			expected: true,
+		},
+		{
+			name:     "exclude root ca",
+			key:      "/registry/configmaps/default/kube-root-ca.crt",
+			expected: true,
+		},
+		{
+			name:     "exclude namespace",
+			options:  CloneOptions{ExcludeNamespaces: []string{"test"}},
+			key:      "/registry/namespaces/test",
+			expected: true,
+		},
+		{
+			name:     "exclude namespaced object",
+			options:  CloneOptions{ExcludeNamespaces: []string{"test"}},
+			key:      "/registry/services/specs/test/nginx",
+			expected: true,
+		},
+		{
+			name:     "exclude custom resource",
+			options:  CloneOptions{ExcludeNamespaces: []string{"test"}},
+			key:      "/registry/cert-manager.io/certificates/test/cert",
+			expected: true,
+		},
+		{
+			name:    "keep cluster scoped object",
+			options: CloneOptions{ExcludeNamespaces: []string{"test"}},
+			key:     "/registry/clusterroles/test",
+		},
+		{
+			name:     "exclude secrets",
+			options:  CloneOptions{ExcludeSecrets: true},
+			key:      "/registry/secrets/default/test",
+			expected: true,
+		},
+		{
+			name:  "keep secrets",
+			key:   "/registry/secrets/default/test",
+			value: opaqueSecret,
+		},
+		{
+			name:     "exclude service account token secrets",
+			key:      "/registry/secrets/default/test-token",
+			value:    tokenSecret,
+			expected: true,
+		},
+		{
+			name:     "exclude workloads",
+			options:  CloneOptions{ExcludeWorkloads: true},
+			key:      "/registry/deployments/default/test",
+			expected: true,
+		},
+		{
+			name:    "keep config maps with excluded workloads",
+			options: CloneOptions{ExcludeWorkloads: true},
+			key:     "/registry/configmaps/default/test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, cloneExcludeFunc(&testCase.options)([]byte(testCase.key), testCase.value), testCase.expected)
+		})
+	}
+}
+
+// encodeSecret encodes a secret of the given type the way the api server stores it in the backing store
+func encodeSecret(t *testing.T, secretType corev1.SecretType) []byte {
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Type:       secretType,
+	}
+
+	data, err := runtime.Encode(protobuf.NewSerializer(scheme.Scheme, scheme.Scheme), secret)
+	assert.NilError(t, err)
+	return data
+}
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"cmp"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -14,10 +16,13 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/cli/find"
 	"github.com/loft-sh/vcluster/pkg/cli/flags"
 	"github.com/loft-sh/vcluster/pkg/cli/localkubernetes"
+	"github.com/loft-sh/vcluster/pkg/helm"
 	"github.com/loft-sh/vcluster/pkg/lifecycle"
 	"github.com/loft-sh/vcluster/pkg/util/clihelper"
 	"github.com/loft-sh/vcluster/pkg/util/portforward"
@@ -54,6 +59,12 @@ type ConnectOptions struct {
 	BackgroundProxy           bool
 	Insecure                  bool
 
+	OIDC             bool
+	OIDCIssuerURL    string
+	OIDCClientID     string
+	OIDCClientSecret string
+	OIDCExtraScopes  []string
+
 	Project string
 }
 
@@ -411,9 +422,80 @@ func (cmd *connectHelm) getVClusterKubeConfig(ctx context.Context, vclusterName
 		}
 	}
 
+	// we want to use an oidc id token in the kube config
+	if cmd.OIDC {
+		execConfig, err := cmd.oidcExecConfig(ctx, vclusterName)
+		if err != nil {
+			return nil, err
+		}
+
+		for k := range kubeConfig.AuthInfos {
+			kubeConfig.AuthInfos[k] = &clientcmdapi.AuthInfo{
+				Exec:                 execConfig,
+				Extensions:           make(map[string]runtime.Object),
+				ImpersonateUserExtra: make(map[string][]string),
+			}
+		}
+	}
+
 	return kubeConfig, nil
 }
 
+// oidcExecConfig builds an exec credential plugin config that retrieves an id token via kubelogin. Issuer, client id and
+// ca default to the ones configured in controlPlane.advanced.oidc of the virtual cluster.
+func (cmd *connectHelm) oidcExecConfig(ctx context.Context, vclusterName string) (*clientcmdapi.ExecConfig, error) {
+	oidcConfig := config.ControlPlaneOIDC{}
+	release, err := helm.NewSecrets(cmd.kubeClient).Get(ctx, vclusterName, cmd.Namespace)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("get helm release: %w", err)
+	} else if release != nil && release.Config != nil {
+		rawValues, err := yaml.Marshal(release.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		vClusterConfig := &config.Config{}
+		err = yaml.Unmarshal(rawValues, vClusterConfig)
+		if err != nil {
+			return nil, fmt.Errorf("parse vcluster config: %w", err)
+		}
+
+		oidcConfig = vClusterConfig.ControlPlane.Advanced.OIDC
+	}
+
+	issuerURL := cmp.Or(cmd.OIDCIssuerURL, oidcConfig.IssuerURL)
+	clientID := cmp.Or(cmd.OIDCClientID, oidcConfig.ClientID)
+	if issuerURL == "" || clientID == "" {
+		return nil, fmt.Errorf("oidc is not configured for vcluster %s, please specify --oidc-issuer-url and --oidc-client-id", vclusterName)
+	} else if !oidcConfig.Enabled {
+		cmd.Log.Warnf("controlPlane.advanced.oidc.enabled is not set for vcluster %s, the virtual cluster might not accept oidc tokens", vclusterName)
+	}
+
+	args := []string{
+		"oidc-login",
+		"get-token",
+		"--oidc-issuer-url=" + issuerURL,
+		"--oidc-client-id=" + clientID,
+	}
+	if cmd.OIDCClientSecret != "" {
+		args = append(args, "--oidc-client-secret="+cmd.OIDCClientSecret)
+	}
+	for _, scope := range cmd.OIDCExtraScopes {
+		args = append(args, "--oidc-extra-scope="+scope)
+	}
+	if oidcConfig.CA != "" && cmd.OIDCIssuerURL == "" {
+		args = append(args, "--certificate-authority-data="+base64.StdEncoding.EncodeToString([]byte(oidcConfig.CA)))
+	}
+
+	return &clientcmdapi.ExecConfig{
+		APIVersion:      "client.authentication.k8s.io/v1",
+		Command:         "kubectl",
+		Args:            args,
+		InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		InstallHint:     "The oidc-login kubectl plugin is required to retrieve an id token, please install it via 'kubectl krew install oidc-login'",
+	}, nil
+}
+
 func (cmd *connectHelm) setServerIfExposed(ctx context.Context, vClusterName string, vClusterConfig *clientcmdapi.Config) error {
 	printedWaiting := false
 	err := wait.PollUntilContextTimeout(ctx, time.Second*2, time.Minute*5, true, func(ctx context.Context) (done bool, err error) {
@@ -0,0 +1,434 @@
+package cli
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/upgrade"
+	"github.com/loft-sh/vcluster/pkg/util/redact"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+)
+
+type DebugBundleOptions struct {
+	Output string
+
+	RedactKeys   []string
+	RedactValues []string
+}
+
+// DebugBundleHelm collects logs, configuration, host and virtual cluster objects of the given virtual cluster into
+// a single redacted tar.gz archive
+func DebugBundleHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *DebugBundleOptions, log log.Logger) error {
+	redactor, err := redact.New(options.RedactKeys, options.RedactValues)
+	if err != nil {
+		return err
+	}
+
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+	if err != nil {
+		return err
+	}
+
+	restConfig, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return err
+	}
+
+	output := options.Output
+	if output == "" {
+		output = fmt.Sprintf("%s-%s.debug.tar.gz", vCluster.Name, time.Now().Format("20060102150405"))
+	}
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	bundle := &debugBundle{
+		tarWriter: tarWriter,
+		redactor:  redactor,
+		prefix:    strings.TrimSuffix(path.Base(output), ".tar.gz"),
+	}
+
+	log.Infof("Collecting debug information of vcluster %s/%s...", vCluster.Namespace, vCluster.Name)
+	collectDebugBundle(ctx, bundle, restConfig, kubeClient, vCluster, log)
+
+	// write the errors that occurred during collection
+	if len(bundle.errors) > 0 {
+		bundle.add("errors.txt", []byte(strings.Join(bundle.errors, "\n")+"\n"))
+	}
+	if bundle.writeErr != nil {
+		_ = os.Remove(output)
+		return fmt.Errorf("write debug bundle: %w", bundle.writeErr)
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return fmt.Errorf("close tar writer: %w", err)
+	}
+	err = gzipWriter.Close()
+	if err != nil {
+		return fmt.Errorf("close gzip writer: %w", err)
+	}
+
+	for _, collectErr := range bundle.errors {
+		log.Warn(collectErr)
+	}
+	log.Donef("Successfully wrote debug bundle of vcluster %s/%s to %s", vCluster.Namespace, vCluster.Name, output)
+	return nil
+}
+
+type debugBundle struct {
+	tarWriter *tar.Writer
+	redactor  *redact.Redactor
+	prefix    string
+
+	errors   []string
+	writeErr error
+}
+
+// add redacts the given text and adds it as file to the bundle
+func (b *debugBundle) add(name string, data []byte) {
+	if b.writeErr != nil {
+		return
+	}
+
+	data = b.redactor.Text(data)
+	err := b.tarWriter.WriteHeader(&tar.Header{
+		Name:    path.Join(b.prefix, name),
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		b.writeErr = err
+		return
+	}
+
+	_, err = b.tarWriter.Write(data)
+	if err != nil {
+		b.writeErr = err
+	}
+}
+
+// addObject redacts the given object and adds it as yaml file to the bundle
+func (b *debugBundle) addObject(name string, obj interface{}) {
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		b.addError(fmt.Errorf("marshal %s: %w", name, err))
+		return
+	}
+
+	out, err = b.redactor.YAML(out)
+	if err != nil {
+		b.addError(fmt.Errorf("redact %s: %w", name, err))
+		return
+	}
+
+	b.add(name, out)
+}
+
+func (b *debugBundle) addError(err error) {
+	b.errors = append(b.errors, err.Error())
+}
+
+func collectDebugBundle(ctx context.Context, bundle *debugBundle, restConfig *rest.Config, kubeClient *kubernetes.Clientset, vCluster *find.VCluster, log log.Logger) {
+	versions := map[string]string{
+		"cli":      upgrade.GetVersion(),
+		"vcluster": vCluster.Version,
+	}
+	hostVersion, err := kubeClient.Discovery().ServerVersion()
+	if err != nil {
+		bundle.addError(fmt.Errorf("get host version: %w", err))
+	} else {
+		versions["host"] = hostVersion.GitVersion
+	}
+
+	// config with redacted secrets
+	configSecret, err := kubeClient.CoreV1().Secrets(vCluster.Namespace).Get(ctx, "vc-config-"+vCluster.Name, metav1.GetOptions{})
+	if err != nil {
+		bundle.addError(fmt.Errorf("get config secret: %w", err))
+	} else {
+		config, err := bundle.redactor.YAML(configSecret.Data["config.yaml"])
+		if err != nil {
+			bundle.addError(fmt.Errorf("redact config: %w", err))
+		} else {
+			bundle.add("vcluster.yaml", config)
+		}
+	}
+
+	// control plane and etcd logs
+	controlPlanePods := []corev1.Pod{}
+	for _, labelSelector := range []string{"app=vcluster,release=" + vCluster.Name, "app=vcluster-etcd,release=" + vCluster.Name} {
+		podList, err := kubeClient.CoreV1().Pods(vCluster.Namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+		if err != nil {
+			bundle.addError(fmt.Errorf("list pods %s: %w", labelSelector, err))
+			continue
+		}
+
+		controlPlanePods = append(controlPlanePods, podList.Items...)
+	}
+	for _, pod := range controlPlanePods {
+		collectPodLogs(ctx, bundle, kubeClient, &pod)
+	}
+	bundle.addObject("host/control-plane/pods.yaml", controlPlanePods)
+
+	// control plane objects
+	labelSelector := metav1.ListOptions{LabelSelector: "release=" + vCluster.Name}
+	statefulSets, err := kubeClient.AppsV1().StatefulSets(vCluster.Namespace).List(ctx, labelSelector)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list statefulsets: %w", err))
+	} else {
+		bundle.addObject("host/control-plane/statefulsets.yaml", statefulSets.Items)
+	}
+	deployments, err := kubeClient.AppsV1().Deployments(vCluster.Namespace).List(ctx, labelSelector)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list deployments: %w", err))
+	} else {
+		bundle.addObject("host/control-plane/deployments.yaml", deployments.Items)
+	}
+	services, err := kubeClient.CoreV1().Services(vCluster.Namespace).List(ctx, labelSelector)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list services: %w", err))
+	} else {
+		bundle.addObject("host/control-plane/services.yaml", services.Items)
+	}
+
+	// objects synced by the virtual cluster
+	collectManagedHostObjects(ctx, bundle, kubeClient, vCluster)
+
+	// host events
+	events, err := kubeClient.CoreV1().Events(vCluster.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		bundle.addError(fmt.Errorf("list host events: %w", err))
+	} else {
+		bundle.addObject("host/events.yaml", events.Items)
+	}
+
+	// the virtual cluster can only be reached while it's running
+	if vCluster.Status != find.StatusRunning {
+		bundle.addError(fmt.Errorf("skipped collecting virtual cluster objects, because vcluster is %s", vCluster.Status))
+	} else {
+		pod, err := findRunningVClusterPod(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+		if err != nil {
+			bundle.addError(err)
+		} else {
+			collectVirtualCluster(ctx, bundle, restConfig, kubeClient, vCluster, pod, versions, log)
+		}
+	}
+
+	bundle.addObject("version.yaml", versions)
+}
+
+func collectPodLogs(ctx context.Context, bundle *debugBundle, kubeClient kubernetes.Interface, pod *corev1.Pod) {
+	containerStatuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, containerStatus := range containerStatuses {
+		logs, err := kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: containerStatus.Name}).DoRaw(ctx)
+		if err != nil {
+			bundle.addError(fmt.Errorf("get logs of %s/%s: %w", pod.Name, containerStatus.Name, err))
+		} else {
+			bundle.add(path.Join("logs", pod.Name, containerStatus.Name+".log"), logs)
+		}
+
+		if containerStatus.RestartCount == 0 {
+			continue
+		}
+
+		logs, err = kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: containerStatus.Name, Previous: true}).DoRaw(ctx)
+		if err != nil {
+			bundle.addError(fmt.Errorf("get previous logs of %s/%s: %w", pod.Name, containerStatus.Name, err))
+		} else {
+			bundle.add(path.Join("logs", pod.Name, containerStatus.Name+".previous.log"), logs)
+		}
+	}
+}
+
+func collectManagedHostObjects(ctx context.Context, bundle *debugBundle, kubeClient kubernetes.Interface, vCluster *find.VCluster) {
+	listOptions := metav1.ListOptions{LabelSelector: translate.MarkerLabel + "=" + vCluster.Name}
+
+	pods, err := kubeClient.CoreV1().Pods(vCluster.Namespace).List(ctx, listOptions)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list synced pods: %w", err))
+	} else {
+		bundle.addObject("host/synced/pods.yaml", pods.Items)
+	}
+
+	services, err := kubeClient.CoreV1().Services(vCluster.Namespace).List(ctx, listOptions)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list synced services: %w", err))
+	} else {
+		bundle.addObject("host/synced/services.yaml", services.Items)
+	}
+
+	endpoints, err := kubeClient.CoreV1().Endpoints(vCluster.Namespace).List(ctx, listOptions)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list synced endpoints: %w", err))
+	} else {
+		bundle.addObject("host/synced/endpoints.yaml", endpoints.Items)
+	}
+
+	configMaps, err := kubeClient.CoreV1().ConfigMaps(vCluster.Namespace).List(ctx, listOptions)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list synced configmaps: %w", err))
+	} else {
+		bundle.addObject("host/synced/configmaps.yaml", redactConfigMaps(configMaps.Items))
+	}
+
+	secrets, err := kubeClient.CoreV1().Secrets(vCluster.Namespace).List(ctx, listOptions)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list synced secrets: %w", err))
+	} else {
+		bundle.addObject("host/synced/secrets.yaml", redactSecrets(secrets.Items))
+	}
+
+	persistentVolumeClaims, err := kubeClient.CoreV1().PersistentVolumeClaims(vCluster.Namespace).List(ctx, listOptions)
+	if err != nil {
+		bundle.addError(fmt.Errorf("list synced persistentvolumeclaims: %w", err))
+	} else {
+		bundle.addObject("host/synced/persistentvolumeclaims.yaml", persistentVolumeClaims.Items)
+	}
+}
+
+func collectVirtualCluster(ctx context.Context, bundle *debugBundle, restConfig *rest.Config, kubeClient *kubernetes.Clientset, vCluster *find.VCluster, pod *corev1.Pod, versions map[string]string, log log.Logger) {
+	vRestConfig, stop, err := connectVirtualCluster(ctx, restConfig, kubeClient, vCluster, pod, log)
+	if err != nil {
+		bundle.addError(fmt.Errorf("connect to virtual cluster: %w", err))
+		return
+	}
+	defer stop()
+
+	vKubeClient, err := kubernetes.NewForConfig(vRestConfig)
+	if err != nil {
+		bundle.addError(fmt.Errorf("create virtual kube client: %w", err))
+		return
+	}
+
+	virtualVersion, err := vKubeClient.Discovery().ServerVersion()
+	if err != nil {
+		bundle.addError(fmt.Errorf("get virtual cluster version: %w", err))
+	} else {
+		versions["virtual"] = virtualVersion.GitVersion
+	}
+
+	nodes, err := vKubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		bundle.addError(fmt.Errorf("list virtual nodes: %w", err))
+	} else {
+		bundle.addObject("virtual/nodes.yaml", nodes.Items)
+	}
+
+	events, err := vKubeClient.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		bundle.addError(fmt.Errorf("list virtual events: %w", err))
+	} else {
+		bundle.addObject("virtual/events.yaml", events.Items)
+	}
+
+	inventory, err := virtualInventory(ctx, vKubeClient.Discovery(), vRestConfig)
+	if err != nil {
+		bundle.addError(fmt.Errorf("collect virtual inventory: %w", err))
+	}
+	if len(inventory) > 0 {
+		bundle.addObject("virtual/inventory.yaml", inventory)
+	}
+}
+
+// virtualInventory returns the names of all listable objects within the virtual cluster by resource
+func virtualInventory(ctx context.Context, discoveryClient discovery.DiscoveryInterface, vRestConfig *rest.Config) (map[string][]string, error) {
+	metadataClient, err := metadata.NewForConfig(vRestConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// partial discovery failures are fine, we still collect the rest
+	resourceLists, discoveryErr := discovery.ServerPreferredResources(discoveryClient)
+	resourceLists = discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list"}}, resourceLists)
+
+	inventory := map[string][]string{}
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+
+		for _, resource := range resourceList.APIResources {
+			gvr := groupVersion.WithResource(resource.Name)
+			key := strings.TrimPrefix(gvr.GroupResource().String(), ".")
+			list, err := metadataClient.Resource(gvr).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				inventory[key] = []string{"error: " + err.Error()}
+				continue
+			}
+
+			names := make([]string, 0, len(list.Items))
+			for _, item := range list.Items {
+				if item.Namespace != "" {
+					names = append(names, item.Namespace+"/"+item.Name)
+				} else {
+					names = append(names, item.Name)
+				}
+			}
+			sort.Strings(names)
+			inventory[key] = names
+		}
+	}
+
+	return inventory, discoveryErr
+}
+
+// redactConfigMaps replaces all config map values with a placeholder, as synced config maps frequently
+// contain application configuration with embedded credentials
+func redactConfigMaps(configMaps []corev1.ConfigMap) []corev1.ConfigMap {
+	retConfigMaps := make([]corev1.ConfigMap, 0, len(configMaps))
+	for _, configMap := range configMaps {
+		configMap := *configMap.DeepCopy()
+		for key := range configMap.Data {
+			configMap.Data[key] = redact.Redacted
+		}
+		for key := range configMap.BinaryData {
+			configMap.BinaryData[key] = []byte(redact.Redacted)
+		}
+		delete(configMap.Annotations, corev1.LastAppliedConfigAnnotation)
+		retConfigMaps = append(retConfigMaps, configMap)
+	}
+
+	return retConfigMaps
+}
+
+// redactSecrets replaces all secret values with a placeholder
+func redactSecrets(secrets []corev1.Secret) []corev1.Secret {
+	retSecrets := make([]corev1.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		secret := *secret.DeepCopy()
+		for key := range secret.Data {
+			secret.Data[key] = []byte(redact.Redacted)
+		}
+		for key := range secret.StringData {
+			secret.StringData[key] = redact.Redacted
+		}
+		delete(secret.Annotations, corev1.LastAppliedConfigAnnotation)
+		retSecrets = append(retSecrets, secret)
+	}
+
+	return retSecrets
+}
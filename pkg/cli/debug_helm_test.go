@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/util/redact"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	secrets := []corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+				Annotations: map[string]string{
+					corev1.LastAppliedConfigAnnotation: `{"data":{"password":"c2VjcmV0"}}`,
+					"other":                            "value",
+				},
+			},
+			Data:       map[string][]byte{"password": []byte("secret")},
+			StringData: map[string]string{"token": "secret"},
+		},
+	}
+
+	redacted := redactSecrets(secrets)
+	assert.DeepEqual(t, redacted[0].Data, map[string][]byte{"password": []byte(redact.Redacted)})
+	assert.DeepEqual(t, redacted[0].StringData, map[string]string{"token": redact.Redacted})
+	assert.DeepEqual(t, redacted[0].Annotations, map[string]string{"other": "value"})
+
+	// the original secrets should stay untouched
+	assert.DeepEqual(t, secrets[0].Data, map[string][]byte{"password": []byte("secret")})
+}
+
+func TestRedactConfigMaps(t *testing.T) {
+	configMaps := []corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Data:       map[string]string{"application.properties": "db.password=hunter2"},
+			BinaryData: map[string][]byte{"keystore": []byte("secret")},
+		},
+	}
+
+	redacted := redactConfigMaps(configMaps)
+	assert.DeepEqual(t, redacted[0].Data, map[string]string{"application.properties": redact.Redacted})
+	assert.DeepEqual(t, redacted[0].BinaryData, map[string][]byte{"keystore": []byte(redact.Redacted)})
+	assert.DeepEqual(t, configMaps[0].Data, map[string]string{"application.properties": "db.password=hunter2"})
+}
+
+func TestDebugBundleAddObject(t *testing.T) {
+	redactor, err := redact.New(nil, nil)
+	assert.NilError(t, err)
+
+	buffer := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(buffer)
+	bundle := &debugBundle{
+		tarWriter: tarWriter,
+		redactor:  redactor,
+		prefix:    "test",
+	}
+	bundle.addObject("config.yaml", map[string]interface{}{"name": "test", "password": "secret"})
+	bundle.addObject("pods.yaml", []corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name: "test",
+			Env:  []corev1.EnvVar{{Name: "DB_PASSWORD", Value: "hunter2"}},
+		}}},
+	}})
+	assert.NilError(t, bundle.writeErr)
+	assert.NilError(t, tarWriter.Close())
+
+	tarReader := tar.NewReader(buffer)
+	header, err := tarReader.Next()
+	assert.NilError(t, err)
+	assert.Equal(t, header.Name, "test/config.yaml")
+	content, err := io.ReadAll(tarReader)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "name: test\npassword: REDACTED\n")
+
+	_, err = tarReader.Next()
+	assert.NilError(t, err)
+	content, err = io.ReadAll(tarReader)
+	assert.NilError(t, err)
+	assert.Assert(t, !bytes.Contains(content, []byte("hunter2")))
+	assert.Assert(t, bytes.Contains(content, []byte("value: REDACTED")))
+}
@@ -0,0 +1,595 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/log/table"
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/certs"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/controllers/deploy"
+	pluginv2 "github.com/loft-sh/vcluster/pkg/plugin/v2"
+	"github.com/loft-sh/vcluster/pkg/util/clihelper"
+	"github.com/loft-sh/vcluster/pkg/util/kubeconfig"
+	"github.com/loft-sh/vcluster/pkg/util/portforward"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+)
+
+// maxDescribeEvents is the maximum number of warning events shown by describe
+const maxDescribeEvents = 10
+
+type DescribeOptions struct {
+	Output string
+}
+
+// DescribeVCluster holds the health and configuration report of a virtual cluster
+type DescribeVCluster struct {
+	Name         string    `json:"name"`
+	Namespace    string    `json:"namespace"`
+	Status       string    `json:"status"`
+	Created      time.Time `json:"created"`
+	Version      string    `json:"version,omitempty"`
+	ChartVersion string    `json:"chartVersion,omitempty"`
+	Distro       string    `json:"distro,omitempty"`
+	BackingStore string    `json:"backingStore,omitempty"`
+
+	Syncers       []string                `json:"syncers,omitempty"`
+	Plugins       []DescribePlugin        `json:"plugins,omitempty"`
+	Certificates  []certs.CertificateInfo `json:"certificates,omitempty"`
+	Deploy        *deploy.Status          `json:"deploy,omitempty"`
+	HostResources map[string]int          `json:"hostResources,omitempty"`
+	Events        []DescribeEvent         `json:"events,omitempty"`
+	Config        *vclusterconfig.Config  `json:"config,omitempty"`
+	Errors        []string                `json:"errors,omitempty"`
+	rawConfig     string
+}
+
+// DescribePlugin holds the state of a plugin
+type DescribePlugin struct {
+	Name      string `json:"name"`
+	Image     string `json:"image,omitempty"`
+	State     string `json:"state"`
+	Restarts  int    `json:"restarts,omitempty"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// DescribeEvent is a warning event in the host namespace of the virtual cluster
+type DescribeEvent struct {
+	Object   string    `json:"object"`
+	Reason   string    `json:"reason"`
+	Message  string    `json:"message"`
+	Count    int32     `json:"count"`
+	LastSeen time.Time `json:"lastSeen"`
+}
+
+// DescribeHelm prints a health and configuration report of the given virtual cluster
+func DescribeHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *DescribeOptions, log log.Logger) error {
+	if options.Output != "" && options.Output != "table" && options.Output != "json" && options.Output != "yaml" {
+		return fmt.Errorf("unsupported output format %s, please use table, json or yaml", options.Output)
+	}
+
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+	if err != nil {
+		return err
+	}
+
+	restConfig, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return err
+	}
+
+	description := describeVCluster(ctx, restConfig, kubeClient, vCluster, log)
+	switch options.Output {
+	case "json":
+		out, err := json.MarshalIndent(description, "", "    ")
+		if err != nil {
+			return fmt.Errorf("json marshal description: %w", err)
+		}
+
+		log.WriteString(logrus.InfoLevel, string(out)+"\n")
+	case "yaml":
+		out, err := yaml.Marshal(description)
+		if err != nil {
+			return fmt.Errorf("yaml marshal description: %w", err)
+		}
+
+		log.WriteString(logrus.InfoLevel, string(out))
+	default:
+		printDescription(description, log)
+	}
+
+	return nil
+}
+
+func describeVCluster(ctx context.Context, restConfig *rest.Config, kubeClient *kubernetes.Clientset, vCluster *find.VCluster, log log.Logger) *DescribeVCluster {
+	description := &DescribeVCluster{
+		Name:      vCluster.Name,
+		Namespace: vCluster.Namespace,
+		Status:    string(vCluster.Status),
+		Created:   vCluster.Created.Time,
+		Version:   vCluster.Version,
+	}
+	addError := func(err error) {
+		description.Errors = append(description.Errors, err.Error())
+	}
+
+	// decode the effective vcluster.yaml
+	configSecret, err := kubeClient.CoreV1().Secrets(vCluster.Namespace).Get(ctx, "vc-config-"+vCluster.Name, metav1.GetOptions{})
+	if err != nil {
+		addError(fmt.Errorf("get config secret: %w", err))
+	} else {
+		description.rawConfig = string(configSecret.Data["config.yaml"])
+		description.Config = &vclusterconfig.Config{}
+		err = yaml.Unmarshal(configSecret.Data["config.yaml"], description.Config)
+		if err != nil {
+			addError(fmt.Errorf("parse config: %w", err))
+			description.Config = nil
+		} else {
+			description.Distro = description.Config.Distro()
+			description.BackingStore = string(description.Config.BackingStoreType())
+			description.Syncers = enabledSyncers(description.Config.Sync)
+		}
+		description.ChartVersion = strings.TrimPrefix(configSecret.Labels["chart"], "vcluster-")
+	}
+
+	// find the control plane pod for plugin states and the virtual cluster connection
+	var pod *corev1.Pod
+	if vCluster.Status == find.StatusRunning {
+		pod, err = findRunningVClusterPod(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+		if err != nil {
+			addError(err)
+		}
+	}
+	if description.Config != nil {
+		statusConfigMap, err := kubeClient.CoreV1().ConfigMaps(vCluster.Namespace).Get(ctx, pluginv2.StatusConfigMapName(vCluster.Name), metav1.GetOptions{})
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				addError(fmt.Errorf("get plugin status config map: %w", err))
+			}
+			statusConfigMap = nil
+		}
+
+		description.Plugins = pluginStates(description.Config, pod, statusConfigMap)
+	}
+
+	// check the certificates
+	certsSecret, err := kubeClient.CoreV1().Secrets(vCluster.Namespace).Get(ctx, certs.CertsSecretName(vCluster.Name), metav1.GetOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		addError(fmt.Errorf("get certs secret: %w", err))
+	} else if err == nil {
+		description.Certificates, err = certs.CheckCertificates(certsSecret)
+		if err != nil {
+			addError(fmt.Errorf("check certificates: %w", err))
+		}
+	}
+
+	// retrieve the deploy status from within the virtual cluster
+	if pod != nil && description.Config != nil && hasDeploy(description.Config) {
+		description.Deploy, err = getDeployStatus(ctx, restConfig, kubeClient, vCluster, pod, log)
+		if err != nil {
+			addError(fmt.Errorf("get deploy status: %w", err))
+		}
+	}
+
+	// count the synced resources in the host cluster
+	description.HostResources, err = countHostResources(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+	if err != nil {
+		addError(fmt.Errorf("count host resources: %w", err))
+	}
+
+	// recent warning events of the virtual cluster and its synced objects
+	objects, err := vClusterObjects(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+	if err != nil {
+		addError(fmt.Errorf("list virtual cluster objects: %w", err))
+	} else {
+		description.Events, err = warningEvents(ctx, kubeClient, vCluster.Namespace, objects)
+		if err != nil {
+			addError(fmt.Errorf("list events: %w", err))
+		}
+	}
+
+	return description
+}
+
+// enabledSyncers returns the enabled syncers of the sync config, e.g. toHost.pods or fromHost.nodes
+func enabledSyncers(sync vclusterconfig.Sync) []string {
+	raw, err := json.Marshal(sync)
+	if err != nil {
+		return nil
+	}
+
+	directions := map[string]map[string]json.RawMessage{}
+	err = json.Unmarshal(raw, &directions)
+	if err != nil {
+		return nil
+	}
+
+	syncers := []string{}
+	for direction, resources := range directions {
+		for resource, resourceConfig := range resources {
+			enabled := struct {
+				Enabled bool `json:"enabled,omitempty"`
+			}{}
+			if json.Unmarshal(resourceConfig, &enabled) == nil && enabled.Enabled {
+				syncers = append(syncers, direction+"."+resource)
+			}
+		}
+	}
+
+	sort.Strings(syncers)
+	return syncers
+}
+
+// pluginStates returns the plugins of the config and their states. Plugins that run within the syncer report their
+// state through the plugin status config map, legacy plugins run as sidecar containers in the given pod.
+func pluginStates(vConfig *vclusterconfig.Config, pod *corev1.Pod, statusConfigMap *corev1.ConfigMap) []DescribePlugin {
+	plugins := map[string]vclusterconfig.Plugins{}
+	sidecars := map[string]bool{}
+	for name, plugin := range vConfig.Plugins {
+		plugins[name] = plugin
+	}
+	for name, plugin := range vConfig.Plugin {
+		plugins[name] = plugin.Plugins
+		sidecars[name] = plugin.Version != "v2"
+	}
+
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	retPlugins := []DescribePlugin{}
+	for _, name := range names {
+		describePlugin := DescribePlugin{
+			Name:  name,
+			Image: plugins[name].Image,
+			State: "Unknown",
+		}
+		if sidecars[name] {
+			containerName := name
+			if plugins[name].Name != "" {
+				containerName = plugins[name].Name
+			}
+
+			if pod != nil {
+				for _, containerStatus := range pod.Status.ContainerStatuses {
+					if containerStatus.Name == containerName {
+						describePlugin.State = containerState(containerStatus.State)
+						describePlugin.Restarts = int(containerStatus.RestartCount)
+						break
+					}
+				}
+			}
+		} else if statusConfigMap != nil && statusConfigMap.Data[name] != "" {
+			status := pluginv2.PluginStatus{}
+			err := json.Unmarshal([]byte(statusConfigMap.Data[name]), &status)
+			if err == nil && status.Phase != "" {
+				describePlugin.State = string(status.Phase)
+				describePlugin.Restarts = status.Restarts
+				describePlugin.LastError = status.LastError
+			}
+		}
+
+		retPlugins = append(retPlugins, describePlugin)
+	}
+
+	return retPlugins
+}
+
+func containerState(state corev1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return "Running"
+	case state.Waiting != nil:
+		return "Waiting: " + state.Waiting.Reason
+	case state.Terminated != nil:
+		return "Terminated: " + state.Terminated.Reason
+	}
+
+	return "Unknown"
+}
+
+func hasDeploy(vConfig *vclusterconfig.Config) bool {
+	return vConfig.Experimental.Deploy.VCluster.Manifests != "" || vConfig.Experimental.Deploy.VCluster.ManifestsTemplate != "" || len(vConfig.Experimental.Deploy.VCluster.Helm) > 0
+}
+
+// getDeployStatus reads the deploy status config map from within the virtual cluster
+func getDeployStatus(ctx context.Context, restConfig *rest.Config, kubeClient *kubernetes.Clientset, vCluster *find.VCluster, pod *corev1.Pod, log log.Logger) (*deploy.Status, error) {
+	vRestConfig, stop, err := connectVirtualCluster(ctx, restConfig, kubeClient, vCluster, pod, log)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+
+	vKubeClient, err := kubernetes.NewForConfig(vRestConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create virtual kube client: %w", err)
+	}
+
+	configMap, err := vKubeClient.CoreV1().ConfigMaps(deploy.VClusterDeployConfigMapNamespace).Get(ctx, deploy.VClusterDeployConfigMap, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return deploy.ParseStatus(configMap), nil
+}
+
+// connectVirtualCluster port-forwards to the given control plane pod and returns a rest config for the virtual
+// cluster. The returned function stops the port-forwarding.
+func connectVirtualCluster(ctx context.Context, restConfig *rest.Config, kubeClient *kubernetes.Clientset, vCluster *find.VCluster, pod *corev1.Pod, log log.Logger) (*rest.Config, func(), error) {
+	vKubeConfig, err := kubeconfig.ReadKubeConfig(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	localPort := clihelper.RandomPort()
+	stopChan, err := portforward.StartPortForwarding(ctx, restConfig, kubeClient, "localhost", pod.Name, pod.Namespace, strconv.Itoa(localPort), "8443", io.Discard, io.Discard, log)
+	if err != nil {
+		return nil, nil, fmt.Errorf("start port forwarding: %w", err)
+	}
+	stop := func() {
+		// the port forwarder closes the channel itself on errors
+		select {
+		case <-stopChan:
+		default:
+			close(stopChan)
+		}
+	}
+
+	for _, cluster := range vKubeConfig.Clusters {
+		if cluster != nil {
+			cluster.Server = "https://localhost:" + strconv.Itoa(localPort)
+		}
+	}
+	vRestConfig, err := clientcmd.NewDefaultClientConfig(*vKubeConfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		stop()
+		return nil, nil, fmt.Errorf("create virtual rest config: %w", err)
+	}
+	vRestConfig.Timeout = time.Second * 10
+
+	return vRestConfig, stop, nil
+}
+
+// countHostResources counts the objects in the host namespace that were synced by the virtual cluster
+func countHostResources(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string) (map[string]int, error) {
+	listOptions := metav1.ListOptions{LabelSelector: translate.MarkerLabel + "=" + name}
+	counts := map[string]int{}
+
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	counts["pods"] = len(pods.Items)
+
+	services, err := kubeClient.CoreV1().Services(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	counts["services"] = len(services.Items)
+
+	configMaps, err := kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	counts["configmaps"] = len(configMaps.Items)
+
+	secrets, err := kubeClient.CoreV1().Secrets(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	counts["secrets"] = len(secrets.Items)
+
+	persistentVolumeClaims, err := kubeClient.CoreV1().PersistentVolumeClaims(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	counts["persistentvolumeclaims"] = len(persistentVolumeClaims.Items)
+
+	return counts, nil
+}
+
+// vClusterObjects returns the objects of the virtual cluster release and the objects synced by the virtual cluster
+// in the host namespace as kind/name
+func vClusterObjects(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string) (map[string]bool, error) {
+	objects := map[string]bool{
+		"Secret/vc-config-" + name:                        true,
+		"Secret/" + certs.CertsSecretName(name):           true,
+		"ConfigMap/" + pluginv2.StatusConfigMapName(name): true,
+	}
+
+	for _, labelSelector := range []string{"release=" + name, translate.MarkerLabel + "=" + name} {
+		listOptions := metav1.ListOptions{LabelSelector: labelSelector}
+
+		pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods.Items {
+			objects["Pod/"+pod.Name] = true
+		}
+
+		services, err := kubeClient.CoreV1().Services(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, service := range services.Items {
+			objects["Service/"+service.Name] = true
+		}
+
+		configMaps, err := kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, configMap := range configMaps.Items {
+			objects["ConfigMap/"+configMap.Name] = true
+		}
+
+		secrets, err := kubeClient.CoreV1().Secrets(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, secret := range secrets.Items {
+			objects["Secret/"+secret.Name] = true
+		}
+
+		persistentVolumeClaims, err := kubeClient.CoreV1().PersistentVolumeClaims(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, persistentVolumeClaim := range persistentVolumeClaims.Items {
+			objects["PersistentVolumeClaim/"+persistentVolumeClaim.Name] = true
+		}
+
+		statefulSets, err := kubeClient.AppsV1().StatefulSets(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, statefulSet := range statefulSets.Items {
+			objects["StatefulSet/"+statefulSet.Name] = true
+		}
+
+		deployments, err := kubeClient.AppsV1().Deployments(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, deployment := range deployments.Items {
+			objects["Deployment/"+deployment.Name] = true
+		}
+	}
+
+	return objects, nil
+}
+
+// warningEvents returns the most recent warning events of the given objects in the given namespace
+func warningEvents(ctx context.Context, kubeClient kubernetes.Interface, namespace string, objects map[string]bool) ([]DescribeEvent, error) {
+	eventList, err := kubeClient.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: "type=" + corev1.EventTypeWarning})
+	if err != nil {
+		return nil, err
+	}
+
+	events := []DescribeEvent{}
+	for _, event := range eventList.Items {
+		if !objects[event.InvolvedObject.Kind+"/"+event.InvolvedObject.Name] {
+			continue
+		}
+
+		lastSeen := event.LastTimestamp.Time
+		if lastSeen.IsZero() {
+			lastSeen = event.EventTime.Time
+		}
+
+		events = append(events, DescribeEvent{
+			Object:   strings.ToLower(event.InvolvedObject.Kind) + "/" + event.InvolvedObject.Name,
+			Reason:   event.Reason,
+			Message:  event.Message,
+			Count:    event.Count,
+			LastSeen: lastSeen,
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeen.After(events[j].LastSeen)
+	})
+	if len(events) > maxDescribeEvents {
+		events = events[:maxDescribeEvents]
+	}
+
+	return events, nil
+}
+
+func printDescription(description *DescribeVCluster, log log.Logger) {
+	table.PrintTable(log, []string{"NAME", "NAMESPACE", "STATUS", "VERSION", "CHART", "DISTRO", "BACKING STORE", "AGE"}, [][]string{{
+		description.Name,
+		description.Namespace,
+		description.Status,
+		description.Version,
+		description.ChartVersion,
+		description.Distro,
+		description.BackingStore,
+		duration.HumanDuration(time.Since(description.Created)),
+	}})
+
+	if len(description.Syncers) > 0 {
+		log.WriteString(logrus.InfoLevel, "\nEnabled syncers: "+strings.Join(description.Syncers, ", ")+"\n")
+	}
+
+	if len(description.Plugins) > 0 {
+		values := [][]string{}
+		for _, plugin := range description.Plugins {
+			values = append(values, []string{plugin.Name, plugin.Image, plugin.State, strconv.Itoa(plugin.Restarts), plugin.LastError})
+		}
+		table.PrintTable(log, []string{"PLUGIN", "IMAGE", "STATE", "RESTARTS", "LAST ERROR"}, values)
+	}
+
+	if len(description.Certificates) > 0 {
+		values := [][]string{}
+		for _, certInfo := range description.Certificates {
+			residualTime := "expired"
+			if time.Until(certInfo.NotAfter) > 0 {
+				residualTime = duration.HumanDuration(time.Until(certInfo.NotAfter))
+			}
+
+			values = append(values, []string{certInfo.Name, certInfo.NotAfter.Format(time.RFC3339), residualTime})
+		}
+		table.PrintTable(log, []string{"CERTIFICATE", "EXPIRES", "RESIDUAL TIME"}, values)
+	}
+
+	if description.Deploy != nil {
+		values := [][]string{{"manifests", description.Deploy.Manifests.Phase, description.Deploy.Manifests.Reason, description.Deploy.Manifests.Message}}
+		for _, chart := range description.Deploy.Charts {
+			values = append(values, []string{chart.Namespace + "/" + chart.Name, chart.Phase, chart.Reason, chart.Message})
+		}
+		table.PrintTable(log, []string{"DEPLOY", "PHASE", "REASON", "MESSAGE"}, values)
+	}
+
+	if len(description.HostResources) > 0 {
+		resources := make([]string, 0, len(description.HostResources))
+		for resource := range description.HostResources {
+			resources = append(resources, resource)
+		}
+		sort.Strings(resources)
+
+		values := [][]string{}
+		for _, resource := range resources {
+			values = append(values, []string{resource, strconv.Itoa(description.HostResources[resource])})
+		}
+		table.PrintTable(log, []string{"HOST RESOURCE", "COUNT"}, values)
+	}
+
+	if len(description.Events) > 0 {
+		values := [][]string{}
+		for _, event := range description.Events {
+			values = append(values, []string{duration.HumanDuration(time.Since(event.LastSeen)), event.Object, event.Reason, strconv.Itoa(int(event.Count)), event.Message})
+		}
+		table.PrintTable(log, []string{"LAST SEEN", "OBJECT", "REASON", "COUNT", "MESSAGE"}, values)
+	}
+
+	for _, err := range description.Errors {
+		log.Warn(err)
+	}
+
+	if description.rawConfig != "" {
+		log.WriteString(logrus.InfoLevel, "\nvcluster.yaml:\n"+description.rawConfig+"\n")
+	}
+}
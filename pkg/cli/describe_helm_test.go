@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestEnabledSyncers(t *testing.T) {
+	sync := vclusterconfig.Sync{}
+	sync.ToHost.Pods.Enabled = true
+	sync.ToHost.Services.Enabled = true
+	sync.FromHost.Nodes.Enabled = true
+
+	assert.DeepEqual(t, enabledSyncers(sync), []string{"fromHost.nodes", "toHost.pods", "toHost.services"})
+}
+
+func TestPluginStates(t *testing.T) {
+	vConfig := &vclusterconfig.Config{
+		Plugins: map[string]vclusterconfig.Plugins{
+			"hooks": {Image: "hooks:v1"},
+		},
+		Plugin: map[string]vclusterconfig.Plugin{
+			"legacy": {Plugins: vclusterconfig.Plugins{Name: "legacy-container", Image: "legacy:v1"}},
+			"other":  {Plugins: vclusterconfig.Plugins{Image: "other:v1"}},
+			"new":    {Plugins: vclusterconfig.Plugins{Image: "new:v1"}, Version: "v2"},
+		},
+	}
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "hooks", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}}},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "legacy-container", RestartCount: 1, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	}
+	statusConfigMap := &corev1.ConfigMap{
+		Data: map[string]string{
+			"hooks": `{"phase":"Restarting","restarts":2,"lastError":"plugin exited"}`,
+		},
+	}
+
+	assert.DeepEqual(t, pluginStates(vConfig, pod, statusConfigMap), []DescribePlugin{
+		{Name: "hooks", Image: "hooks:v1", State: "Restarting", Restarts: 2, LastError: "plugin exited"},
+		{Name: "legacy", Image: "legacy:v1", State: "Running", Restarts: 1},
+		{Name: "new", Image: "new:v1", State: "Unknown"},
+		{Name: "other", Image: "other:v1", State: "Unknown"},
+	})
+	assert.DeepEqual(t, pluginStates(vConfig, nil, nil)[0].State, "Unknown")
+}
+
+func TestWarningEvents(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-0", Namespace: "test", Labels: map[string]string{"release": "test"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "synced-x-default-x-test", Namespace: "test", Labels: map[string]string{translate.MarkerLabel: "test"}}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "event-1", Namespace: "test"}, Type: corev1.EventTypeWarning, Reason: "BackOff", InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "test-0"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "event-2", Namespace: "test"}, Type: corev1.EventTypeWarning, Reason: "Failed", InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "synced-x-default-x-test"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "event-3", Namespace: "test"}, Type: corev1.EventTypeWarning, Reason: "Unrelated", InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "event-4", Namespace: "test"}, Type: corev1.EventTypeWarning, Reason: "InvalidConfig", InvolvedObject: corev1.ObjectReference{Kind: "Secret", Name: "vc-config-test"}},
+	)
+
+	objects, err := vClusterObjects(context.TODO(), kubeClient, "test", "test")
+	assert.NilError(t, err)
+	events, err := warningEvents(context.TODO(), kubeClient, "test", objects)
+	assert.NilError(t, err)
+
+	reasons := []string{}
+	for _, event := range events {
+		reasons = append(reasons, event.Reason)
+	}
+	sort.Strings(reasons)
+	assert.DeepEqual(t, reasons, []string{"BackOff", "Failed", "InvalidConfig"})
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/util/podhelper"
+)
+
+type DryRunOptions struct {
+	Files []string
+}
+
+func DryRunHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *DryRunOptions, log log.Logger) error {
+	if len(options.Files) == 0 {
+		return fmt.Errorf("please specify at least one manifest via --file")
+	}
+
+	// read the manifests
+	manifests := &bytes.Buffer{}
+	for _, file := range options.Files {
+		var (
+			out []byte
+			err error
+		)
+		if file == "-" {
+			out, err = io.ReadAll(os.Stdin)
+		} else {
+			out, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return fmt.Errorf("read manifest %s: %w", file, err)
+		}
+
+		manifests.WriteString("\n---\n")
+		manifests.Write(out)
+	}
+
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+	if err != nil {
+		return err
+	}
+
+	restConfig, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return err
+	}
+
+	pod, err := findRunningVClusterPod(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+	if err != nil {
+		return err
+	}
+
+	// the syncer logs are only interesting if something goes wrong
+	stderr := &bytes.Buffer{}
+	err = podhelper.ExecStream(ctx, restConfig, &podhelper.ExecStreamOptions{
+		Pod:       pod.Name,
+		Namespace: pod.Namespace,
+		Container: "syncer",
+		Command:   []string{"/vcluster", "dry-run", "--file", "-"},
+		Stdin:     manifests,
+		Stdout:    os.Stdout,
+		Stderr:    stderr,
+	})
+	if err != nil {
+		return fmt.Errorf("dry run: %w\n%s", err, stderr.String())
+	}
+
+	return nil
+}
@@ -21,6 +21,11 @@ func AddCommonFlags(cmd *cobra.Command, options *cli.ConnectOptions) {
 	cmd.Flags().StringVar(&options.ServiceAccountClusterRole, "cluster-role", "", "If specified, vCluster will create the service account if it does not exist and also add a cluster role binding for the given cluster role to it. Requires --service-account to be set")
 	cmd.Flags().IntVar(&options.ServiceAccountExpiration, "token-expiration", 0, "If specified, vCluster will create the service account token for the given duration in seconds. Defaults to eternal")
 	cmd.Flags().BoolVar(&options.Insecure, "insecure", false, "If specified, vCluster will create the kube config with insecure-skip-tls-verify")
+	cmd.Flags().BoolVar(&options.OIDC, "oidc", false, "If specified, vCluster will create a kube config that retrieves an OIDC id token via the kubectl oidc-login plugin instead of using the default client cert / key")
+	cmd.Flags().StringVar(&options.OIDCIssuerURL, "oidc-issuer-url", "", "The OIDC issuer url to use with --oidc. Defaults to controlPlane.advanced.oidc.issuerURL of the vCluster")
+	cmd.Flags().StringVar(&options.OIDCClientID, "oidc-client-id", "", "The OIDC client id to use with --oidc. Defaults to controlPlane.advanced.oidc.clientID of the vCluster")
+	cmd.Flags().StringVar(&options.OIDCClientSecret, "oidc-client-secret", "", "The OIDC client secret to use with --oidc")
+	cmd.Flags().StringSliceVar(&options.OIDCExtraScopes, "oidc-extra-scope", []string{}, "Additional scopes to request with --oidc, e.g. email or groups")
 	cmd.Flags().BoolVar(&options.BackgroundProxy, "background-proxy", true, "Try to use a background-proxy to access the vCluster. Only works if docker is installed and reachable")
 
 	// deprecated
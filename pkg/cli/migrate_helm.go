@@ -0,0 +1,265 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/loft-sh/log"
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/loft-sh/vcluster/pkg/helm"
+	"github.com/loft-sh/vcluster/pkg/lifecycle"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type MigrateOptions struct {
+	ToContext    string
+	ToNamespace  string
+	ChartRepo    string
+	DeleteSource bool
+}
+
+// MigrateHelm moves a virtual cluster to another host cluster. It freezes the source virtual cluster, takes a
+// snapshot of it and pauses it, then installs the same chart version with the same values on the target host
+// cluster and restores the snapshot there. Workloads are synced to the new host cluster once the restored virtual
+// cluster starts.
+func MigrateHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *MigrateOptions, log log.Logger) error {
+	if options.ToContext == "" {
+		return fmt.Errorf("please specify the target kube context via --to-context")
+	}
+
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+	if err != nil {
+		return err
+	} else if vCluster.Status != find.StatusRunning {
+		return fmt.Errorf("vcluster %s/%s is %s, please make sure it is running before migrating it", vCluster.Namespace, vCluster.Name, vCluster.Status)
+	}
+
+	sourceFlags := *globalFlags
+	sourceFlags.Context = vCluster.Context
+	sourceFlags.Namespace = vCluster.Namespace
+	targetFlags := *globalFlags
+	targetFlags.Context = options.ToContext
+	targetFlags.Namespace = options.ToNamespace
+	if targetFlags.Namespace == "" {
+		targetFlags.Namespace = vCluster.Namespace
+	}
+	if targetFlags.Context == sourceFlags.Context && targetFlags.Namespace == sourceFlags.Namespace {
+		return fmt.Errorf("source and target of the migration are the same, please specify a different --to-context or --to-namespace")
+	}
+
+	// retrieve the chart and values of the source
+	_, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return err
+	}
+	createOptions, cleanup, err := migrateCreateOptions(ctx, kubeClient, vCluster, options.ChartRepo)
+	if cleanup != nil {
+		defer cleanup()
+	}
+	if err != nil {
+		return err
+	}
+
+	snapshotFile, err := os.CreateTemp("", vCluster.Name+"-*.snapshot.gz")
+	if err != nil {
+		return fmt.Errorf("create temp snapshot file: %w", err)
+	}
+	_ = snapshotFile.Close()
+	defer os.Remove(snapshotFile.Name())
+
+	// freeze the source first, so workloads don't run twice and no changes get lost after the snapshot
+	err = freezeVCluster(ctx, kubeClient, vCluster, log)
+	if err != nil {
+		return fmt.Errorf("%w\n- The source vcluster was paused, use `vcluster resume %s -n %s --context %s` to resume it", err, vCluster.Name, vCluster.Namespace, vCluster.Context)
+	}
+
+	// snapshot the backing store and certificates
+	snapshotErr := SnapshotHelm(ctx, &sourceFlags, vCluster.Name, &SnapshotOptions{Output: snapshotFile.Name()}, log)
+
+	// stop the frozen source again, it stays paused until it is resumed or deleted
+	err = unfreezeVCluster(ctx, kubeClient, vCluster, log)
+	if err != nil {
+		return fmt.Errorf("stop frozen source vcluster: %w", err)
+	} else if snapshotErr != nil {
+		return fmt.Errorf("%w\n- The source vcluster was paused, use `vcluster resume %s -n %s --context %s` to resume it", snapshotErr, vCluster.Name, vCluster.Namespace, vCluster.Context)
+	}
+
+	err = migrateToTarget(ctx, &targetFlags, vCluster.Name, createOptions, &RestoreOptions{
+		Input: snapshotFile.Name(),
+		// the certificates are only valid for the service within the source namespace
+		SkipCerts: targetFlags.Namespace != vCluster.Namespace,
+	}, log)
+	if err != nil {
+		return fmt.Errorf("%w\n- The source vcluster was paused, use `vcluster resume %s -n %s --context %s` to resume it", err, vCluster.Name, vCluster.Namespace, vCluster.Context)
+	}
+	if targetFlags.Namespace != vCluster.Namespace {
+		log.Warnf("The certificates of the vcluster were recreated, because the namespace changed. Please retrieve kube configs again via `vcluster connect %s -n %s`", vCluster.Name, targetFlags.Namespace)
+	}
+
+	// delete the source
+	if options.DeleteSource {
+		err = DeleteHelm(ctx, &DeleteOptions{
+			Wait:                true,
+			DeleteContext:       true,
+			AutoDeleteNamespace: true,
+			IgnoreNotFound:      true,
+		}, &sourceFlags, vCluster.Name, log)
+		if err != nil {
+			return fmt.Errorf("delete source vcluster: %w", err)
+		}
+	}
+
+	log.Donef("Successfully migrated vcluster %s from %s/%s to %s/%s", vCluster.Name, vCluster.Context, vCluster.Namespace, targetFlags.Context, targetFlags.Namespace)
+	return nil
+}
+
+// migrateCreateOptions returns the options to install the same chart version with the same values as the source
+// virtual cluster. The returned function removes the temporary values file.
+func migrateCreateOptions(ctx context.Context, kubeClient kubernetes.Interface, vCluster *find.VCluster, chartRepo string) (*CreateOptions, func(), error) {
+	release, err := helm.NewSecrets(kubeClient).Get(ctx, vCluster.Name, vCluster.Namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get helm release: %w", err)
+	} else if release == nil || release.Chart == nil || release.Chart.Metadata == nil {
+		return nil, nil, fmt.Errorf("helm release of vcluster %s/%s has no chart information", vCluster.Namespace, vCluster.Name)
+	} else if isLegacyVCluster(release.Chart.Metadata.Version) {
+		return nil, nil, fmt.Errorf("vcluster %s/%s uses chart version %s, please upgrade it to v0.20 or newer first", vCluster.Namespace, vCluster.Name, release.Chart.Metadata.Version)
+	}
+
+	// the config secret holds the effective values of the release, which pins the exact same images and versions
+	values := []byte{}
+	configSecret, err := kubeClient.CoreV1().Secrets(vCluster.Namespace).Get(ctx, "vc-config-"+vCluster.Name, metav1.GetOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("get config secret: %w", err)
+	} else if err == nil {
+		values = configSecret.Data["config.yaml"]
+	}
+	if len(values) == 0 {
+		extraValues, err := helmExtraValuesYAML(release)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		values = []byte(extraValues)
+	}
+
+	vClusterConfig := &vclusterconfig.Config{}
+	err = yaml.Unmarshal(values, vClusterConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse vcluster config: %w", err)
+	}
+
+	valuesFile, err := os.CreateTemp("", vCluster.Name+"-*.values.yaml")
+	if err != nil {
+		return nil, nil, fmt.Errorf("create temp values file: %w", err)
+	}
+	cleanup := func() {
+		_ = os.Remove(valuesFile.Name())
+	}
+	_, err = valuesFile.Write(values)
+	_ = valuesFile.Close()
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("write temp values file: %w", err)
+	}
+
+	if chartRepo == "" {
+		chartRepo = constants.LoftChartRepo
+	}
+
+	return &CreateOptions{
+		ChartName:       release.Chart.Metadata.Name,
+		ChartVersion:    release.Chart.Metadata.Version,
+		ChartRepo:       chartRepo,
+		Distro:          vClusterConfig.Distro(),
+		Values:          []string{valuesFile.Name()},
+		CreateNamespace: true,
+	}, cleanup, nil
+}
+
+// freezeVCluster stops the virtual cluster and deletes its workloads, then starts the control plane again without
+// syncing and read-only, so a snapshot can be taken without any changes happening afterwards
+func freezeVCluster(ctx context.Context, kubeClient kubernetes.Interface, vCluster *find.VCluster, log log.Logger) error {
+	err := lifecycle.SleepVCluster(ctx, kubeClient, vCluster.Name, vCluster.Namespace, log)
+	if err != nil {
+		return fmt.Errorf("pause vcluster: %w", err)
+	}
+
+	err = lifecycle.SetFrozen(ctx, kubeClient, vCluster.Name, vCluster.Namespace, true)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Starting vcluster %s/%s frozen...", vCluster.Namespace, vCluster.Name)
+	err = lifecycle.ResumeVCluster(ctx, kubeClient, vCluster.Name, vCluster.Namespace, log)
+	if err == nil {
+		err = waitForRunningVClusterPod(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+	}
+	if err != nil {
+		_ = unfreezeVCluster(ctx, kubeClient, vCluster, log)
+		return fmt.Errorf("start frozen vcluster: %w", err)
+	}
+
+	return nil
+}
+
+// unfreezeVCluster stops the frozen virtual cluster, which starts normally once it is resumed
+func unfreezeVCluster(ctx context.Context, kubeClient kubernetes.Interface, vCluster *find.VCluster, log log.Logger) error {
+	err := lifecycle.PauseVCluster(ctx, kubeClient, vCluster.Name, vCluster.Namespace, log)
+	if err != nil {
+		return err
+	}
+
+	return lifecycle.SetFrozen(ctx, kubeClient, vCluster.Name, vCluster.Namespace, false)
+}
+
+// waitForRunningVClusterPod waits until a control plane pod of the virtual cluster is running
+func waitForRunningVClusterPod(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string) error {
+	return wait.PollUntilContextTimeout(ctx, time.Second*2, time.Minute*10, true, func(ctx context.Context) (bool, error) {
+		_, err := findRunningVClusterPod(ctx, kubeClient, name, namespace)
+		return err == nil, nil
+	})
+}
+
+// migrateToTarget installs the virtual cluster on the target and restores the snapshot into it
+func migrateToTarget(ctx context.Context, targetFlags *flags.GlobalFlags, vClusterName string, createOptions *CreateOptions, restoreOptions *RestoreOptions, log log.Logger) error {
+	err := CreateHelm(ctx, createOptions, targetFlags, vClusterName, log)
+	if err != nil {
+		return fmt.Errorf("create target vcluster: %w", err)
+	}
+
+	// wait until the target control plane is running
+	log.Infof("Waiting for vcluster %s/%s to come up...", targetFlags.Namespace, vClusterName)
+	err = wait.PollUntilContextTimeout(ctx, time.Second*2, time.Minute*10, true, func(ctx context.Context) (bool, error) {
+		vCluster, err := find.GetVCluster(ctx, targetFlags.Context, vClusterName, targetFlags.Namespace, log)
+		if err != nil {
+			return false, nil
+		}
+
+		_, kubeClient, err := prepareSnapshot(vCluster)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = findRunningVClusterPod(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+		return err == nil, nil
+	})
+	if err != nil {
+		return fmt.Errorf("wait for target vcluster: %w", err)
+	}
+
+	// the snapshot is applied while the target control plane is stopped, afterwards it syncs the workloads to the new host cluster
+	err = RestoreHelm(ctx, targetFlags, vClusterName, restoreOptions, log)
+	if err != nil {
+		return fmt.Errorf("restore target vcluster: %w", err)
+	}
+
+	return nil
+}
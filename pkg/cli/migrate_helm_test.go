@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMigrateCreateOptions(t *testing.T) {
+	release := `{"name":"test","namespace":"test","version":1,"info":{"status":"deployed"},"chart":{"metadata":{"name":"vcluster","version":"0.21.0"}},"config":{"sync":{"toHost":{"ingresses":{"enabled":true}}}}}`
+	configValues := "controlPlane:\n  distro:\n    k3s:\n      enabled: true\n"
+	kubeClient := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sh.helm.release.v1.test.v1",
+				Namespace: "test",
+				Labels:    map[string]string{"owner": "helm", "name": "test"},
+			},
+			Data: map[string][]byte{"release": []byte(base64.StdEncoding.EncodeToString([]byte(release)))},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vc-config-test", Namespace: "test"},
+			Data:       map[string][]byte{"config.yaml": []byte(configValues)},
+		},
+	)
+
+	createOptions, cleanup, err := migrateCreateOptions(context.Background(), kubeClient, &find.VCluster{Name: "test", Namespace: "test"}, "")
+	assert.NilError(t, err)
+	defer cleanup()
+
+	assert.Equal(t, createOptions.ChartName, "vcluster")
+	assert.Equal(t, createOptions.ChartVersion, "0.21.0")
+	assert.Equal(t, createOptions.ChartRepo, constants.LoftChartRepo)
+	assert.Equal(t, createOptions.Distro, "k3s")
+	assert.Equal(t, len(createOptions.Values), 1)
+
+	values, err := os.ReadFile(createOptions.Values[0])
+	assert.NilError(t, err)
+	assert.Equal(t, string(values), configValues)
+
+	cleanup()
+	_, err = os.Stat(createOptions.Values[0])
+	assert.Assert(t, os.IsNotExist(err))
+}
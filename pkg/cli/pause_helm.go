@@ -30,21 +30,11 @@ func PauseHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName
 		return err
 	}
 
-	err = lifecycle.PauseVCluster(ctx, kubeClient, vClusterName, globalFlags.Namespace, log)
+	err = lifecycle.SleepVCluster(ctx, kubeClient, vClusterName, globalFlags.Namespace, log)
 	if err != nil {
 		return err
 	}
 
-	err = lifecycle.DeletePods(ctx, kubeClient, "vcluster.loft.sh/managed-by="+vClusterName, globalFlags.Namespace, log)
-	if err != nil {
-		return fmt.Errorf("delete vcluster workloads: %w", err)
-	}
-
-	err = lifecycle.DeleteMultiNamespaceVClusterWorkloads(ctx, kubeClient, vClusterName, globalFlags.Namespace, log)
-	if err != nil {
-		return fmt.Errorf("delete vcluster multinamespace workloads: %w", err)
-	}
-
 	log.Donef("Successfully paused vcluster %s/%s", globalFlags.Namespace, vClusterName)
 	return nil
 }
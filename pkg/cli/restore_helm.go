@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/lifecycle"
+	"github.com/loft-sh/vcluster/pkg/util/podhelper"
+)
+
+type RestoreOptions struct {
+	Input     string
+	SkipCerts bool
+}
+
+func RestoreHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *RestoreOptions, log log.Logger) error {
+	if options.Input == "" {
+		return fmt.Errorf("please specify a snapshot via --input")
+	}
+
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+	if err != nil {
+		return err
+	}
+
+	restConfig, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return err
+	}
+
+	pod, err := findRunningVClusterPod(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(options.Input)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	command := []string{"/vcluster", "restore", "--input", "-"}
+	if options.SkipCerts {
+		command = append(command, "--skip-certs")
+	}
+
+	log.Infof("Restoring vcluster %s/%s from %s...", vCluster.Namespace, vCluster.Name, options.Input)
+	err = podhelper.ExecStream(ctx, restConfig, &podhelper.ExecStreamOptions{
+		Pod:       pod.Name,
+		Namespace: pod.Namespace,
+		Container: "syncer",
+		Command:   command,
+		Stdin:     file,
+		Stdout:    os.Stdout,
+		Stderr:    os.Stderr,
+	})
+	if err != nil {
+		return fmt.Errorf("restore snapshot: %w", err)
+	}
+
+	// the snapshot is only staged and applied on the next start before the api server starts, so we
+	// stop all control plane instances first to make sure none of them writes into the restored state
+	err = lifecycle.PauseVCluster(ctx, kubeClient, vCluster.Name, vCluster.Namespace, log)
+	if err != nil {
+		return fmt.Errorf("stop vcluster: %w", err)
+	}
+	err = lifecycle.ResumeVCluster(ctx, kubeClient, vCluster.Name, vCluster.Namespace, log)
+	if err != nil {
+		return fmt.Errorf("start vcluster: %w", err)
+	}
+
+	log.Donef("Successfully restored vcluster %s/%s", vCluster.Namespace, vCluster.Name)
+	return nil
+}
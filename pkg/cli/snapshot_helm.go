@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/util/podhelper"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+type SnapshotOptions struct {
+	Output string
+}
+
+func SnapshotHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *SnapshotOptions, log log.Logger) error {
+	vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+	if err != nil {
+		return err
+	}
+
+	restConfig, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return err
+	}
+
+	pod, err := findRunningVClusterPod(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+	if err != nil {
+		return err
+	}
+
+	output := options.Output
+	if output == "" {
+		output = fmt.Sprintf("%s-%s.snapshot.gz", vCluster.Name, time.Now().Format("20060102150405"))
+	}
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	log.Infof("Taking snapshot of vcluster %s/%s...", vCluster.Namespace, vCluster.Name)
+	err = podhelper.ExecStream(ctx, restConfig, &podhelper.ExecStreamOptions{
+		Pod:       pod.Name,
+		Namespace: pod.Namespace,
+		Container: "syncer",
+		Command:   []string{"/vcluster", "snapshot", "--output", "-"},
+		Stdout:    file,
+		Stderr:    os.Stderr,
+	})
+	if err != nil {
+		_ = os.Remove(output)
+		return fmt.Errorf("take snapshot: %w", err)
+	}
+
+	log.Donef("Successfully wrote snapshot of vcluster %s/%s to %s", vCluster.Namespace, vCluster.Name, output)
+	return nil
+}
+
+func prepareSnapshot(vCluster *find.VCluster) (*rest.Config, *kubernetes.Clientset, error) {
+	restConfig, err := vCluster.ClientFactory.ClientConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("there is an error loading your current kube config (%w), please make sure you have access to a kubernetes cluster and the command `kubectl get namespaces` is working", err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return restConfig, kubeClient, nil
+}
+
+// findRunningVClusterPod returns a running control plane pod of the given vCluster
+func findRunningVClusterPod(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string) (*corev1.Pod, error) {
+	podList, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=vcluster,release=" + name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list vcluster pods: %w", err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning {
+			return &pod, nil
+		}
+	}
+
+	return nil, fmt.Errorf("couldn't find a running pod for vcluster %s/%s, please make sure the vcluster is not paused", namespace, name)
+}
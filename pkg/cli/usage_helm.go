@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/log/table"
+	"github.com/loft-sh/vcluster/pkg/cli/find"
+	"github.com/loft-sh/vcluster/pkg/cli/flags"
+	"github.com/loft-sh/vcluster/pkg/usage"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
+)
+
+type UsageOptions struct {
+	Output string
+}
+
+// UsageHelm prints the host resource usage of a single virtual cluster grouped by virtual namespace or, if
+// no name is given, the total usage of all virtual clusters in the current context
+func UsageHelm(ctx context.Context, globalFlags *flags.GlobalFlags, vClusterName string, options *UsageOptions, log log.Logger) error {
+	var vClusters []find.VCluster
+	if vClusterName != "" {
+		vCluster, err := find.GetVCluster(ctx, globalFlags.Context, vClusterName, globalFlags.Namespace, log)
+		if err != nil {
+			return err
+		}
+
+		vClusters = append(vClusters, *vCluster)
+	} else {
+		namespace := metav1.NamespaceAll
+		if globalFlags.Namespace != "" {
+			namespace = globalFlags.Namespace
+		}
+
+		var err error
+		vClusters, err = find.ListVClusters(ctx, globalFlags.Context, "", namespace, log.ErrorStreamOnly())
+		if err != nil {
+			return err
+		}
+	}
+
+	reports := []*usage.Report{}
+	for _, vCluster := range vClusters {
+		report, err := collectUsage(ctx, &vCluster)
+		if err != nil {
+			return fmt.Errorf("collect usage of vcluster %s/%s: %w", vCluster.Namespace, vCluster.Name, err)
+		}
+
+		reports = append(reports, report)
+	}
+
+	if options.Output == "json" {
+		var out []byte
+		var err error
+		if vClusterName != "" {
+			out, err = json.MarshalIndent(reports[0], "", "    ")
+		} else {
+			out, err = json.MarshalIndent(reports, "", "    ")
+		}
+		if err != nil {
+			return fmt.Errorf("json marshal usage: %w", err)
+		}
+
+		log.WriteString(logrus.InfoLevel, string(out)+"\n")
+		return nil
+	} else if options.Output != "" && options.Output != "table" {
+		return fmt.Errorf("unsupported output format %s, please use table or json", options.Output)
+	}
+
+	header := []string{"PODS", "CPU REQUESTS", "CPU LIMITS", "CPU USAGE", "MEMORY REQUESTS", "MEMORY LIMITS", "MEMORY USAGE", "PVCS", "STORAGE", "LOAD BALANCERS"}
+	values := [][]string{}
+	if vClusterName != "" {
+		header = append([]string{"NAMESPACE"}, header...)
+		for _, namespaceUsage := range reports[0].Namespaces {
+			values = append(values, append([]string{namespaceUsage.Namespace}, usageToValues(&namespaceUsage.Usage)...))
+		}
+		values = append(values, append([]string{"TOTAL"}, usageToValues(&reports[0].Total)...))
+	} else {
+		header = append([]string{"NAME", "NAMESPACE"}, header...)
+		for _, report := range reports {
+			values = append(values, append([]string{report.Name, report.Namespace}, usageToValues(&report.Total)...))
+		}
+	}
+
+	table.PrintTable(log, header, values)
+	for _, report := range reports {
+		if !report.MetricsAvailable {
+			log.Info("Actual usage is not shown as the host metrics api is not available, please make sure metrics-server is installed in the host cluster")
+			break
+		}
+	}
+
+	return nil
+}
+
+func collectUsage(ctx context.Context, vCluster *find.VCluster) (*usage.Report, error) {
+	restConfig, kubeClient, err := prepareSnapshot(vCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	metricsClient, err := metricsv1beta1.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	multiNamespace, err := usage.IsMultiNamespace(ctx, kubeClient, vCluster.Name, vCluster.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return usage.Collect(ctx, kubeClient, metricsClient, usage.Options{
+		Name:           vCluster.Name,
+		Namespace:      vCluster.Namespace,
+		MultiNamespace: multiNamespace,
+	})
+}
+
+func usageToValues(u *usage.Usage) []string {
+	quantity := func(list corev1.ResourceList, name corev1.ResourceName) string {
+		value, ok := list[name]
+		if !ok {
+			return "0"
+		}
+
+		return value.String()
+	}
+	usageQuantity := func(name corev1.ResourceName) string {
+		// usage is nil if the metrics api is not available
+		if u.Usage == nil {
+			return "-"
+		}
+
+		return quantity(u.Usage, name)
+	}
+
+	return []string{
+		strconv.Itoa(u.Pods),
+		quantity(u.Requests, corev1.ResourceCPU),
+		quantity(u.Limits, corev1.ResourceCPU),
+		usageQuantity(corev1.ResourceCPU),
+		quantity(u.Requests, corev1.ResourceMemory),
+		quantity(u.Limits, corev1.ResourceMemory),
+		usageQuantity(corev1.ResourceMemory),
+		strconv.Itoa(u.PersistentVolumeClaims),
+		u.Storage.String(),
+		strconv.Itoa(u.LoadBalancers),
+	}
+}
@@ -51,6 +51,9 @@ type VirtualClusterConfig struct {
 
 	// ControlPlaneNamespace is the namespace where the vCluster control plane is running
 	ControlPlaneNamespace string `json:"controlPlaneNamespace,omitempty"`
+
+	// DryRun is true if the syncers only preview their changes and nothing may be written to the host or virtual cluster
+	DryRun bool `json:"-"`
 }
 
 func (v VirtualClusterConfig) EmbeddedDatabase() bool {
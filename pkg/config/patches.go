@@ -0,0 +1,41 @@
+package config
+
+import (
+	"github.com/loft-sh/vcluster/config"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ToHostPatches returns the patches of sync.toHost by the group kind of the synced resource
+func (v VirtualClusterConfig) ToHostPatches() map[schema.GroupKind][]*config.Patch {
+	toHost := v.Sync.ToHost
+	return map[schema.GroupKind][]*config.Patch{
+		{Kind: "Pod"}:       toHost.Pods.Patches,
+		{Kind: "Secret"}:    toHost.Secrets.Patches,
+		{Kind: "ConfigMap"}: toHost.ConfigMaps.Patches,
+		{Group: "networking.k8s.io", Kind: "Ingress"}: toHost.Ingresses.Patches,
+		{Kind: "Service"}:   toHost.Services.Patches,
+		{Kind: "Endpoints"}: toHost.Endpoints.Patches,
+		{Group: "networking.k8s.io", Kind: "NetworkPolicy"}:        toHost.NetworkPolicies.Patches,
+		{Kind: "PersistentVolumeClaim"}:                            toHost.PersistentVolumeClaims.Patches,
+		{Kind: "PersistentVolume"}:                                 toHost.PersistentVolumes.Patches,
+		{Group: "snapshot.storage.k8s.io", Kind: "VolumeSnapshot"}: toHost.VolumeSnapshots.Patches,
+		{Group: "storage.k8s.io", Kind: "StorageClass"}:            toHost.StorageClasses.Patches,
+		{Kind: "ServiceAccount"}:                                   toHost.ServiceAccounts.Patches,
+		{Group: "policy", Kind: "PodDisruptionBudget"}:             toHost.PodDisruptionBudgets.Patches,
+		{Group: "scheduling.k8s.io", Kind: "PriorityClass"}:        toHost.PriorityClasses.Patches,
+	}
+}
+
+// FromHostPatches returns the patches of sync.fromHost by the group kind of the synced resource
+func (v VirtualClusterConfig) FromHostPatches() map[schema.GroupKind][]*config.Patch {
+	fromHost := v.Sync.FromHost
+	return map[schema.GroupKind][]*config.Patch{
+		{Kind: "Node"}:  fromHost.Nodes.Patches,
+		{Kind: "Event"}: fromHost.Events.Patches,
+		{Group: "networking.k8s.io", Kind: "IngressClass"}:    fromHost.IngressClasses.Patches,
+		{Group: "storage.k8s.io", Kind: "StorageClass"}:       fromHost.StorageClasses.Patches,
+		{Group: "storage.k8s.io", Kind: "CSINode"}:            fromHost.CSINodes.Patches,
+		{Group: "storage.k8s.io", Kind: "CSIDriver"}:          fromHost.CSIDrivers.Patches,
+		{Group: "storage.k8s.io", Kind: "CSIStorageCapacity"}: fromHost.CSIStorageCapacities.Patches,
+	}
+}
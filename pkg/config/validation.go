@@ -5,13 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/ghodss/yaml"
 	"github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/patches"
+	patchesregex "github.com/loft-sh/vcluster/pkg/patches/regex"
 	"github.com/loft-sh/vcluster/pkg/util/toleration"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 var allowedPodSecurityStandards = map[string]bool{
@@ -69,8 +75,24 @@ func ValidateConfigAndSetDefaults(config *VirtualClusterConfig) error {
 		return fmt.Errorf("you cannot enable both sync.fromHost.storageClasses.enabled and sync.toHost.storageClasses.enabled at the same time. Choose only one of them")
 	}
 
+	// validate class mappings
+	err := validateClassMappings("sync.fromHost.storageClasses.mappings", config.Sync.FromHost.StorageClasses.Mappings)
+	if err != nil {
+		return err
+	}
+	err = validateClassMappings("sync.fromHost.ingressClasses.mappings", config.Sync.FromHost.IngressClasses.Mappings)
+	if err != nil {
+		return err
+	}
+
+	// validate image rewrite rules
+	err = validateImageRewriteRules(config.Sync.ToHost.Pods.RewriteImages.Rules)
+	if err != nil {
+		return err
+	}
+
 	// validate central admission control
-	err := validateCentralAdmissionControl(config)
+	err = validateCentralAdmissionControl(config)
 	if err != nil {
 		return err
 	}
@@ -81,6 +103,12 @@ func ValidateConfigAndSetDefaults(config *VirtualClusterConfig) error {
 		return fmt.Errorf("validate experimental.genericSync")
 	}
 
+	// validate sync patches
+	err = validateSyncPatches(config)
+	if err != nil {
+		return err
+	}
+
 	// validate distro
 	err = validateDistro(config)
 	if err != nil {
@@ -92,6 +120,18 @@ func ValidateConfigAndSetDefaults(config *VirtualClusterConfig) error {
 		return err
 	}
 
+	// validate oidc
+	err = validateOIDC(config.ControlPlane.Advanced.OIDC)
+	if err != nil {
+		return err
+	}
+
+	// validate audit
+	err = validateAudit(config.ControlPlane.Advanced.Audit)
+	if err != nil {
+		return err
+	}
+
 	// check deny proxy requests
 	for _, c := range config.Experimental.DenyProxyRequests {
 		err := validateCheck(c)
@@ -106,6 +146,12 @@ func ValidateConfigAndSetDefaults(config *VirtualClusterConfig) error {
 		return err
 	}
 
+	// validate sleep mode
+	err = validateSleepMode(config)
+	if err != nil {
+		return err
+	}
+
 	// set service name
 	if config.ControlPlane.Advanced.WorkloadServiceAccount.Name == "" {
 		config.ControlPlane.Advanced.WorkloadServiceAccount.Name = "vc-workload-" + config.Name
@@ -237,7 +283,47 @@ func validateGenericSyncConfig(config config.ExperimentalGenericSync) error {
 	return nil
 }
 
+func validateSyncPatches(vConfig *VirtualClusterConfig) error {
+	for direction, patchesByKind := range map[string]map[schema.GroupKind][]*config.Patch{
+		"toHost":   vConfig.ToHostPatches(),
+		"fromHost": vConfig.FromHostPatches(),
+	} {
+		for groupKind, kindPatches := range patchesByKind {
+			for idx, patch := range kindPatches {
+				if patch == nil {
+					return fmt.Errorf("sync.%s patch %d for %s is empty", direction, idx, groupKind.String())
+				}
+
+				err := validatePatch(patch)
+				if err != nil {
+					return fmt.Errorf("invalid sync.%s patch %d for %s: %w", direction, idx, groupKind.String(), err)
+				}
+
+				if patch.Regex != "" {
+					patch.ParsedRegex, err = patchesregex.PrepareRegex(patch.Regex)
+					if err != nil {
+						return fmt.Errorf("invalid regex in sync.%s patch %d for %s: %w", direction, idx, groupKind.String(), err)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func validatePatch(patch *config.Patch) error {
+	for idx, condition := range patch.Conditions {
+		if condition == nil || condition.Expression == "" {
+			continue
+		}
+
+		_, err := patches.CompileExpression(condition.Expression)
+		if err != nil {
+			return fmt.Errorf("invalid conditions[%d].expression: %w", idx, err)
+		}
+	}
+
 	switch patch.Operation {
 	case config.PatchTypeRemove, config.PatchTypeReplace, config.PatchTypeAdd:
 		if patch.FromPath != "" {
@@ -253,6 +339,13 @@ func validatePatch(patch *config.Patch) error {
 		}
 
 		return nil
+	case config.PatchTypeExpression:
+		if patch.Expression == "" {
+			return fmt.Errorf("expression is required for this operation")
+		}
+
+		_, err := patches.CompileExpression(patch.Expression)
+		return err
 	default:
 		return fmt.Errorf("unsupported patch type %s", patch.Operation)
 	}
@@ -450,6 +543,100 @@ func validateWildcardOrAny(values []string) error {
 	return nil
 }
 
+func validateClassMappings(path string, mappings config.ClassMappings) error {
+	hostNames := map[string]string{}
+	for virtualName, hostName := range mappings.ByName {
+		if virtualName == "" || hostName == "" {
+			return fmt.Errorf("%s.byName: class names cannot be empty", path)
+		} else if otherName, ok := hostNames[hostName]; ok {
+			return fmt.Errorf("%s.byName: %s and %s cannot both map to host class %s", path, otherName, virtualName, hostName)
+		}
+
+		hostNames[hostName] = virtualName
+	}
+
+	return nil
+}
+
+func validateImageRewriteRules(rules []config.ImageRewriteRule) error {
+	for idx, rule := range rules {
+		if rule.From == "" || rule.To == "" {
+			return fmt.Errorf("sync.toHost.pods.rewriteImages.rules[%d]: from and to are required", idx)
+		}
+
+		if rule.Regex {
+			_, err := regexp.Compile(rule.From)
+			if err != nil {
+				return fmt.Errorf("sync.toHost.pods.rewriteImages.rules[%d]: invalid regex %s: %w", idx, rule.From, err)
+			}
+		} else if strings.Count(rule.From, "*") > 1 {
+			return fmt.Errorf("sync.toHost.pods.rewriteImages.rules[%d]: from %s can only contain a single wildcard", idx, rule.From)
+		} else if !strings.Contains(rule.From, "*") && strings.Contains(rule.To, "*") {
+			return fmt.Errorf("sync.toHost.pods.rewriteImages.rules[%d]: to %s cannot contain a wildcard if from does not contain one", idx, rule.To)
+		}
+	}
+
+	return nil
+}
+
+func validateSleepMode(vConfig *VirtualClusterConfig) error {
+	if !vConfig.Experimental.SleepMode.Enabled {
+		return nil
+	}
+
+	if vConfig.Experimental.IsolatedControlPlane.Headless {
+		return fmt.Errorf("experimental.sleepMode is not supported with experimental.isolatedControlPlane.headless")
+	}
+
+	if vConfig.Experimental.SleepMode.AfterInactivity != "" {
+		afterInactivity, err := time.ParseDuration(vConfig.Experimental.SleepMode.AfterInactivity)
+		if err != nil {
+			return fmt.Errorf("experimental.sleepMode.afterInactivity: %w", err)
+		} else if afterInactivity < time.Minute {
+			return fmt.Errorf("experimental.sleepMode.afterInactivity needs to be at least 1m")
+		}
+	}
+
+	return nil
+}
+
+func validateAudit(audit config.ControlPlaneAudit) error {
+	if !audit.Enabled {
+		return nil
+	}
+
+	if !audit.Log.Enabled && !audit.Webhook.Enabled {
+		return errors.New("controlPlane.advanced.audit requires either the log or the webhook backend to be enabled")
+	}
+	if audit.Log.Enabled && audit.Log.Path == "" {
+		return errors.New("controlPlane.advanced.audit.log.path is required if the audit log is enabled")
+	}
+	if audit.Webhook.Enabled && audit.Webhook.Config == "" {
+		return errors.New("controlPlane.advanced.audit.webhook.config is required if the audit webhook is enabled")
+	}
+
+	return nil
+}
+
+func validateOIDC(oidc config.ControlPlaneOIDC) error {
+	if !oidc.Enabled {
+		return nil
+	}
+
+	issuerURL, err := url.Parse(oidc.IssuerURL)
+	if err != nil || issuerURL.Scheme != "https" || issuerURL.Host == "" {
+		return fmt.Errorf("controlPlane.advanced.oidc.issuerURL %q must be a valid https url", oidc.IssuerURL)
+	}
+	if oidc.ClientID == "" {
+		return errors.New("controlPlane.advanced.oidc.clientID is required if oidc is enabled")
+	}
+	if oidc.CA != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(oidc.CA)) {
+		return errors.New("controlPlane.advanced.oidc.ca does not contain any valid certificates")
+	}
+
+	return nil
+}
+
 func validateK0sAndNoExperimentalKubeconfig(c *VirtualClusterConfig) error {
 	if c.Distro() != config.K0SDistro {
 		return nil
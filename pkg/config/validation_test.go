@@ -125,3 +125,60 @@ func mutHook(clientCfg config.ValidatingWebhookClientConfig) config.MutatingWebh
 	}
 	return hook
 }
+
+func TestValidateAudit(t *testing.T) {
+	testCases := []struct {
+		name    string
+		audit   config.ControlPlaneAudit
+		wantErr string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name: "log backend",
+			audit: config.ControlPlaneAudit{
+				Enabled: true,
+				Log:     config.AuditLog{Enabled: true, Path: "/data/audit/audit.log"},
+			},
+		},
+		{
+			name: "webhook backend",
+			audit: config.ControlPlaneAudit{
+				Enabled: true,
+				Webhook: config.AuditWebhook{Enabled: true, Config: "apiVersion: v1"},
+			},
+		},
+		{
+			name:    "no backend",
+			audit:   config.ControlPlaneAudit{Enabled: true},
+			wantErr: "controlPlane.advanced.audit requires either the log or the webhook backend to be enabled",
+		},
+		{
+			name: "log without path",
+			audit: config.ControlPlaneAudit{
+				Enabled: true,
+				Log:     config.AuditLog{Enabled: true},
+			},
+			wantErr: "controlPlane.advanced.audit.log.path is required if the audit log is enabled",
+		},
+		{
+			name: "webhook without config",
+			audit: config.ControlPlaneAudit{
+				Enabled: true,
+				Webhook: config.AuditWebhook{Enabled: true},
+			},
+			wantErr: "controlPlane.advanced.audit.webhook.config is required if the audit webhook is enabled",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAudit(tt.audit)
+			if err != nil && (tt.wantErr == "" || tt.wantErr != err.Error()) {
+				t.Errorf("wanted err to be %s but got %s", tt.wantErr, err.Error())
+			} else if err == nil && tt.wantErr != "" {
+				t.Errorf("wanted err to be %s but got nil", tt.wantErr)
+			}
+		})
+	}
+}
@@ -8,6 +8,9 @@ const (
 	PausedReplicasAnnotation = "loft.sh/paused-replicas"
 	PausedDateAnnotation     = "loft.sh/paused-date"
 
+	// FrozenAnnotation on the config secret starts the control plane without syncing and rejects writes
+	FrozenAnnotation = "vcluster.loft.sh/frozen"
+
 	// NodeSuffix is the dns suffix for our nodes
 	NodeSuffix = "nodes.vcluster.com"
 
@@ -8,6 +8,7 @@ const (
 	IndexByAssigned      = "IndexByAssigned"
 	IndexByStorageClass  = "IndexByStorageClass"
 	IndexByIngressSecret = "IndexByIngressSecret"
+	IndexByGatewaySecret = "IndexByGatewaySecret"
 	IndexByPodSecret     = "IndexByPodSecret"
 	IndexByConfigMap     = "IndexByConfigMap"
 	// IndexByHostName is used to map rewritten hostnames(advertised as node addresses) to nodenames
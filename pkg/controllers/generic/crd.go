@@ -0,0 +1,17 @@
+package generic
+
+import (
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// EnsureCRD copies the CRD of the given kind from the host cluster into the virtual cluster and returns if it is
+// cluster scoped and has a status subresource. In dry-run mode the CRD is only looked up and never created.
+func EnsureCRD(ctx *synccontext.RegisterContext, gvk schema.GroupVersionKind) (bool, bool, error) {
+	if ctx.Config.DryRun {
+		return translate.DescribeCRDFromPhysicalCluster(ctx.Context, ctx.PhysicalManager.GetConfig(), ctx.VirtualManager.GetConfig(), gvk)
+	}
+
+	return translate.EnsureCRDFromPhysicalCluster(ctx.Context, ctx.PhysicalManager.GetConfig(), ctx.VirtualManager.GetConfig(), gvk)
+}
@@ -0,0 +1,49 @@
+package generic
+
+import (
+	"github.com/loft-sh/vcluster/pkg/constants"
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newClusterScopedTranslator creates a translator for cluster scoped exports. Host objects are prefixed
+// via PhysicalNameClusterScoped and ownership is tracked through IsManagedCluster.
+func newClusterScopedTranslator(ctx *synccontext.RegisterContext, name string, obj client.Object) translator.NamespacedTranslator {
+	return &clusterScopedTranslator{
+		Translator: translator.NewClusterTranslator(ctx, name, obj, func(vName string, _ client.Object) string {
+			return translate.Default.PhysicalNameClusterScoped(vName)
+		}),
+
+		obj:           obj,
+		eventRecorder: ctx.VirtualManager.GetEventRecorderFor(name + "-syncer"),
+	}
+}
+
+type clusterScopedTranslator struct {
+	translator.Translator
+
+	obj           client.Object
+	eventRecorder record.EventRecorder
+}
+
+func (c *clusterScopedTranslator) EventRecorder() record.EventRecorder {
+	return c.eventRecorder
+}
+
+func (c *clusterScopedTranslator) RegisterIndices(ctx *synccontext.RegisterContext) error {
+	return ctx.VirtualManager.GetFieldIndexer().IndexField(ctx.Context, c.obj.DeepCopyObject().(client.Object), constants.IndexByPhysicalName, func(rawObj client.Object) []string {
+		return []string{translate.Default.PhysicalNameClusterScoped(rawObj.GetName())}
+	})
+}
+
+func (c *clusterScopedTranslator) SyncToHostCreate(ctx *synccontext.SyncContext, vObj, pObj client.Object) (ctrl.Result, error) {
+	return translator.SyncToHostCreate(ctx, c.Name(), c.eventRecorder, vObj, pObj)
+}
+
+func (c *clusterScopedTranslator) SyncToHostUpdate(ctx *synccontext.SyncContext, vObj, pObj client.Object) (ctrl.Result, error) {
+	return translator.SyncToHostUpdate(ctx, c.Name(), c.eventRecorder, vObj, pObj)
+}
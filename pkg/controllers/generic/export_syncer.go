@@ -31,25 +31,41 @@ import (
 )
 
 func CreateExporters(ctx *config.ControllerContext) error {
+	exporters, err := NewExporters(ctx)
+	if err != nil {
+		return err
+	}
+
+	registerCtx := util.ToRegisterContext(ctx)
+	for _, s := range exporters {
+		err = syncer.RegisterSyncer(registerCtx, s)
+		klog.Infof("registering export syncer %s", s.Name())
+		if err != nil {
+			return fmt.Errorf("error registering syncer %w", err)
+		}
+	}
+
+	return nil
+}
+
+// NewExporters creates the export syncers from the generic sync config without registering them
+func NewExporters(ctx *config.ControllerContext) ([]syncertypes.Syncer, error) {
 	exporterConfig := ctx.Config.Experimental.GenericSync
 	if len(exporterConfig.Exports) == 0 {
-		return nil
+		return nil, nil
 	}
 	registerCtx := util.ToRegisterContext(ctx)
 
+	exporters := []syncertypes.Syncer{}
 	for _, exportConfig := range exporterConfig.Exports {
-		_, hasStatusSubresource, err := translate.EnsureCRDFromPhysicalCluster(
-			registerCtx.Context,
-			registerCtx.PhysicalManager.GetConfig(),
-			registerCtx.VirtualManager.GetConfig(),
-			schema.FromAPIVersionAndKind(exportConfig.APIVersion, exportConfig.Kind))
+		isClusterScoped, hasStatusSubresource, err := EnsureCRD(registerCtx, schema.FromAPIVersionAndKind(exportConfig.APIVersion, exportConfig.Kind))
 		if err != nil {
 			if exportConfig.Optional {
 				klog.Infof("error ensuring CRD %s(%s) from host cluster: %v. Skipping exportSyncer as resource is optional", exportConfig.Kind, exportConfig.APIVersion, err)
 				continue
 			}
 
-			return fmt.Errorf("error creating %s(%s) syncer: %w", exportConfig.Kind, exportConfig.APIVersion, err)
+			return nil, fmt.Errorf("error creating %s(%s) syncer: %w", exportConfig.Kind, exportConfig.APIVersion, err)
 		}
 
 		reversePatches := []*vclusterconfig.Patch{
@@ -62,23 +78,19 @@ func CreateExporters(ctx *config.ControllerContext) error {
 		reversePatches = append(reversePatches, exportConfig.ReversePatches...)
 		exportConfig.ReversePatches = reversePatches
 
-		s, err := createExporterFromConfig(registerCtx, exportConfig, hasStatusSubresource)
+		s, err := createExporterFromConfig(registerCtx, exportConfig, isClusterScoped, hasStatusSubresource)
 		klog.Infof("creating exporter for %s/%s", exportConfig.APIVersion, exportConfig.Kind)
 		if err != nil {
-			return fmt.Errorf("error creating %s(%s) syncer: %w", exportConfig.Kind, exportConfig.APIVersion, err)
+			return nil, fmt.Errorf("error creating %s(%s) syncer: %w", exportConfig.Kind, exportConfig.APIVersion, err)
 		}
 
-		err = syncer.RegisterSyncer(registerCtx, s)
-		klog.Infof("registering export syncer for %s/%s", exportConfig.APIVersion, exportConfig.Kind)
-		if err != nil {
-			return fmt.Errorf("error registering syncer %w", err)
-		}
+		exporters = append(exporters, s)
 	}
 
-	return nil
+	return exporters, nil
 }
 
-func createExporterFromConfig(ctx *synccontext.RegisterContext, config *vclusterconfig.Export, hasStatusSubresource bool) (syncertypes.Syncer, error) {
+func createExporterFromConfig(ctx *synccontext.RegisterContext, config *vclusterconfig.Export, isClusterScoped, hasStatusSubresource bool) (syncertypes.Syncer, error) {
 	obj := &unstructured.Unstructured{}
 	obj.SetKind(config.Kind)
 	obj.SetAPIVersion(config.APIVersion)
@@ -98,12 +110,22 @@ func createExporterFromConfig(ctx *synccontext.RegisterContext, config *vcluster
 
 	gvk := schema.FromAPIVersionAndKind(config.APIVersion, config.Kind)
 	controllerID := fmt.Sprintf("%s/%s/GenericExport", strings.ToLower(gvk.Kind), strings.ToLower(gvk.Group))
+
+	// cluster scoped objects are prefixed with the vCluster name and namespace on the host
+	var namespacedTranslator translator.NamespacedTranslator
+	if isClusterScoped {
+		namespacedTranslator = newClusterScopedTranslator(ctx, controllerID, obj)
+	} else {
+		namespacedTranslator = translator.NewNamespacedTranslator(ctx, controllerID, obj)
+	}
+
 	return &exporter{
 		ObjectPatcher: &exportPatcher{
-			config: config,
-			gvk:    gvk,
+			config:        config,
+			gvk:           gvk,
+			clusterScoped: isClusterScoped,
 		},
-		NamespacedTranslator: translator.NewNamespacedTranslator(ctx, controllerID, obj),
+		NamespacedTranslator: namespacedTranslator,
 
 		patcher:  NewPatcher(ctx.VirtualManager.GetClient(), ctx.PhysicalManager.GetClient(), hasStatusSubresource, log.New(controllerID)),
 		gvk:      gvk,
@@ -122,11 +144,7 @@ func BuildCustomExporter(
 	namespacedTranslator translator.NamespacedTranslator,
 	replaceWhenInvalid bool,
 ) (syncertypes.Object, error) {
-	_, hasStatusSubresource, err := translate.EnsureCRDFromPhysicalCluster(
-		registerCtx.Context,
-		registerCtx.PhysicalManager.GetConfig(),
-		registerCtx.VirtualManager.GetConfig(),
-		gvk)
+	_, hasStatusSubresource, err := EnsureCRD(registerCtx, gvk)
 	if err != nil {
 		return nil, fmt.Errorf("error creating %s(%s) syncer: %w", gvk.Kind, gvk.GroupVersion().String(), err)
 	}
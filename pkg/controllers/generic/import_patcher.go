@@ -25,7 +25,7 @@ func (s *importPatcher) ServerSideApply(ctx context.Context, _, destObj, sourceO
 }
 
 func (s *importPatcher) ReverseUpdate(_ context.Context, destObj, sourceObj client.Object) error {
-	return patches.ApplyPatches(destObj, sourceObj, s.config.ReversePatches, nil, &virtualToHostNameResolver{namespace: sourceObj.GetNamespace()})
+	return patches.ApplyPatches(destObj, sourceObj, s.config.ReversePatches, nil, patches.NewVirtualToHostNameResolver(sourceObj.GetNamespace(), false))
 }
 
 type hostToVirtualImportNameResolver struct {
@@ -52,11 +52,7 @@ func CreateImporters(ctx *config.ControllerContext) error {
 
 		// don't skip even if scheme.Recognizes(gvk) to ensure scope for builtin
 		// cluster scoped resources is registered and set properly
-		isClusterScoped, hasStatusSubresource, err := translate.EnsureCRDFromPhysicalCluster(
-			registerCtx.Context,
-			registerCtx.PhysicalManager.GetConfig(),
-			registerCtx.VirtualManager.GetConfig(),
-			gvk)
+		isClusterScoped, hasStatusSubresource, err := EnsureCRD(registerCtx, gvk)
 		if err != nil {
 			if importConfig.Optional {
 				klog.Infof("error ensuring CRD %s(%s) from host cluster: %v, Skipping importSyncer as resource is optional", importConfig.Kind, importConfig.APIVersion, err)
@@ -116,12 +112,7 @@ func BuildCustomImporter(
 	gvk schema.GroupVersionKind,
 	replaceWhenInvalid bool,
 ) (syncertypes.Object, error) {
-	isClusterScoped, hasStatusSubresource, err := translate.EnsureCRDFromPhysicalCluster(
-		registerCtx.Context,
-		registerCtx.PhysicalManager.GetConfig(),
-		registerCtx.VirtualManager.GetConfig(),
-		gvk,
-	)
+	isClusterScoped, hasStatusSubresource, err := EnsureCRD(registerCtx, gvk)
 	if err != nil {
 		return nil, fmt.Errorf("error creating %s(%s) syncer: %w", gvk.Kind, gvk.GroupVersion().String(), err)
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/csistoragecapacities"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/endpoints"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/events"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/gatewayapi"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/ingressclasses"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/ingresses"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/namespaces"
@@ -34,6 +35,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/controllers/servicesync"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/plugin"
 	"github.com/loft-sh/vcluster/pkg/util/blockingcacheclient"
 	util "github.com/loft-sh/vcluster/pkg/util/context"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -69,6 +71,12 @@ func getSyncers(ctx *config.ControllerContext) []BuildController {
 		isEnabled(ctx.Config.Sync.ToHost.PersistentVolumeClaims.Enabled, persistentvolumeclaims.New),
 		isEnabled(ctx.Config.Sync.ToHost.Ingresses.Enabled, ingresses.New),
 		isEnabled(ctx.Config.Sync.FromHost.IngressClasses.Enabled, ingressclasses.New),
+		isEnabled(ctx.Config.Sync.ToHost.GatewayAPI.Enabled, gatewayapi.NewGatewayClassSyncer),
+		isEnabled(ctx.Config.Sync.ToHost.GatewayAPI.Enabled && ctx.Config.Sync.ToHost.GatewayAPI.Gateways.Enabled, gatewayapi.NewGatewaySyncer),
+		isEnabled(ctx.Config.Sync.ToHost.GatewayAPI.Enabled, gatewayapi.NewHTTPRouteSyncer),
+		isEnabled(ctx.Config.Sync.ToHost.GatewayAPI.Enabled, gatewayapi.NewGRPCRouteSyncer),
+		isEnabled(ctx.Config.Sync.ToHost.GatewayAPI.Enabled, gatewayapi.NewTCPRouteSyncer),
+		isEnabled(ctx.Config.Sync.ToHost.GatewayAPI.Enabled && ctx.Config.Experimental.MultiNamespaceMode.Enabled, gatewayapi.NewReferenceGrantSyncer),
 		isEnabled(ctx.Config.Sync.ToHost.StorageClasses.Enabled, storageclasses.New),
 		isEnabled(ctx.Config.Sync.FromHost.StorageClasses.Enabled == "true", storageclasses.NewHostStorageClassSyncer),
 		isEnabled(ctx.Config.Sync.ToHost.PriorityClasses.Enabled, priorityclasses.New),
@@ -113,12 +121,25 @@ func Create(ctx *config.ControllerContext) ([]syncertypes.Object, error) {
 
 		if err != nil {
 			return nil, fmt.Errorf("register %s controller: %w", name, err)
+		} else if createdController == nil {
+			// optional syncers return nil if their resource is not available
+			continue
 		}
 
 		loghelper.Infof("Start %s sync controller", name)
 		syncers = append(syncers, createdController)
 	}
 
+	// register syncers implemented by plugins
+	pluginSyncers, err := plugin.DefaultManager.NewSyncers(registerContext)
+	if err != nil {
+		return nil, fmt.Errorf("register plugin syncers: %w", err)
+	}
+	for _, pluginSyncer := range pluginSyncers {
+		loghelper.Infof("Start %s plugin sync controller", pluginSyncer.Name())
+		syncers = append(syncers, pluginSyncer)
+	}
+
 	return syncers, nil
 }
 
@@ -97,7 +97,12 @@ func (s *configMapSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.O
 		return ctrl.Result{}, nil
 	}
 
-	return s.SyncToHostCreate(ctx, vObj, s.translate(ctx.Context, vObj.(*corev1.ConfigMap)))
+	pObj, err := s.translate(ctx, vObj.(*corev1.ConfigMap))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return s.SyncToHostCreate(ctx, vObj, pObj)
 }
 
 func (s *configMapSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
@@ -120,8 +125,10 @@ func (s *configMapSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object,
 		return ctrl.Result{}, nil
 	}
 
-	newConfigMap := s.translateUpdate(ctx.Context, pObj.(*corev1.ConfigMap), vObj.(*corev1.ConfigMap))
-	if newConfigMap != nil {
+	newConfigMap, err := s.translateUpdate(ctx, pObj.(*corev1.ConfigMap), vObj.(*corev1.ConfigMap))
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if newConfigMap != nil {
 		translator.PrintChanges(pObj, newConfigMap, ctx.Log)
 	}
 
@@ -1,8 +1,7 @@
 package configmaps
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -10,17 +9,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *configMapSyncer) translate(ctx context.Context, vObj client.Object) *corev1.ConfigMap {
-	pObj := s.TranslateMetadata(ctx, vObj).(*corev1.ConfigMap)
-	pObj.SetName(s.VirtualToHost(ctx, types.NamespacedName{Name: vObj.GetName(), Namespace: vObj.GetNamespace()}, vObj).Name)
-	return pObj
+func (s *configMapSyncer) translate(ctx *synccontext.SyncContext, vObj client.Object) (*corev1.ConfigMap, error) {
+	pObj := s.TranslateMetadata(ctx.Context, vObj).(*corev1.ConfigMap)
+	pObj.SetName(s.VirtualToHost(ctx.Context, types.NamespacedName{Name: vObj.GetName(), Namespace: vObj.GetNamespace()}, vObj).Name)
+	return pObj, translator.ApplyToHostPatches(ctx, vObj, pObj)
 }
 
-func (s *configMapSyncer) translateUpdate(ctx context.Context, pObj, vObj *corev1.ConfigMap) *corev1.ConfigMap {
+func (s *configMapSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *corev1.ConfigMap) (*corev1.ConfigMap, error) {
 	var updated *corev1.ConfigMap
 
 	// check annotations & labels
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		updated = translator.NewIfNil(updated, pObj)
 		updated.Labels = updatedLabels
@@ -39,5 +38,5 @@ func (s *configMapSyncer) translateUpdate(ctx context.Context, pObj, vObj *corev
 		updated.BinaryData = vObj.BinaryData
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
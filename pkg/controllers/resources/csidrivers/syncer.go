@@ -23,15 +23,21 @@ var _ syncer.ToVirtualSyncer = &csidriverSyncer{}
 var _ syncer.Syncer = &csidriverSyncer{}
 
 func (s *csidriverSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pObj client.Object) (ctrl.Result, error) {
-	vObj := s.translateBackwards(ctx.Context, pObj.(*storagev1.CSIDriver))
+	vObj, err := s.translateBackwards(ctx, pObj.(*storagev1.CSIDriver))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	ctx.Log.Infof("create CSIDriver %s, because it does not exist in virtual cluster", vObj.Name)
 	return ctrl.Result{}, ctx.VirtualClient.Create(ctx.Context, vObj)
 }
 
 func (s *csidriverSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
 	// check if there is a change
-	updated := s.translateUpdateBackwards(ctx.Context, pObj.(*storagev1.CSIDriver), vObj.(*storagev1.CSIDriver))
-	if updated != nil {
+	updated, err := s.translateUpdateBackwards(ctx, pObj.(*storagev1.CSIDriver), vObj.(*storagev1.CSIDriver))
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if updated != nil {
 		ctx.Log.Infof("update CSIDriver %s", vObj.GetName())
 		translator.PrintChanges(pObj, updated, ctx.Log)
 		return ctrl.Result{}, ctx.VirtualClient.Update(ctx.Context, updated)
@@ -1,21 +1,21 @@
 package csidrivers
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (s *csidriverSyncer) translateBackwards(ctx context.Context, pCSIDriver *storagev1.CSIDriver) *storagev1.CSIDriver {
-	return s.TranslateMetadata(ctx, pCSIDriver).(*storagev1.CSIDriver)
+func (s *csidriverSyncer) translateBackwards(ctx *synccontext.SyncContext, pCSIDriver *storagev1.CSIDriver) (*storagev1.CSIDriver, error) {
+	vCSIDriver := s.TranslateMetadata(ctx.Context, pCSIDriver).(*storagev1.CSIDriver)
+	return vCSIDriver, translator.ApplyFromHostPatches(ctx, pCSIDriver, vCSIDriver)
 }
 
-func (s *csidriverSyncer) translateUpdateBackwards(ctx context.Context, pObj, vObj *storagev1.CSIDriver) *storagev1.CSIDriver {
+func (s *csidriverSyncer) translateUpdateBackwards(ctx *synccontext.SyncContext, pObj, vObj *storagev1.CSIDriver) (*storagev1.CSIDriver, error) {
 	var updated *storagev1.CSIDriver
 
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		updated = translator.NewIfNil(updated, vObj)
 		updated.Labels = updatedLabels
@@ -27,5 +27,5 @@ func (s *csidriverSyncer) translateUpdateBackwards(ctx context.Context, pObj, vO
 		pObj.Spec.DeepCopyInto(&updated.Spec)
 	}
 
-	return updated
+	return translator.ApplyFromHostPatchesUpdate(ctx, pObj, vObj, updated)
 }
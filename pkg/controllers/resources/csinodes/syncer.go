@@ -36,7 +36,11 @@ func (s *csinodeSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pObj client.
 	} else if err != nil {
 		return ctrl.Result{}, err
 	}
-	vObj := s.translateBackwards(ctx.Context, pObj.(*storagev1.CSINode))
+	vObj, err := s.translateBackwards(ctx, pObj.(*storagev1.CSINode))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	ctx.Log.Infof("create CSINode %s, because it does not exist in virtual cluster", vObj.Name)
 	return ctrl.Result{}, ctx.VirtualClient.Create(ctx.Context, vObj)
 }
@@ -52,8 +56,10 @@ func (s *csinodeSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, v
 		return ctrl.Result{}, err
 	}
 	// check if there is a change
-	updated := s.translateUpdateBackwards(ctx.Context, pObj.(*storagev1.CSINode), vObj.(*storagev1.CSINode))
-	if updated != nil {
+	updated, err := s.translateUpdateBackwards(ctx, pObj.(*storagev1.CSINode), vObj.(*storagev1.CSINode))
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if updated != nil {
 		ctx.Log.Infof("update CSINode %s", vObj.GetName())
 		translator.PrintChanges(pObj, updated, ctx.Log)
 		return ctrl.Result{}, ctx.VirtualClient.Update(ctx.Context, updated)
@@ -1,21 +1,21 @@
 package csinodes
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (s *csinodeSyncer) translateBackwards(ctx context.Context, pCSINode *storagev1.CSINode) *storagev1.CSINode {
-	return s.TranslateMetadata(ctx, pCSINode).(*storagev1.CSINode)
+func (s *csinodeSyncer) translateBackwards(ctx *synccontext.SyncContext, pCSINode *storagev1.CSINode) (*storagev1.CSINode, error) {
+	vCSINode := s.TranslateMetadata(ctx.Context, pCSINode).(*storagev1.CSINode)
+	return vCSINode, translator.ApplyFromHostPatches(ctx, pCSINode, vCSINode)
 }
 
-func (s *csinodeSyncer) translateUpdateBackwards(ctx context.Context, pObj, vObj *storagev1.CSINode) *storagev1.CSINode {
+func (s *csinodeSyncer) translateUpdateBackwards(ctx *synccontext.SyncContext, pObj, vObj *storagev1.CSINode) (*storagev1.CSINode, error) {
 	var updated *storagev1.CSINode
 
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		updated = translator.NewIfNil(updated, vObj)
 		updated.Labels = updatedLabels
@@ -27,5 +27,5 @@ func (s *csinodeSyncer) translateUpdateBackwards(ctx context.Context, pObj, vObj
 		pObj.Spec.DeepCopyInto(&updated.Spec)
 	}
 
-	return updated
+	return translator.ApplyFromHostPatchesUpdate(ctx, pObj, vObj, updated)
 }
@@ -68,7 +68,7 @@ func (s *csistoragecapacitySyncer) translateBackwards(ctx *synccontext.SyncConte
 
 	vObj.StorageClassName = scName
 
-	return vObj, false, nil
+	return vObj, false, translator.ApplyFromHostPatches(ctx, pObj, vObj)
 }
 
 func (s *csistoragecapacitySyncer) translateUpdateBackwards(ctx *synccontext.SyncContext, pObj, vObj *storagev1.CSIStorageCapacity) (*storagev1.CSIStorageCapacity, bool, error) {
@@ -112,5 +112,6 @@ func (s *csistoragecapacitySyncer) translateUpdateBackwards(ctx *synccontext.Syn
 		updated.MaximumVolumeSize = pObj.MaximumVolumeSize
 	}
 
-	return updated, false, nil
+	updated, err = translator.ApplyFromHostPatchesUpdate(ctx, pObj, vObj, updated)
+	return updated, false, err
 }
@@ -28,7 +28,12 @@ type endpointsSyncer struct {
 }
 
 func (s *endpointsSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
-	return s.SyncToHostCreate(ctx, vObj, s.translate(ctx.Context, vObj))
+	pObj, err := s.translate(ctx, vObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return s.SyncToHostCreate(ctx, vObj, pObj)
 }
 
 func (s *endpointsSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
@@ -37,7 +42,10 @@ func (s *endpointsSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object,
 		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
 	}
 
-	s.translateUpdate(ctx.Context, pObj.(*corev1.Endpoints), vObj.(*corev1.Endpoints))
+	err = s.translateUpdate(ctx, pObj.(*corev1.Endpoints), vObj.(*corev1.Endpoints))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, patch.Patch(ctx, pObj, vObj)
 }
@@ -1,16 +1,16 @@
 package endpoints
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *endpointsSyncer) translate(ctx context.Context, vObj client.Object) *corev1.Endpoints {
-	endpoints := s.TranslateMetadata(ctx, vObj).(*corev1.Endpoints)
+func (s *endpointsSyncer) translate(ctx *synccontext.SyncContext, vObj client.Object) (*corev1.Endpoints, error) {
+	endpoints := s.TranslateMetadata(ctx.Context, vObj).(*corev1.Endpoints)
 	s.translateSpec(endpoints)
 
 	// make sure we delete the control-plane.alpha.kubernetes.io/leader annotation
@@ -19,7 +19,7 @@ func (s *endpointsSyncer) translate(ctx context.Context, vObj client.Object) *co
 		delete(endpoints.Annotations, "control-plane.alpha.kubernetes.io/leader")
 	}
 
-	return endpoints
+	return endpoints, translator.ApplyToHostPatches(ctx, vObj, endpoints)
 }
 
 func (s *endpointsSyncer) translateSpec(endpoints *corev1.Endpoints) {
@@ -48,7 +48,7 @@ func (s *endpointsSyncer) translateSpec(endpoints *corev1.Endpoints) {
 	}
 }
 
-func (s *endpointsSyncer) translateUpdate(ctx context.Context, pObj, vObj *corev1.Endpoints) {
+func (s *endpointsSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *corev1.Endpoints) error {
 	// check subsets
 	translated := vObj.DeepCopy()
 	s.translateSpec(translated)
@@ -57,10 +57,12 @@ func (s *endpointsSyncer) translateUpdate(ctx context.Context, pObj, vObj *corev
 	}
 
 	// check annotations & labels
-	_, annotations, labels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	_, annotations, labels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	delete(annotations, "control-plane.alpha.kubernetes.io/leader")
 	if !equality.Semantic.DeepEqual(annotations, pObj.Annotations) || !equality.Semantic.DeepEqual(labels, pObj.Labels) {
 		pObj.Annotations = annotations
 		pObj.Labels = labels
 	}
+
+	return translator.ApplyToHostPatches(ctx, vObj, pObj)
 }
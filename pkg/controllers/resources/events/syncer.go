@@ -104,6 +104,12 @@ func (s *eventSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vOb
 	vEvent.TypeMeta = vOldEvent.TypeMeta
 	vEvent.ObjectMeta = vOldEvent.ObjectMeta
 
+	// apply the sync patches before the events are compared
+	err = translator.ApplyFromHostPatches(ctx, pEvent, vEvent)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// update existing event only if changed
 	if equality.Semantic.DeepEqual(vEvent, vOldEvent) {
 		return ctrl.Result{}, nil
@@ -128,6 +134,10 @@ func (s *eventSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pObj client.Ob
 	if err != nil {
 		return ctrl.Result{}, IgnoreAcceptableErrors(err)
 	}
+	err = translator.ApplyFromHostPatches(ctx, pObj, vObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
 	// make sure namespace is not being deleted
 	namespace := &corev1.Namespace{}
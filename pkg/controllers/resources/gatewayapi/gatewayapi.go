@@ -0,0 +1,116 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/controllers/generic"
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// GroupName is the api group of the Gateway API resources
+const GroupName = "gateway.networking.k8s.io"
+
+var (
+	GatewayClassGVK   = schema.GroupVersionKind{Group: GroupName, Version: "v1", Kind: "GatewayClass"}
+	GatewayGVK        = schema.GroupVersionKind{Group: GroupName, Version: "v1", Kind: "Gateway"}
+	HTTPRouteGVK      = schema.GroupVersionKind{Group: GroupName, Version: "v1", Kind: "HTTPRoute"}
+	GRPCRouteGVK      = schema.GroupVersionKind{Group: GroupName, Version: "v1", Kind: "GRPCRoute"}
+	TCPRouteGVK       = schema.GroupVersionKind{Group: GroupName, Version: "v1alpha2", Kind: "TCPRoute"}
+	ReferenceGrantGVK = schema.GroupVersionKind{Group: GroupName, Version: "v1beta1", Kind: "ReferenceGrant"}
+)
+
+// NewObject returns an empty unstructured object of the given kind
+func NewObject(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+// NewObjectList returns an empty unstructured list of the given kind
+func NewObjectList(gvk schema.GroupVersionKind) *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	return list
+}
+
+// kindInstalled checks if the given kind is served by the host cluster
+func kindInstalled(ctx *synccontext.RegisterContext, gvk schema.GroupVersionKind) (bool, error) {
+	_, err := translate.KindExists(ctx.PhysicalManager.GetConfig(), gvk)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("check %s in host cluster: %w", gvk.String(), err)
+	}
+
+	return true, nil
+}
+
+// ensureKinds copies the CRDs of the given kinds from the host cluster into the virtual cluster
+func ensureKinds(ctx *synccontext.RegisterContext, gvks ...schema.GroupVersionKind) error {
+	for _, gvk := range gvks {
+		_, _, err := generic.EnsureCRD(ctx, gvk)
+		if err != nil {
+			return fmt.Errorf("ensure %s crd: %w", gvk.String(), err)
+		}
+	}
+
+	return nil
+}
+
+// listReferenceGrants returns all reference grants within the virtual cluster
+func listReferenceGrants(ctx context.Context, virtualClient client.Client) (referenceGrants, error) {
+	grantList := NewObjectList(ReferenceGrantGVK)
+	err := virtualClient.List(ctx, grantList)
+	if err != nil {
+		return nil, fmt.Errorf("list reference grants: %w", err)
+	}
+
+	return grantList.Items, nil
+}
+
+// mapReferenceGrants enqueues all objects of the given kind in the namespaces a reference grant allows references from
+func mapReferenceGrants(virtualClient client.Client, gvk schema.GroupVersionKind) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		grant, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil
+		}
+
+		requests := []reconcile.Request{}
+		for _, from := range nestedMaps(grant.Object, "spec", "from") {
+			if stringField(from, "group") != gvk.Group || stringField(from, "kind") != gvk.Kind {
+				continue
+			}
+
+			objList := NewObjectList(gvk)
+			err := virtualClient.List(ctx, objList, client.InNamespace(stringField(from, "namespace")))
+			if err != nil {
+				klog.FromContext(ctx).Error(err, "list objects for reference grant", "kind", gvk.Kind)
+				continue
+			}
+
+			for _, item := range objList.Items {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: item.GetNamespace(),
+						Name:      item.GetName(),
+					},
+				})
+			}
+		}
+
+		return requests
+	}
+}
@@ -0,0 +1,74 @@
+package gatewayapi
+
+import (
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewGatewayClassSyncer imports the gateway classes of the host cluster into the virtual cluster
+func NewGatewayClassSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	err := ensureKinds(ctx, GatewayClassGVK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gatewayClassSyncer{
+		Translator: translator.NewMirrorPhysicalTranslator("gatewayclass", NewObject(GatewayClassGVK)),
+	}, nil
+}
+
+type gatewayClassSyncer struct {
+	translator.Translator
+}
+
+var _ syncertypes.ToVirtualSyncer = &gatewayClassSyncer{}
+var _ syncertypes.Syncer = &gatewayClassSyncer{}
+
+func (s *gatewayClassSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pObj client.Object) (ctrl.Result, error) {
+	vObj := s.TranslateMetadata(ctx.Context, pObj).(*unstructured.Unstructured)
+	unstructured.RemoveNestedField(vObj.Object, "status")
+	ctx.Log.Infof("create gateway class %s, because it does not exist in virtual cluster", vObj.GetName())
+	return ctrl.Result{}, ctx.VirtualClient.Create(ctx.Context, vObj)
+}
+
+func (s *gatewayClassSyncer) Sync(ctx *synccontext.SyncContext, pObj, vObj client.Object) (ctrl.Result, error) {
+	pGatewayClass := pObj.(*unstructured.Unstructured)
+	vGatewayClass := vObj.(*unstructured.Unstructured)
+
+	pStatus, found, _ := unstructured.NestedMap(pGatewayClass.Object, "status")
+	if found && !equality.Semantic.DeepEqual(vGatewayClass.Object["status"], pStatus) {
+		newGatewayClass := vGatewayClass.DeepCopy()
+		newGatewayClass.Object["status"] = pStatus
+		ctx.Log.Infof("update gateway class %s, because status is out of sync", vGatewayClass.GetName())
+		return ctrl.Result{}, ctx.VirtualClient.Status().Update(ctx.Context, newGatewayClass)
+	}
+
+	var updated *unstructured.Unstructured
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vGatewayClass, pGatewayClass)
+	if changed {
+		updated = translator.NewIfNil(updated, vGatewayClass)
+		updated.SetAnnotations(updatedAnnotations)
+		updated.SetLabels(updatedLabels)
+	}
+	if !equality.Semantic.DeepEqual(vGatewayClass.Object["spec"], pGatewayClass.Object["spec"]) {
+		updated = translator.NewIfNil(updated, vGatewayClass)
+		updated.Object["spec"] = pGatewayClass.Object["spec"]
+	}
+	if updated == nil {
+		return ctrl.Result{}, nil
+	}
+
+	ctx.Log.Infof("update gateway class %s, because it is out of sync", vGatewayClass.GetName())
+	translator.PrintChanges(vGatewayClass, updated, ctx.Log)
+	return ctrl.Result{}, ctx.VirtualClient.Update(ctx.Context, updated)
+}
+
+func (s *gatewayClassSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
+	ctx.Log.Infof("delete virtual gateway class %s, because physical object is missing", vObj.GetName())
+	return ctrl.Result{}, ctx.VirtualClient.Delete(ctx.Context, vObj)
+}
@@ -0,0 +1,115 @@
+package gatewayapi
+
+import (
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewGatewaySyncer creates a syncer that copies the Gateways of the virtual cluster to the host cluster and their
+// status back. Listener certificates are translated to the synced host secrets.
+func NewGatewaySyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	err := ensureKinds(ctx, ReferenceGrantGVK, GatewayGVK)
+	if err != nil {
+		return nil, err
+	}
+
+	return createGatewaySyncer(ctx), nil
+}
+
+func createGatewaySyncer(ctx *synccontext.RegisterContext) syncertypes.Object {
+	return &gatewaySyncer{
+		NamespacedTranslator: translator.NewNamespacedTranslator(ctx, "gateway", NewObject(GatewayGVK)),
+
+		virtualClient: ctx.VirtualManager.GetClient(),
+	}
+}
+
+type gatewaySyncer struct {
+	translator.NamespacedTranslator
+
+	virtualClient client.Client
+}
+
+var _ syncertypes.Syncer = &gatewaySyncer{}
+
+var _ syncertypes.ControllerModifier = &gatewaySyncer{}
+
+func (s *gatewaySyncer) ModifyController(_ *synccontext.RegisterContext, builder *builder.Builder) (*builder.Builder, error) {
+	return builder.Watches(NewObject(ReferenceGrantGVK), handler.EnqueueRequestsFromMapFunc(mapReferenceGrants(s.virtualClient, GatewayGVK))), nil
+}
+
+func (s *gatewaySyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
+	vGateway := vObj.(*unstructured.Unstructured)
+	spec, err := s.translateSpec(ctx, vGateway)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	pGateway := s.TranslateMetadata(ctx.Context, vGateway).(*unstructured.Unstructured)
+	unstructured.RemoveNestedField(pGateway.Object, "status")
+	pGateway.Object["spec"] = spec
+	return s.SyncToHostCreate(ctx, vObj, pGateway)
+}
+
+func (s *gatewaySyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
+	vGateway := vObj.(*unstructured.Unstructured)
+	pGateway := pObj.(*unstructured.Unstructured)
+
+	pStatus, found, _ := unstructured.NestedMap(pGateway.Object, "status")
+	if found && !equality.Semantic.DeepEqual(vGateway.Object["status"], pStatus) {
+		newGateway := vGateway.DeepCopy()
+		newGateway.Object["status"] = pStatus
+		ctx.Log.Infof("update virtual gateway %s/%s, because status is out of sync", vGateway.GetNamespace(), vGateway.GetName())
+		translator.PrintChanges(vGateway, newGateway, ctx.Log)
+		err := ctx.VirtualClient.Status().Update(ctx.Context, newGateway)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// we will requeue anyways
+		return ctrl.Result{}, nil
+	}
+
+	var updated *unstructured.Unstructured
+	spec, err := s.translateSpec(ctx, vGateway)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !equality.Semantic.DeepEqual(spec, pGateway.Object["spec"]) {
+		updated = translator.NewIfNil(updated, pGateway)
+		updated.Object["spec"] = spec
+	}
+
+	changed, translatedAnnotations, translatedLabels := s.TranslateMetadataUpdate(ctx.Context, vGateway, pGateway)
+	if changed {
+		updated = translator.NewIfNil(updated, pGateway)
+		updated.SetAnnotations(translatedAnnotations)
+		updated.SetLabels(translatedLabels)
+	}
+	if updated != nil {
+		translator.PrintChanges(pGateway, updated, ctx.Log)
+	}
+
+	return s.SyncToHostUpdate(ctx, vObj, updated)
+}
+
+func (s *gatewaySyncer) translateSpec(ctx *synccontext.SyncContext, vGateway *unstructured.Unstructured) (map[string]interface{}, error) {
+	grants, err := listReferenceGrants(ctx.Context, ctx.VirtualClient)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, denied := translateGatewaySpec(vGateway, grants)
+	for _, ref := range denied {
+		s.EventRecorder().Eventf(vGateway, "Warning", "RefNotPermitted", "Certificate %s is not permitted, only secrets allowed by a ReferenceGrant can be referenced", ref.String())
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,61 @@
+package gatewayapi
+
+import (
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewReferenceGrantSyncer syncs reference grants to the host cluster. This is only needed in multi namespace mode,
+// where virtual namespaces map to different host namespaces and the host cluster enforces the grants as well.
+func NewReferenceGrantSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	err := ensureKinds(ctx, ReferenceGrantGVK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &referenceGrantSyncer{
+		NamespacedTranslator: translator.NewNamespacedTranslator(ctx, "referencegrant", NewObject(ReferenceGrantGVK)),
+	}, nil
+}
+
+type referenceGrantSyncer struct {
+	translator.NamespacedTranslator
+}
+
+var _ syncertypes.Syncer = &referenceGrantSyncer{}
+
+func (s *referenceGrantSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
+	vGrant := vObj.(*unstructured.Unstructured)
+	pGrant := s.TranslateMetadata(ctx.Context, vGrant).(*unstructured.Unstructured)
+	pGrant.Object["spec"] = translateReferenceGrantSpec(vGrant)
+	return s.SyncToHostCreate(ctx, vObj, pGrant)
+}
+
+func (s *referenceGrantSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
+	vGrant := vObj.(*unstructured.Unstructured)
+	pGrant := pObj.(*unstructured.Unstructured)
+
+	var updated *unstructured.Unstructured
+	spec := translateReferenceGrantSpec(vGrant)
+	if !equality.Semantic.DeepEqual(spec, pGrant.Object["spec"]) {
+		updated = translator.NewIfNil(updated, pGrant)
+		updated.Object["spec"] = spec
+	}
+
+	changed, translatedAnnotations, translatedLabels := s.TranslateMetadataUpdate(ctx.Context, vGrant, pGrant)
+	if changed {
+		updated = translator.NewIfNil(updated, pGrant)
+		updated.SetAnnotations(translatedAnnotations)
+		updated.SetLabels(translatedLabels)
+	}
+	if updated != nil {
+		translator.PrintChanges(pGrant, updated, ctx.Log)
+	}
+
+	return s.SyncToHostUpdate(ctx, vObj, updated)
+}
@@ -0,0 +1,162 @@
+package gatewayapi
+
+import (
+	"strings"
+
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewHTTPRouteSyncer creates a syncer that copies the HTTPRoutes of the virtual cluster to the host cluster and
+// their status back. Parent and backend references are translated to the synced host objects.
+func NewHTTPRouteSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return newRouteSyncer(ctx, HTTPRouteGVK, false)
+}
+
+// NewGRPCRouteSyncer creates a GRPCRoute syncer or returns nil if GRPCRoutes are not installed in the host cluster
+func NewGRPCRouteSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return newRouteSyncer(ctx, GRPCRouteGVK, true)
+}
+
+// NewTCPRouteSyncer creates a TCPRoute syncer or returns nil if TCPRoutes are not installed in the host cluster
+func NewTCPRouteSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+	return newRouteSyncer(ctx, TCPRouteGVK, true)
+}
+
+func newRouteSyncer(ctx *synccontext.RegisterContext, gvk schema.GroupVersionKind, optional bool) (syncertypes.Object, error) {
+	if optional {
+		installed, err := kindInstalled(ctx, gvk)
+		if err != nil {
+			return nil, err
+		} else if !installed {
+			klog.Infof("Skip %s syncer, because %s is not installed in the host cluster", strings.ToLower(gvk.Kind), gvk.String())
+			return nil, nil
+		}
+	}
+
+	err := ensureKinds(ctx, ReferenceGrantGVK, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	return createRouteSyncer(ctx, gvk), nil
+}
+
+func createRouteSyncer(ctx *synccontext.RegisterContext, gvk schema.GroupVersionKind) syncertypes.Object {
+	return &routeSyncer{
+		NamespacedTranslator: translator.NewNamespacedTranslator(ctx, strings.ToLower(gvk.Kind), NewObject(gvk)),
+
+		gvk:           gvk,
+		syncGateways:  ctx.Config.Sync.ToHost.GatewayAPI.Gateways.Enabled,
+		virtualClient: ctx.VirtualManager.GetClient(),
+	}
+}
+
+type routeSyncer struct {
+	translator.NamespacedTranslator
+
+	gvk           schema.GroupVersionKind
+	syncGateways  bool
+	virtualClient client.Client
+}
+
+var _ syncertypes.Syncer = &routeSyncer{}
+
+var _ syncertypes.ControllerModifier = &routeSyncer{}
+
+func (s *routeSyncer) ModifyController(_ *synccontext.RegisterContext, builder *builder.Builder) (*builder.Builder, error) {
+	return builder.Watches(NewObject(ReferenceGrantGVK), handler.EnqueueRequestsFromMapFunc(mapReferenceGrants(s.virtualClient, s.gvk))), nil
+}
+
+func (s *routeSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
+	pRoute, err := s.translate(ctx, vObj.(*unstructured.Unstructured))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return s.SyncToHostCreate(ctx, vObj, pRoute)
+}
+
+func (s *routeSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
+	vRoute := vObj.(*unstructured.Unstructured)
+	pRoute := pObj.(*unstructured.Unstructured)
+
+	updated := translateRouteStatus(pRoute, vRoute, s.syncGateways)
+	if updated != nil {
+		ctx.Log.Infof("update virtual %s %s/%s, because status is out of sync", s.Name(), vRoute.GetNamespace(), vRoute.GetName())
+		translator.PrintChanges(vRoute, updated, ctx.Log)
+		err := ctx.VirtualClient.Status().Update(ctx.Context, updated)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// we will requeue anyways
+		return ctrl.Result{}, nil
+	}
+
+	newRoute, err := s.translateUpdate(ctx, pRoute, vRoute)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if newRoute != nil {
+		translator.PrintChanges(pRoute, newRoute, ctx.Log)
+	}
+
+	return s.SyncToHostUpdate(ctx, vObj, newRoute)
+}
+
+func (s *routeSyncer) translate(ctx *synccontext.SyncContext, vRoute *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	spec, err := s.translateSpec(ctx, vRoute)
+	if err != nil {
+		return nil, err
+	}
+
+	pRoute := s.TranslateMetadata(ctx.Context, vRoute).(*unstructured.Unstructured)
+	unstructured.RemoveNestedField(pRoute.Object, "status")
+	pRoute.Object["spec"] = spec
+	return pRoute, nil
+}
+
+func (s *routeSyncer) translateUpdate(ctx *synccontext.SyncContext, pRoute, vRoute *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	var updated *unstructured.Unstructured
+
+	spec, err := s.translateSpec(ctx, vRoute)
+	if err != nil {
+		return nil, err
+	}
+	if !equality.Semantic.DeepEqual(spec, pRoute.Object["spec"]) {
+		updated = translator.NewIfNil(updated, pRoute)
+		updated.Object["spec"] = spec
+	}
+
+	changed, translatedAnnotations, translatedLabels := s.TranslateMetadataUpdate(ctx.Context, vRoute, pRoute)
+	if changed {
+		updated = translator.NewIfNil(updated, pRoute)
+		updated.SetAnnotations(translatedAnnotations)
+		updated.SetLabels(translatedLabels)
+	}
+
+	return updated, nil
+}
+
+func (s *routeSyncer) translateSpec(ctx *synccontext.SyncContext, vRoute *unstructured.Unstructured) (map[string]interface{}, error) {
+	grants, err := listReferenceGrants(ctx.Context, ctx.VirtualClient)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, denied := translateRouteSpec(vRoute, grants, s.syncGateways)
+	for _, ref := range denied {
+		s.EventRecorder().Eventf(vRoute, "Warning", "RefNotPermitted", "Backend %s is not permitted, only services allowed by a ReferenceGrant can be referenced", ref.String())
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,184 @@
+package gatewayapi
+
+import (
+	"testing"
+
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	generictesting "github.com/loft-sh/vcluster/pkg/controllers/syncer/testing"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newSyncedObject(gvk schema.GroupVersionKind, name, namespace string, spec map[string]interface{}) *unstructured.Unstructured {
+	obj := NewObject(gvk)
+	obj.SetName(translate.Default.PhysicalName(name, namespace))
+	obj.SetNamespace(translate.Default.PhysicalNamespace(namespace))
+	obj.SetAnnotations(map[string]string{
+		translate.NameAnnotation:      name,
+		translate.NamespaceAnnotation: namespace,
+		translate.UIDAnnotation:       "",
+		translate.KindAnnotation:      gvk.String(),
+	})
+	obj.SetLabels(map[string]string{
+		translate.MarkerLabel:    translate.VClusterName,
+		translate.NamespaceLabel: namespace,
+	})
+	obj.Object["spec"] = spec
+	return obj
+}
+
+func TestGatewaySync(t *testing.T) {
+	translate.Default = translate.NewSingleNamespaceTranslator(generictesting.DefaultTestTargetNamespace)
+
+	vGateway := NewObject(GatewayGVK)
+	vGateway.SetName("gateway")
+	vGateway.SetNamespace("test")
+	vGateway.Object["spec"] = map[string]interface{}{
+		"gatewayClassName": "example",
+		"listeners": []interface{}{
+			map[string]interface{}{
+				"name":     "https",
+				"port":     int64(443),
+				"protocol": "HTTPS",
+				"tls": map[string]interface{}{
+					"certificateRefs": []interface{}{
+						map[string]interface{}{"name": "cert"},
+						map[string]interface{}{"name": "cert", "namespace": "other"},
+					},
+				},
+				"allowedRoutes": map[string]interface{}{
+					"namespaces": map[string]interface{}{"from": "All"},
+				},
+			},
+		},
+	}
+	pGateway := newSyncedObject(GatewayGVK, "gateway", "test", map[string]interface{}{
+		"gatewayClassName": "example",
+		"listeners": []interface{}{
+			map[string]interface{}{
+				"name":     "https",
+				"port":     int64(443),
+				"protocol": "HTTPS",
+				"tls": map[string]interface{}{
+					"certificateRefs": []interface{}{
+						map[string]interface{}{"name": translate.Default.PhysicalName("cert", "test")},
+					},
+				},
+				"allowedRoutes": map[string]interface{}{
+					"namespaces": map[string]interface{}{"from": "Same"},
+				},
+			},
+		},
+	})
+
+	generictesting.RunTests(t, []*generictesting.SyncTest{
+		{
+			Name:                 "Create forward",
+			InitialVirtualState:  []runtime.Object{vGateway.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				GatewayGVK: {pGateway.DeepCopy()},
+			},
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, registerContext, func(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+					return createGatewaySyncer(ctx), nil
+				})
+				_, err := syncer.(*gatewaySyncer).SyncToHost(syncCtx, vGateway.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
+
+func TestHTTPRouteSync(t *testing.T) {
+	translate.Default = translate.NewSingleNamespaceTranslator(generictesting.DefaultTestTargetNamespace)
+
+	vRoute := NewObject(HTTPRouteGVK)
+	vRoute.SetName("route")
+	vRoute.SetNamespace("test")
+	vRoute.Object["spec"] = map[string]interface{}{
+		"parentRefs": []interface{}{
+			map[string]interface{}{"name": "gateway", "namespace": "gateway-system"},
+		},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"backendRefs": []interface{}{
+					map[string]interface{}{"name": "svc", "port": int64(80)},
+					map[string]interface{}{"name": "bucket", "group": "example.com", "kind": "Bucket"},
+				},
+			},
+		},
+	}
+	pRoute := newSyncedObject(HTTPRouteGVK, "route", "test", map[string]interface{}{
+		"parentRefs": []interface{}{
+			map[string]interface{}{"name": "gateway", "namespace": "gateway-system"},
+		},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"backendRefs": []interface{}{
+					map[string]interface{}{"name": translate.Default.PhysicalName("svc", "test"), "port": int64(80)},
+				},
+			},
+		},
+	})
+	vChangedRoute := vRoute.DeepCopy()
+	vChangedRoute.Object["spec"].(map[string]interface{})["rules"] = []interface{}{
+		map[string]interface{}{
+			"backendRefs": []interface{}{
+				map[string]interface{}{"name": "other", "port": int64(8080)},
+			},
+		},
+	}
+	pChangedRoute := pRoute.DeepCopy()
+	pChangedRoute.Object["spec"].(map[string]interface{})["rules"] = []interface{}{
+		map[string]interface{}{
+			"backendRefs": []interface{}{
+				map[string]interface{}{"name": translate.Default.PhysicalName("other", "test"), "port": int64(8080)},
+			},
+		},
+	}
+	// the fake client keeps an empty status for updated unstructured objects
+	pChangedRoute.Object["status"] = nil
+
+	generictesting.RunTests(t, []*generictesting.SyncTest{
+		{
+			Name:                 "Create forward",
+			InitialVirtualState:  []runtime.Object{vRoute.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				HTTPRouteGVK: {pRoute.DeepCopy()},
+			},
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, registerContext, func(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+					return createRouteSyncer(ctx, HTTPRouteGVK), nil
+				})
+				_, err := syncer.(*routeSyncer).SyncToHost(syncCtx, vRoute.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Update forward",
+			InitialVirtualState:  []runtime.Object{vChangedRoute.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pRoute.DeepCopy()},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				HTTPRouteGVK: {pChangedRoute.DeepCopy()},
+			},
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, registerContext, func(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
+					return createRouteSyncer(ctx, HTTPRouteGVK), nil
+				})
+				pObj := NewObject(HTTPRouteGVK)
+				err := syncCtx.PhysicalClient.Get(syncCtx.Context, client.ObjectKeyFromObject(pRoute), pObj)
+				assert.NilError(t, err)
+
+				_, err = syncer.(*routeSyncer).Sync(syncCtx, pObj, vChangedRoute.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
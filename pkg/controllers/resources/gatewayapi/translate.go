@@ -0,0 +1,354 @@
+package gatewayapi
+
+import (
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// reference identifies an object that is referenced by a route or gateway
+type reference struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (r reference) String() string {
+	if r.Group == "" {
+		return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+	}
+
+	return fmt.Sprintf("%s.%s %s/%s", r.Kind, r.Group, r.Namespace, r.Name)
+}
+
+// referenceGrants are the reference grants of the virtual cluster. As all virtual namespaces
+// share a single host namespace, the host cluster cannot enforce them and we need to check them
+// ourselves before translating a cross namespace reference.
+type referenceGrants []unstructured.Unstructured
+
+func (g referenceGrants) allows(from, to reference) bool {
+	if from.Namespace == to.Namespace {
+		return true
+	}
+
+	for _, grant := range g {
+		if grant.GetNamespace() != to.Namespace {
+			continue
+		}
+
+		fromAllowed := false
+		for _, grantFrom := range nestedMaps(grant.Object, "spec", "from") {
+			if stringField(grantFrom, "group") == from.Group && stringField(grantFrom, "kind") == from.Kind && stringField(grantFrom, "namespace") == from.Namespace {
+				fromAllowed = true
+				break
+			}
+		}
+		if !fromAllowed {
+			continue
+		}
+
+		for _, grantTo := range nestedMaps(grant.Object, "spec", "to") {
+			if stringField(grantTo, "group") == to.Group && stringField(grantTo, "kind") == to.Kind && (stringField(grantTo, "name") == "" || stringField(grantTo, "name") == to.Name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// translateRouteSpec translates the parent and backend references of a HTTPRoute, GRPCRoute or TCPRoute
+// to the host cluster. Backend references that are not permitted by a reference grant are removed and returned.
+func translateRouteSpec(vRoute *unstructured.Unstructured, grants referenceGrants, syncGateways bool) (map[string]interface{}, []reference) {
+	spec, found, err := unstructured.NestedMap(vRoute.Object, "spec")
+	if err != nil || !found {
+		return nil, nil
+	}
+
+	for _, parentRef := range sliceMaps(spec["parentRefs"]) {
+		translateParentRef(parentRef, vRoute.GetNamespace(), syncGateways)
+	}
+
+	from := reference{
+		Group:     vRoute.GroupVersionKind().Group,
+		Kind:      vRoute.GetKind(),
+		Namespace: vRoute.GetNamespace(),
+	}
+	denied := []reference{}
+	for _, rule := range sliceMaps(spec["rules"]) {
+		if _, ok := rule["filters"]; ok {
+			rule["filters"], denied = translateFilters(rule["filters"], from, grants, denied)
+		}
+		if _, ok := rule["backendRefs"]; !ok {
+			continue
+		}
+
+		backendRefs := []interface{}{}
+		for _, backendRef := range sliceMaps(rule["backendRefs"]) {
+			to, ok := translateBackendRef(backendRef, from, grants)
+			if !ok {
+				denied = append(denied, to)
+				continue
+			}
+			if _, ok := backendRef["filters"]; ok {
+				backendRef["filters"], denied = translateFilters(backendRef["filters"], from, grants, denied)
+			}
+
+			backendRefs = append(backendRefs, backendRef)
+		}
+		rule["backendRefs"] = backendRefs
+	}
+
+	return spec, denied
+}
+
+// translateFilters translates the backends of request mirror filters and removes the ones that are not permitted
+func translateFilters(filters interface{}, from reference, grants referenceGrants, denied []reference) (interface{}, []reference) {
+	translated := []interface{}{}
+	for _, filter := range sliceMaps(filters) {
+		mirror, ok := filter["requestMirror"].(map[string]interface{})
+		if ok {
+			backendRef, ok := mirror["backendRef"].(map[string]interface{})
+			if ok {
+				to, ok := translateBackendRef(backendRef, from, grants)
+				if !ok {
+					denied = append(denied, to)
+					continue
+				}
+			}
+		}
+
+		translated = append(translated, filter)
+	}
+
+	return translated, denied
+}
+
+// translateBackendRef rewrites a service backend reference to the host service. References to other kinds are
+// not permitted, as we cannot know how to translate them and they would otherwise point to host objects.
+func translateBackendRef(backendRef map[string]interface{}, from reference, grants referenceGrants) (reference, bool) {
+	to := reference{
+		Group:     defaultedStringField(backendRef, "group", ""),
+		Kind:      defaultedStringField(backendRef, "kind", "Service"),
+		Namespace: defaultedStringField(backendRef, "namespace", from.Namespace),
+		Name:      stringField(backendRef, "name"),
+	}
+	if to.Group != "" || to.Kind != "Service" {
+		return to, false
+	} else if !grants.allows(from, to) {
+		return to, false
+	}
+
+	translateNamespacedRef(backendRef, to.Namespace)
+	return to, true
+}
+
+// translateParentRef rewrites a parent reference to the host gateway or service. If gateways are not synced,
+// gateway references are kept as they are and point to gateways in the host cluster.
+func translateParentRef(parentRef map[string]interface{}, routeNamespace string, syncGateways bool) {
+	group := defaultedStringField(parentRef, "group", GroupName)
+	kind := defaultedStringField(parentRef, "kind", "Gateway")
+	if group == GroupName && kind == "Gateway" && !syncGateways {
+		return
+	} else if (group != GroupName || kind != "Gateway") && (group != "" || kind != "Service") {
+		return
+	}
+
+	translateNamespacedRef(parentRef, defaultedStringField(parentRef, "namespace", routeNamespace))
+}
+
+func translateNamespacedRef(ref map[string]interface{}, namespace string) {
+	ref["name"] = translate.Default.PhysicalName(stringField(ref, "name"), namespace)
+	if _, ok := ref["namespace"]; ok {
+		ref["namespace"] = translate.Default.PhysicalNamespace(namespace)
+	}
+}
+
+// translateRouteStatus returns the virtual route with the status of the host route or nil if
+// the status is already in sync. Parent references are translated back to the virtual ones.
+func translateRouteStatus(pRoute, vRoute *unstructured.Unstructured, syncGateways bool) *unstructured.Unstructured {
+	pStatus, found, err := unstructured.NestedMap(pRoute.Object, "status")
+	if err != nil || !found {
+		return nil
+	}
+
+	// map the translated parent references back to the virtual ones
+	vParentRefs := map[string]map[string]interface{}{}
+	for _, vParentRef := range nestedMaps(vRoute.Object, "spec", "parentRefs") {
+		pParentRef := runtime.DeepCopyJSON(vParentRef)
+		translateParentRef(pParentRef, vRoute.GetNamespace(), syncGateways)
+		vParentRefs[parentRefKey(pParentRef, pRoute.GetNamespace())] = vParentRef
+	}
+	for _, parent := range sliceMaps(pStatus["parents"]) {
+		pParentRef, ok := parent["parentRef"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if vParentRef, ok := vParentRefs[parentRefKey(pParentRef, pRoute.GetNamespace())]; ok {
+			parent["parentRef"] = runtime.DeepCopyJSON(vParentRef)
+		}
+	}
+
+	vStatus, _, _ := unstructured.NestedMap(vRoute.Object, "status")
+	if equality.Semantic.DeepEqual(vStatus, pStatus) {
+		return nil
+	}
+
+	updated := vRoute.DeepCopy()
+	updated.Object["status"] = pStatus
+	return updated
+}
+
+func parentRefKey(parentRef map[string]interface{}, defaultNamespace string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%v",
+		defaultedStringField(parentRef, "group", GroupName),
+		defaultedStringField(parentRef, "kind", "Gateway"),
+		defaultedStringField(parentRef, "namespace", defaultNamespace),
+		stringField(parentRef, "name"),
+		stringField(parentRef, "sectionName"),
+		parentRef["port"],
+	)
+}
+
+// translateGatewaySpec translates the certificate references of the gateway listeners to the host secrets.
+// Certificate references that are not permitted by a reference grant or are not secrets are removed and returned.
+// Listeners that allow routes from all or selected namespaces are restricted to the same namespace, as these
+// would otherwise admit routes from other namespaces of the host cluster.
+func translateGatewaySpec(vGateway *unstructured.Unstructured, grants referenceGrants) (map[string]interface{}, []reference) {
+	spec, found, err := unstructured.NestedMap(vGateway.Object, "spec")
+	if err != nil || !found {
+		return nil, nil
+	}
+
+	from := reference{
+		Group:     GroupName,
+		Kind:      "Gateway",
+		Namespace: vGateway.GetNamespace(),
+	}
+	denied := []reference{}
+	for _, listener := range sliceMaps(spec["listeners"]) {
+		translateAllowedRoutes(listener)
+
+		tls, ok := listener["tls"].(map[string]interface{})
+		if !ok {
+			continue
+		} else if _, ok := tls["certificateRefs"]; !ok {
+			continue
+		}
+
+		certificateRefs := []interface{}{}
+		for _, certificateRef := range sliceMaps(tls["certificateRefs"]) {
+			to := reference{
+				Group:     defaultedStringField(certificateRef, "group", ""),
+				Kind:      defaultedStringField(certificateRef, "kind", "Secret"),
+				Namespace: defaultedStringField(certificateRef, "namespace", from.Namespace),
+				Name:      stringField(certificateRef, "name"),
+			}
+			if to.Group != "" || to.Kind != "Secret" || !grants.allows(from, to) {
+				denied = append(denied, to)
+				continue
+			}
+
+			translateNamespacedRef(certificateRef, to.Namespace)
+			certificateRefs = append(certificateRefs, certificateRef)
+		}
+		tls["certificateRefs"] = certificateRefs
+	}
+
+	return spec, denied
+}
+
+// translateAllowedRoutes restricts the namespaces a listener accepts routes from to the namespace of the host
+// gateway. The host cluster cannot resolve virtual namespace selectors and would otherwise attach routes
+// from any host namespace to the gateway.
+func translateAllowedRoutes(listener map[string]interface{}) {
+	namespaces, ok, _ := unstructured.NestedMap(listener, "allowedRoutes", "namespaces")
+	if !ok {
+		return
+	}
+
+	from := defaultedStringField(namespaces, "from", "Same")
+	if from == "All" || from == "Selector" {
+		_ = unstructured.SetNestedMap(listener, map[string]interface{}{"from": "Same"}, "allowedRoutes", "namespaces")
+	}
+}
+
+// SecretNamesFromGateway returns the secrets referenced by the listeners of a gateway in the form namespace/name
+func SecretNamesFromGateway(gateway *unstructured.Unstructured) []string {
+	secrets := []string{}
+	for _, listener := range nestedMaps(gateway.Object, "spec", "listeners") {
+		for _, certificateRef := range nestedMaps(listener, "tls", "certificateRefs") {
+			if defaultedStringField(certificateRef, "group", "") != "" || defaultedStringField(certificateRef, "kind", "Secret") != "Secret" {
+				continue
+			}
+
+			secrets = append(secrets, defaultedStringField(certificateRef, "namespace", gateway.GetNamespace())+"/"+stringField(certificateRef, "name"))
+		}
+	}
+
+	return translate.UniqueSlice(secrets)
+}
+
+// translateReferenceGrantSpec translates the namespaces and names of a reference grant to the host cluster
+func translateReferenceGrantSpec(vGrant *unstructured.Unstructured) map[string]interface{} {
+	spec, found, err := unstructured.NestedMap(vGrant.Object, "spec")
+	if err != nil || !found {
+		return nil
+	}
+
+	for _, from := range sliceMaps(spec["from"]) {
+		from["namespace"] = translate.Default.PhysicalNamespace(stringField(from, "namespace"))
+	}
+	for _, to := range sliceMaps(spec["to"]) {
+		if stringField(to, "name") != "" {
+			to["name"] = translate.Default.PhysicalName(stringField(to, "name"), vGrant.GetNamespace())
+		}
+	}
+
+	return spec
+}
+
+func nestedMaps(obj map[string]interface{}, fields ...string) []map[string]interface{} {
+	val, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found {
+		return nil
+	}
+
+	return sliceMaps(val)
+}
+
+func sliceMaps(val interface{}) []map[string]interface{} {
+	items, ok := val.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	maps := []map[string]interface{}{}
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if ok {
+			maps = append(maps, m)
+		}
+	}
+
+	return maps
+}
+
+func stringField(obj map[string]interface{}, field string) string {
+	val, _ := obj[field].(string)
+	return val
+}
+
+func defaultedStringField(obj map[string]interface{}, field, defaultValue string) string {
+	val, ok := obj[field].(string)
+	if !ok {
+		return defaultValue
+	}
+
+	return val
+}
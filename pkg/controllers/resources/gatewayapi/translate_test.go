@@ -0,0 +1,296 @@
+package gatewayapi
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newRoute(namespace string, spec map[string]interface{}) *unstructured.Unstructured {
+	route := NewObject(HTTPRouteGVK)
+	route.SetNamespace(namespace)
+	route.SetName("route")
+	route.Object["spec"] = spec
+	return route
+}
+
+func newReferenceGrant(namespace, fromNamespace, toName string) unstructured.Unstructured {
+	to := map[string]interface{}{
+		"group": "",
+		"kind":  "Service",
+	}
+	if toName != "" {
+		to["name"] = toName
+	}
+
+	grant := NewObject(ReferenceGrantGVK)
+	grant.SetNamespace(namespace)
+	grant.SetName("grant")
+	grant.Object["spec"] = map[string]interface{}{
+		"from": []interface{}{
+			map[string]interface{}{
+				"group":     GroupName,
+				"kind":      "HTTPRoute",
+				"namespace": fromNamespace,
+			},
+		},
+		"to": []interface{}{to},
+	}
+	return *grant
+}
+
+func TestTranslateRouteSpec(t *testing.T) {
+	testCases := []struct {
+		name         string
+		spec         map[string]interface{}
+		grants       referenceGrants
+		syncGateways bool
+
+		expectedSpec   map[string]interface{}
+		expectedDenied []reference
+	}{
+		{
+			name: "translate backend in same namespace",
+			spec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": "svc", "port": int64(80)},
+						},
+					},
+				},
+			},
+			expectedSpec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": translate.Default.PhysicalName("svc", "test"), "port": int64(80)},
+						},
+					},
+				},
+			},
+			expectedDenied: []reference{},
+		},
+		{
+			name: "remove cross namespace backend without reference grant",
+			spec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": "svc", "namespace": "other"},
+							map[string]interface{}{"name": "local"},
+						},
+					},
+				},
+			},
+			grants: referenceGrants{newReferenceGrant("other", "test", "another")},
+			expectedSpec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": translate.Default.PhysicalName("local", "test")},
+						},
+					},
+				},
+			},
+			expectedDenied: []reference{{Kind: "Service", Namespace: "other", Name: "svc"}},
+		},
+		{
+			name: "translate cross namespace backend with reference grant",
+			spec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": "svc", "namespace": "other"},
+						},
+						"filters": []interface{}{
+							map[string]interface{}{
+								"type": "RequestMirror",
+								"requestMirror": map[string]interface{}{
+									"backendRef": map[string]interface{}{"name": "mirror", "namespace": "other"},
+								},
+							},
+						},
+					},
+				},
+			},
+			grants: referenceGrants{newReferenceGrant("other", "test", "")},
+			expectedSpec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": translate.Default.PhysicalName("svc", "other"), "namespace": translate.Default.PhysicalNamespace("other")},
+						},
+						"filters": []interface{}{
+							map[string]interface{}{
+								"type": "RequestMirror",
+								"requestMirror": map[string]interface{}{
+									"backendRef": map[string]interface{}{"name": translate.Default.PhysicalName("mirror", "other"), "namespace": translate.Default.PhysicalNamespace("other")},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedDenied: []reference{},
+		},
+		{
+			name: "remove backend of other kind",
+			spec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": "bucket", "group": "example.com", "kind": "Bucket", "namespace": "kube-system"},
+						},
+					},
+				},
+			},
+			expectedSpec: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{},
+					},
+				},
+			},
+			expectedDenied: []reference{{Group: "example.com", Kind: "Bucket", Namespace: "kube-system", Name: "bucket"}},
+		},
+		{
+			name: "keep host gateway parent",
+			spec: map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": "gateway", "namespace": "gateway-system"},
+				},
+			},
+			expectedSpec: map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": "gateway", "namespace": "gateway-system"},
+				},
+			},
+			expectedDenied: []reference{},
+		},
+		{
+			name: "translate synced gateway parent",
+			spec: map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": "gateway"},
+					map[string]interface{}{"name": "other", "group": "example.com", "kind": "Other"},
+				},
+			},
+			syncGateways: true,
+			expectedSpec: map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": translate.Default.PhysicalName("gateway", "test")},
+					map[string]interface{}{"name": "other", "group": "example.com", "kind": "Other"},
+				},
+			},
+			expectedDenied: []reference{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		spec, denied := translateRouteSpec(newRoute("test", testCase.spec), testCase.grants, testCase.syncGateways)
+		assert.DeepEqual(t, testCase.expectedSpec, spec)
+		assert.DeepEqual(t, testCase.expectedDenied, denied)
+	}
+}
+
+func TestTranslateRouteStatus(t *testing.T) {
+	vRoute := newRoute("test", map[string]interface{}{
+		"parentRefs": []interface{}{
+			map[string]interface{}{"name": "gateway"},
+		},
+	})
+	pRoute := newRoute(translate.Default.PhysicalNamespace("test"), map[string]interface{}{})
+	pRoute.Object["status"] = map[string]interface{}{
+		"parents": []interface{}{
+			map[string]interface{}{
+				"controllerName": "example.com/gateway",
+				"parentRef": map[string]interface{}{
+					"group":     GroupName,
+					"kind":      "Gateway",
+					"name":      translate.Default.PhysicalName("gateway", "test"),
+					"namespace": translate.Default.PhysicalNamespace("test"),
+				},
+			},
+		},
+	}
+
+	updated := translateRouteStatus(pRoute, vRoute, true)
+	assert.Assert(t, updated != nil)
+	assert.DeepEqual(t, updated.Object["status"], map[string]interface{}{
+		"parents": []interface{}{
+			map[string]interface{}{
+				"controllerName": "example.com/gateway",
+				"parentRef":      map[string]interface{}{"name": "gateway"},
+			},
+		},
+	})
+
+	// status is in sync now
+	assert.Assert(t, translateRouteStatus(pRoute, updated, true) == nil)
+}
+
+func TestTranslateGatewaySpec(t *testing.T) {
+	gateway := NewObject(GatewayGVK)
+	gateway.SetNamespace("test")
+	gateway.Object["spec"] = map[string]interface{}{
+		"gatewayClassName": "example",
+		"listeners": []interface{}{
+			map[string]interface{}{
+				"name": "https",
+				"tls": map[string]interface{}{
+					"certificateRefs": []interface{}{
+						map[string]interface{}{"name": "cert"},
+						map[string]interface{}{"name": "cert", "namespace": "other"},
+						map[string]interface{}{"name": "cert", "group": "example.com", "kind": "Certificate"},
+					},
+				},
+				"allowedRoutes": map[string]interface{}{
+					"namespaces": map[string]interface{}{
+						"from": "Selector",
+						"selector": map[string]interface{}{
+							"matchLabels": map[string]interface{}{"team": "a"},
+						},
+					},
+				},
+			},
+			map[string]interface{}{
+				"name": "http",
+				"allowedRoutes": map[string]interface{}{
+					"namespaces": map[string]interface{}{"from": "All"},
+				},
+			},
+		},
+	}
+
+	spec, denied := translateGatewaySpec(gateway, nil)
+	assert.DeepEqual(t, spec, map[string]interface{}{
+		"gatewayClassName": "example",
+		"listeners": []interface{}{
+			map[string]interface{}{
+				"name": "https",
+				"tls": map[string]interface{}{
+					"certificateRefs": []interface{}{
+						map[string]interface{}{"name": translate.Default.PhysicalName("cert", "test")},
+					},
+				},
+				"allowedRoutes": map[string]interface{}{
+					"namespaces": map[string]interface{}{"from": "Same"},
+				},
+			},
+			map[string]interface{}{
+				"name": "http",
+				"allowedRoutes": map[string]interface{}{
+					"namespaces": map[string]interface{}{"from": "Same"},
+				},
+			},
+		},
+	})
+	assert.DeepEqual(t, denied, []reference{
+		{Kind: "Secret", Namespace: "other", Name: "cert"},
+		{Group: "example.com", Kind: "Certificate", Namespace: "test", Name: "cert"},
+	})
+	assert.DeepEqual(t, SecretNamesFromGateway(gateway), []string{"test/cert", "other/cert"})
+}
@@ -3,6 +3,7 @@ package ingressclasses
 import (
 	"fmt"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	"github.com/loft-sh/vcluster/pkg/patcher"
@@ -13,26 +14,57 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func New(*synccontext.RegisterContext) (syncer.Object, error) {
+func New(ctx *synccontext.RegisterContext) (syncer.Object, error) {
+	mappings := ctx.Config.Sync.FromHost.IngressClasses.Mappings
+	if len(mappings.ByName) > 0 {
+		return &ingressClassSyncer{
+			Translator: translator.NewMappedPhysicalTranslator("ingressclass", &networkingv1.IngressClass{}, mappings.ByName),
+
+			mappings: mappings,
+		}, nil
+	}
+
 	return &ingressClassSyncer{
 		Translator: translator.NewMirrorPhysicalTranslator("ingressclass", &networkingv1.IngressClass{}),
+
+		mappings: mappings,
 	}, nil
 }
 
 type ingressClassSyncer struct {
 	translator.Translator
+
+	mappings vclusterconfig.ClassMappings
 }
 
 var _ syncer.ToVirtualSyncer = &ingressClassSyncer{}
 var _ syncer.Syncer = &ingressClassSyncer{}
 
 func (i *ingressClassSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pObj client.Object) (ctrl.Result, error) {
-	vObj := i.createVirtual(ctx.Context, pObj.(*networkingv1.IngressClass))
+	if !i.isMapped(pObj) {
+		return ctrl.Result{}, nil
+	}
+
+	vObj, err := i.createVirtual(ctx, pObj.(*networkingv1.IngressClass))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	ctx.Log.Infof("create ingress class %s, because it does not exist in virtual cluster", vObj.Name)
 	return ctrl.Result{}, ctx.VirtualClient.Create(ctx.Context, vObj)
 }
 
 func (i *ingressClassSyncer) Sync(ctx *synccontext.SyncContext, pObj, vObj client.Object) (_ ctrl.Result, retErr error) {
+	if !i.isMapped(pObj) {
+		// the virtual ingress class could be mapped to another host ingress class
+		if _, ok := i.mappings.ByName[vObj.GetName()]; ok {
+			return ctrl.Result{}, nil
+		}
+
+		ctx.Log.Infof("delete virtual ingress class %s, because it is not mapped to a host ingress class", vObj.GetName())
+		return ctrl.Result{}, ctx.VirtualClient.Delete(ctx.Context, vObj)
+	}
+
 	patch, err := patcher.NewSyncerPatcher(ctx, pObj, vObj)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
@@ -47,8 +79,17 @@ func (i *ingressClassSyncer) Sync(ctx *synccontext.SyncContext, pObj, vObj clien
 	// cast objects
 	pIngressClass, vIngressClass, _, _ := synccontext.Cast[*networkingv1.IngressClass](ctx, pObj, vObj)
 
-	i.updateVirtual(ctx.Context, pIngressClass, vIngressClass)
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, i.updateVirtual(ctx, pIngressClass, vIngressClass)
+}
+
+// isMapped returns true if no ingress class mappings are configured or the host ingress class is mapped
+func (i *ingressClassSyncer) isMapped(pObj client.Object) bool {
+	if len(i.mappings.ByName) == 0 {
+		return true
+	}
+
+	_, ok := i.mappings.VirtualName(pObj.GetName())
+	return ok
 }
 
 func (i *ingressClassSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
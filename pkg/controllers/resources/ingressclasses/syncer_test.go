@@ -3,6 +3,7 @@ package ingressclasses
 import (
 	"testing"
 
+	"github.com/loft-sh/vcluster/pkg/config"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	"gotest.tools/assert"
@@ -82,7 +83,90 @@ func TestSync(t *testing.T) {
 		},
 	}
 
+	pMappedObj := &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "host-nginx",
+		},
+		Spec: networkingv1.IngressClassSpec{
+			Controller: "nginx-controller",
+		},
+	}
+	vMappedObj := &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx",
+			Annotations: map[string]string{
+				networkingv1.AnnotationIsDefaultIngressClass: "true",
+			},
+		},
+		Spec: networkingv1.IngressClassSpec{
+			Controller: "nginx-controller",
+		},
+	}
+	pUnmappedObj := &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "host-other",
+		},
+		Spec: networkingv1.IngressClassSpec{
+			Controller: "other-controller",
+		},
+	}
+	adjustMappings := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.FromHost.IngressClasses.Mappings.ByName = map[string]string{"nginx": "host-nginx"}
+		vConfig.Sync.FromHost.IngressClasses.Mappings.Default = "host-nginx"
+	}
+
 	generictesting.RunTests(t, []*generictesting.SyncTest{
+		{
+			Name:                 "Sync Up mapped",
+			InitialVirtualState:  []runtime.Object{},
+			InitialPhysicalState: []runtime.Object{pMappedObj},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("IngressClass"): {vMappedObj},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("IngressClass"): {pMappedObj},
+			},
+			AdjustConfig: adjustMappings,
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*ingressClassSyncer).SyncToVirtual(syncCtx, pMappedObj)
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Sync Up unmapped",
+			InitialVirtualState:  []runtime.Object{},
+			InitialPhysicalState: []runtime.Object{pUnmappedObj},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("IngressClass"): {},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("IngressClass"): {pUnmappedObj},
+			},
+			AdjustConfig: adjustMappings,
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*ingressClassSyncer).SyncToVirtual(syncCtx, pUnmappedObj)
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Sync unmapped",
+			InitialVirtualState:  []runtime.Object{pUnmappedObj.DeepCopy()},
+			InitialPhysicalState: []runtime.Object{pUnmappedObj},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("IngressClass"): {},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("IngressClass"): {pUnmappedObj},
+			},
+			AdjustConfig: adjustMappings,
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*ingressClassSyncer).Sync(syncCtx, pUnmappedObj, pUnmappedObj.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
 		{
 			Name:                 "Sync Up",
 			InitialVirtualState:  []runtime.Object{},
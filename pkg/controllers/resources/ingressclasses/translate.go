@@ -1,19 +1,22 @@
 package ingressclasses
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (i *ingressClassSyncer) createVirtual(ctx context.Context, pIngressClass *networkingv1.IngressClass) *networkingv1.IngressClass {
-	return i.TranslateMetadata(ctx, pIngressClass).(*networkingv1.IngressClass)
+func (i *ingressClassSyncer) createVirtual(ctx *synccontext.SyncContext, pIngressClass *networkingv1.IngressClass) (*networkingv1.IngressClass, error) {
+	vIngressClass := i.TranslateMetadata(ctx.Context, pIngressClass).(*networkingv1.IngressClass)
+	vIngressClass.Annotations = translator.TranslateDefaultClassAnnotation(i.mappings, pIngressClass.Name, networkingv1.AnnotationIsDefaultIngressClass, vIngressClass.Annotations)
+	return vIngressClass, translator.ApplyFromHostPatches(ctx, pIngressClass, vIngressClass)
 }
 
-func (i *ingressClassSyncer) updateVirtual(ctx context.Context, pObj, vObj *networkingv1.IngressClass) {
-	changed, updatedAnnotations, updatedLabels := i.TranslateMetadataUpdate(ctx, vObj, pObj)
-	if changed {
+func (i *ingressClassSyncer) updateVirtual(ctx *synccontext.SyncContext, pObj, vObj *networkingv1.IngressClass) error {
+	_, updatedAnnotations, updatedLabels := i.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
+	updatedAnnotations = translator.TranslateDefaultClassAnnotation(i.mappings, pObj.Name, networkingv1.AnnotationIsDefaultIngressClass, updatedAnnotations)
+	if !equality.Semantic.DeepEqual(updatedAnnotations, vObj.Annotations) || !equality.Semantic.DeepEqual(updatedLabels, vObj.Labels) {
 		vObj.Labels = updatedLabels
 		vObj.Annotations = updatedAnnotations
 	}
@@ -25,4 +28,6 @@ func (i *ingressClassSyncer) updateVirtual(ctx context.Context, pObj, vObj *netw
 	if !equality.Semantic.DeepEqual(vObj.Spec.Parameters, pObj.Spec.Parameters) {
 		vObj.Spec.Parameters = pObj.Spec.Parameters
 	}
+
+	return translator.ApplyFromHostPatches(ctx, pObj, vObj)
 }
@@ -3,6 +3,7 @@ package ingresses
 import (
 	"strings"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	syncertypes "github.com/loft-sh/vcluster/pkg/types"
@@ -16,17 +17,30 @@ import (
 func NewSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
 	return &ingressSyncer{
 		NamespacedTranslator: translator.NewNamespacedTranslator(ctx, "ingress", &networkingv1.Ingress{}),
+
+		ingressClassMappings: ctx.Config.Sync.FromHost.IngressClasses.Mappings,
 	}, nil
 }
 
 type ingressSyncer struct {
 	translator.NamespacedTranslator
+
+	ingressClassMappings vclusterconfig.ClassMappings
 }
 
 var _ syncertypes.Syncer = &ingressSyncer{}
 
 func (s *ingressSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
-	return s.SyncToHostCreate(ctx, vObj, s.translate(ctx.Context, vObj.(*networkingv1.Ingress)))
+	if !s.isIngressClassAllowed(ctx, vObj.(*networkingv1.Ingress)) {
+		return ctrl.Result{}, nil
+	}
+
+	pObj, err := s.translate(ctx, vObj.(*networkingv1.Ingress))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return s.SyncToHostCreate(ctx, vObj, pObj)
 }
 
 func (s *ingressSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
@@ -60,14 +74,35 @@ func (s *ingressSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, v
 		return ctrl.Result{}, nil
 	}
 
-	newIngress := s.translateUpdate(ctx.Context, pIngress, vIngress)
-	if newIngress != nil {
+	if !s.isIngressClassAllowed(ctx, vIngress) {
+		return ctrl.Result{}, nil
+	}
+
+	newIngress, err := s.translateUpdate(ctx, pIngress, vIngress)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if newIngress != nil {
 		translator.PrintChanges(pObj, newIngress, ctx.Log)
 	}
 
 	return s.SyncToHostUpdate(ctx, vObj, newIngress)
 }
 
+// isIngressClassAllowed checks if the ingress class of the ingress is mapped to a host ingress class and
+// records an event on the virtual ingress if it is not
+func (s *ingressSyncer) isIngressClassAllowed(ctx *synccontext.SyncContext, vIngress *networkingv1.Ingress) bool {
+	ingressClassName := ingressClassNameFromIngress(vIngress)
+	if len(s.ingressClassMappings.ByName) == 0 || ingressClassName == "" {
+		return true
+	} else if _, ok := s.ingressClassMappings.HostName(ingressClassName); ok {
+		return true
+	}
+
+	ctx.Log.Infof("skip syncing ingress %s/%s, because ingress class %s is not mapped to a host ingress class", vIngress.Namespace, vIngress.Name, ingressClassName)
+	s.EventRecorder().Eventf(vIngress, "Warning", "UnknownIngressClass", "Ingress class %s is not available in this virtual cluster", ingressClassName)
+	return false
+}
+
 func SecretNamesFromIngress(ingress *networkingv1.Ingress) []string {
 	secrets := []string{}
 	_, extraSecrets := translateIngressAnnotations(ingress.Annotations, ingress.Namespace)
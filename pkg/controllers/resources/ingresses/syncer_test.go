@@ -3,6 +3,7 @@ package ingresses
 import (
 	"testing"
 
+	"github.com/loft-sh/vcluster/pkg/config"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"gotest.tools/assert"
 	"k8s.io/apimachinery/pkg/types"
@@ -157,7 +158,75 @@ func TestSync(t *testing.T) {
 		Status: changedIngressStatus,
 	}
 
+	mappedIngress := &networkingv1.Ingress{
+		ObjectMeta: vObjectMeta,
+		Spec:       *vBaseSpec.DeepCopy(),
+	}
+	mappedIngress.Spec.IngressClassName = stringPointer("nginx")
+	createdMappedIngress := &networkingv1.Ingress{
+		ObjectMeta: pObjectMeta,
+		Spec:       *pBaseSpec.DeepCopy(),
+	}
+	createdMappedIngress.Spec.IngressClassName = stringPointer("host-nginx")
+	unmappedIngress := &networkingv1.Ingress{
+		ObjectMeta: vObjectMeta,
+		Spec:       *vBaseSpec.DeepCopy(),
+	}
+	unmappedIngress.Spec.IngressClassName = stringPointer("other")
+	adjustIngressClassMappings := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.FromHost.IngressClasses.Mappings.ByName = map[string]string{"nginx": "host-nginx"}
+		vConfig.Sync.FromHost.IngressClasses.Mappings.Default = "host-nginx"
+	}
+
 	generictesting.RunTests(t, []*generictesting.SyncTest{
+		{
+			Name:                "Create forward with mapped ingress class",
+			InitialVirtualState: []runtime.Object{mappedIngress.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {mappedIngress.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {createdMappedIngress.DeepCopy()},
+			},
+			AdjustConfig: adjustIngressClassMappings,
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, registerContext, NewSyncer)
+				_, err := syncer.(*ingressSyncer).SyncToHost(syncCtx, mappedIngress.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Create forward with default ingress class",
+			InitialVirtualState: []runtime.Object{baseIngress.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {baseIngress.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {createdMappedIngress.DeepCopy()},
+			},
+			AdjustConfig: adjustIngressClassMappings,
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, registerContext, NewSyncer)
+				_, err := syncer.(*ingressSyncer).SyncToHost(syncCtx, baseIngress.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Reject unmapped ingress class",
+			InitialVirtualState: []runtime.Object{unmappedIngress.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {unmappedIngress.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				networkingv1.SchemeGroupVersion.WithKind("Ingress"): {},
+			},
+			AdjustConfig: adjustIngressClassMappings,
+			Sync: func(registerContext *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, registerContext, NewSyncer)
+				_, err := syncer.(*ingressSyncer).SyncToHost(syncCtx, unmappedIngress.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
 		{
 			Name:                "Create forward",
 			InitialVirtualState: []runtime.Object{baseIngress.DeepCopy()},
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/ingresses/util"
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -11,11 +12,43 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *ingressSyncer) translate(ctx context.Context, vIngress *networkingv1.Ingress) *networkingv1.Ingress {
-	newIngress := s.TranslateMetadata(ctx, vIngress).(*networkingv1.Ingress)
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+func (s *ingressSyncer) translate(ctx *synccontext.SyncContext, vIngress *networkingv1.Ingress) (*networkingv1.Ingress, error) {
+	newIngress := s.TranslateMetadata(ctx.Context, vIngress).(*networkingv1.Ingress)
 	newIngress.Spec = *translateSpec(vIngress.Namespace, &vIngress.Spec)
 	newIngress.Annotations, _ = translateIngressAnnotations(newIngress.Annotations, vIngress.Namespace)
-	return newIngress
+	s.translateIngressClass(vIngress, newIngress)
+	return newIngress, translator.ApplyToHostPatches(ctx, vIngress, newIngress)
+}
+
+// translateIngressClass sets the host ingress class the ingress class of the virtual ingress is mapped to
+func (s *ingressSyncer) translateIngressClass(vIngress, pIngress *networkingv1.Ingress) {
+	if !s.ingressClassMappings.Enabled() {
+		return
+	}
+
+	hostIngressClassName, ok := s.ingressClassMappings.HostName(ingressClassNameFromIngress(vIngress))
+	if !ok {
+		return
+	}
+
+	if vIngress.Spec.IngressClassName == nil && vIngress.Annotations[ingressClassAnnotation] != "" {
+		if pIngress.Annotations == nil {
+			pIngress.Annotations = map[string]string{}
+		}
+		pIngress.Annotations[ingressClassAnnotation] = hostIngressClassName
+	} else {
+		pIngress.Spec.IngressClassName = &hostIngressClassName
+	}
+}
+
+func ingressClassNameFromIngress(ingress *networkingv1.Ingress) string {
+	if ingress.Spec.IngressClassName != nil {
+		return *ingress.Spec.IngressClassName
+	}
+
+	return ingress.Annotations[ingressClassAnnotation]
 }
 
 func (s *ingressSyncer) TranslateMetadata(ctx context.Context, vObj client.Object) client.Object {
@@ -26,32 +59,46 @@ func (s *ingressSyncer) TranslateMetadataUpdate(ctx context.Context, vObj client
 	return s.NamespacedTranslator.TranslateMetadataUpdate(ctx, util.UpdateAnnotations(vObj), pObj)
 }
 
-func (s *ingressSyncer) translateUpdate(ctx context.Context, pObj, vObj *networkingv1.Ingress) *networkingv1.Ingress {
+func (s *ingressSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *networkingv1.Ingress) (*networkingv1.Ingress, error) {
 	var updated *networkingv1.Ingress
 
-	translatedSpec := *translateSpec(vObj.Namespace, &vObj.Spec)
-	if !equality.Semantic.DeepEqual(translatedSpec, pObj.Spec) {
+	translated := vObj.DeepCopy()
+	translated.Spec = *translateSpec(vObj.Namespace, &vObj.Spec)
+	_, translated.Annotations, translated.Labels = s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
+	translated.Annotations, _ = translateIngressAnnotations(translated.Annotations, vObj.Namespace)
+	s.translateIngressClass(vObj, translated)
+
+	if !equality.Semantic.DeepEqual(translated.Spec, pObj.Spec) {
 		updated = translator.NewIfNil(updated, pObj)
-		updated.Spec = translatedSpec
+		updated.Spec = translated.Spec
 	}
 
-	_, translatedAnnotations, translatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
-	translatedAnnotations, _ = translateIngressAnnotations(translatedAnnotations, vObj.Namespace)
-	if !equality.Semantic.DeepEqual(translatedAnnotations, pObj.GetAnnotations()) || !equality.Semantic.DeepEqual(translatedLabels, pObj.GetLabels()) {
+	if !equality.Semantic.DeepEqual(translated.Annotations, pObj.GetAnnotations()) || !equality.Semantic.DeepEqual(translated.Labels, pObj.GetLabels()) {
 		updated = translator.NewIfNil(updated, pObj)
-		updated.Annotations = translatedAnnotations
-		updated.Labels = translatedLabels
+		updated.Annotations = translated.Annotations
+		updated.Labels = translated.Labels
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
 
 func (s *ingressSyncer) translateUpdateBackwards(pObj, vObj *networkingv1.Ingress) *networkingv1.Ingress {
 	var updated *networkingv1.Ingress
 
 	if vObj.Spec.IngressClassName == nil && pObj.Spec.IngressClassName != nil {
+		ingressClassName := *pObj.Spec.IngressClassName
+		if s.ingressClassMappings.Enabled() {
+			// only set the ingress class if the host ingress class is known within the virtual cluster
+			virtualIngressClassName, ok := s.ingressClassMappings.VirtualName(ingressClassName)
+			if !ok {
+				return nil
+			}
+
+			ingressClassName = virtualIngressClassName
+		}
+
 		updated = translator.NewIfNil(updated, vObj)
-		updated.Spec.IngressClassName = pObj.Spec.IngressClassName
+		updated.Spec.IngressClassName = &ingressClassName
 	}
 
 	return updated
@@ -23,12 +23,19 @@ type networkPolicySyncer struct {
 var _ syncertypes.Syncer = &networkPolicySyncer{}
 
 func (s *networkPolicySyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
-	return s.SyncToHostCreate(ctx, vObj, s.translate(ctx.Context, vObj.(*networkingv1.NetworkPolicy)))
+	pObj, err := s.translate(ctx, vObj.(*networkingv1.NetworkPolicy))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return s.SyncToHostCreate(ctx, vObj, pObj)
 }
 
 func (s *networkPolicySyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
-	newNetworkPolicy := s.translateUpdate(ctx.Context, pObj.(*networkingv1.NetworkPolicy), vObj.(*networkingv1.NetworkPolicy))
-	if newNetworkPolicy != nil {
+	newNetworkPolicy, err := s.translateUpdate(ctx, pObj.(*networkingv1.NetworkPolicy), vObj.(*networkingv1.NetworkPolicy))
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if newNetworkPolicy != nil {
 		translator.PrintChanges(pObj, newNetworkPolicy, ctx.Log)
 	}
 
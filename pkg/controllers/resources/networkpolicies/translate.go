@@ -1,24 +1,23 @@
 package networkpolicies
 
 import (
-	"context"
-
 	podstranslate "github.com/loft-sh/vcluster/pkg/controllers/resources/pods/translate"
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (s *networkPolicySyncer) translate(ctx context.Context, vNetworkPolicy *networkingv1.NetworkPolicy) *networkingv1.NetworkPolicy {
-	newNetworkPolicy := s.TranslateMetadata(ctx, vNetworkPolicy).(*networkingv1.NetworkPolicy)
+func (s *networkPolicySyncer) translate(ctx *synccontext.SyncContext, vNetworkPolicy *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+	newNetworkPolicy := s.TranslateMetadata(ctx.Context, vNetworkPolicy).(*networkingv1.NetworkPolicy)
 	if spec := translateSpec(&vNetworkPolicy.Spec, vNetworkPolicy.GetNamespace()); spec != nil {
 		newNetworkPolicy.Spec = *spec
 	}
-	return newNetworkPolicy
+	return newNetworkPolicy, translator.ApplyToHostPatches(ctx, vNetworkPolicy, newNetworkPolicy)
 }
 
-func (s *networkPolicySyncer) translateUpdate(ctx context.Context, pObj, vObj *networkingv1.NetworkPolicy) *networkingv1.NetworkPolicy {
+func (s *networkPolicySyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
 	var updated *networkingv1.NetworkPolicy
 
 	if translatedSpec := translateSpec(&vObj.Spec, vObj.GetNamespace()); translatedSpec != nil {
@@ -28,14 +27,14 @@ func (s *networkPolicySyncer) translateUpdate(ctx context.Context, pObj, vObj *n
 		}
 	}
 
-	changed, translatedAnnotations, translatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, translatedAnnotations, translatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		updated = translator.NewIfNil(updated, pObj)
 		updated.Labels = translatedLabels
 		updated.Annotations = translatedAnnotations
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
 
 func translateSpec(spec *networkingv1.NetworkPolicySpec, namespace string) *networkingv1.NetworkPolicySpec {
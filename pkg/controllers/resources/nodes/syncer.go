@@ -178,7 +178,7 @@ func enqueueNonVClusterPod(old, new client.Object, q workqueue.RateLimitingInter
 			return
 		}
 	}
-	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: pod.Spec.NodeName}})
+	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: translate.Nodes.VirtualName(pod.Spec.NodeName)}})
 }
 
 // this is split out because it is shared with the fake syncer
@@ -195,7 +195,7 @@ func modifyController(ctx *synccontext.RegisterContext, nodeServiceProvider node
 		return []reconcile.Request{
 			{
 				NamespacedName: types.NamespacedName{
-					Name: pod.Spec.NodeName,
+					Name: translate.Nodes.VirtualName(pod.Spec.NodeName),
 				},
 			},
 		}
@@ -229,7 +229,8 @@ func registerIndices(ctx *synccontext.RegisterContext) error {
 		if !translate.Default.IsManaged(pod, translate.Default.PhysicalName) || pod.Spec.NodeName == "" {
 			return nil
 		}
-		return []string{pod.Spec.NodeName}
+		// index by the virtual node name, so virtual and host pods can be looked up the same way
+		return []string{translate.Nodes.VirtualName(pod.Spec.NodeName)}
 	})
 	if err != nil {
 		return err
@@ -245,11 +246,16 @@ func registerIndices(ctx *synccontext.RegisterContext) error {
 }
 
 func (s *nodeSyncer) VirtualToHost(_ context.Context, req types.NamespacedName, _ client.Object) types.NamespacedName {
-	return req
+	hostName, ok := translate.Nodes.HostName(req.Name)
+	if !ok {
+		return types.NamespacedName{}
+	}
+
+	return types.NamespacedName{Name: hostName}
 }
 
 func (s *nodeSyncer) HostToVirtual(_ context.Context, req types.NamespacedName, _ client.Object) types.NamespacedName {
-	return types.NamespacedName{Name: req.Name}
+	return types.NamespacedName{Name: translate.Nodes.VirtualName(req.Name)}
 }
 
 func (s *nodeSyncer) IsManaged(_ context.Context, _ client.Object) (bool, error) {
@@ -271,15 +277,16 @@ func (s *nodeSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj
 	if err != nil {
 		return ctrl.Result{}, err
 	} else if !shouldSync {
-		ctx.Log.Infof("delete virtual node %s, because there is no virtual pod with that node", pNode.Name)
+		ctx.Log.Infof("delete virtual node %s, because there is no virtual pod with that node", vNode.Name)
 		return ctrl.Result{}, ctx.VirtualClient.Delete(ctx.Context, vObj)
 	}
 
+	pNode = hidePrivateNode(pNode, vNode.Name)
 	updatedVNode, statusChanged, err := s.translateUpdateStatus(ctx, pNode, vNode)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "update node status")
 	} else if statusChanged {
-		ctx.Log.Infof("update virtual node %s, because status has changed", pNode.Name)
+		ctx.Log.Infof("update virtual node %s, because status has changed", vNode.Name)
 		translator.PrintChanges(vNode, updatedVNode, ctx.Log)
 		err := ctx.VirtualClient.Status().Update(ctx.Context, updatedVNode)
 		if err != nil {
@@ -289,9 +296,11 @@ func (s *nodeSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj
 		vNode = updatedVNode
 	}
 
-	updated := s.translateUpdateBackwards(pNode, vNode)
-	if updated != nil {
-		ctx.Log.Infof("update virtual node %s, because spec has changed", pNode.Name)
+	updated, err := s.translateUpdateBackwards(ctx, pNode, vNode)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if updated != nil {
+		ctx.Log.Infof("update virtual node %s, because spec has changed", vNode.Name)
 		translator.PrintChanges(vNode, updated, ctx.Log)
 		err = ctx.VirtualClient.Update(ctx.Context, updated)
 		if err != nil {
@@ -313,14 +322,22 @@ func (s *nodeSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pObj client.Obj
 		return ctrl.Result{}, nil
 	}
 
-	ctx.Log.Infof("create virtual node %s, because there is a virtual pod with that node", pNode.Name)
-	err = ctx.VirtualClient.Create(ctx.Context, &corev1.Node{
+	vName := translate.Nodes.VirtualName(pNode.Name)
+	pNode = hidePrivateNode(pNode, vName)
+	ctx.Log.Infof("create virtual node %s, because there is a virtual pod with that node", vName)
+	vNode := &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        pNode.Name,
+			Name:        vName,
 			Labels:      pNode.Labels,
 			Annotations: pNode.Annotations,
 		},
-	})
+	}
+	err = translator.ApplyFromHostPatches(ctx, pNode, vNode)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = ctx.VirtualClient.Create(ctx.Context, vNode)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -338,13 +355,13 @@ func (s *nodeSyncer) shouldSync(ctx context.Context, pObj *corev1.Node) (bool, e
 
 		matched := s.nodeSelector.Matches(ls)
 		if !matched && !s.enforceNodeSelector {
-			return isNodeNeededByPod(ctx, s.virtualClient, s.physicalClient, pObj.Name)
+			return isNodeNeededByPod(ctx, s.virtualClient, s.physicalClient, translate.Nodes.VirtualName(pObj.Name))
 		}
 
 		return matched, nil
 	}
 
-	return isNodeNeededByPod(ctx, s.virtualClient, s.physicalClient, pObj.Name)
+	return isNodeNeededByPod(ctx, s.virtualClient, s.physicalClient, translate.Nodes.VirtualName(pObj.Name))
 }
 
 func isNodeNeededByPod(ctx context.Context, virtualClient client.Client, physicalClient client.Client, nodeName string) (bool, error) {
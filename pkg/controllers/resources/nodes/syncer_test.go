@@ -3,6 +3,8 @@ package nodes
 import (
 	"testing"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/config"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	syncertypes "github.com/loft-sh/vcluster/pkg/types"
@@ -618,3 +620,67 @@ func TestSync(t *testing.T) {
 		},
 	})
 }
+
+func TestSyncStatusPatches(t *testing.T) {
+	baseName := types.NamespacedName{
+		Name: "mynode",
+	}
+	basePNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: baseName.Name,
+		},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{
+				Architecture: "amd64",
+			},
+		},
+	}
+	baseVNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: baseName.Name,
+		},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{
+				{
+					Address: GetNodeHost(baseName.Name),
+					Type:    corev1.NodeHostName,
+				},
+			},
+			DaemonEndpoints: corev1.NodeDaemonEndpoints{
+				KubeletEndpoint: corev1.DaemonEndpoint{
+					Port: constants.KubeletPort,
+				},
+			},
+			NodeInfo: corev1.NodeSystemInfo{
+				Architecture: "arm64",
+			},
+		},
+	}
+
+	generictesting.RunTests(t, []*generictesting.SyncTest{
+		{
+			Name:                 "Status patches are applied before the diff",
+			InitialPhysicalState: []runtime.Object{basePNode},
+			InitialVirtualState:  []runtime.Object{baseVNode},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("Node"): {baseVNode},
+			},
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Networking.Advanced.ProxyKubelets.ByIP = false
+				vConfig.Sync.FromHost.Nodes.Selector.All = true
+				vConfig.Sync.FromHost.Nodes.Patches = []*vclusterconfig.Patch{
+					{
+						Operation: vclusterconfig.PatchTypeReplace,
+						Path:      "status.nodeInfo.architecture",
+						Value:     "arm64",
+					},
+				}
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := newFakeSyncer(t, ctx)
+				_, err := syncer.Sync(syncCtx, basePNode, baseVNode)
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
@@ -20,7 +20,7 @@ import (
 
 var TaintsAnnotation = "vcluster.loft.sh/original-taints"
 
-func (s *nodeSyncer) translateUpdateBackwards(pNode *corev1.Node, vNode *corev1.Node) *corev1.Node {
+func (s *nodeSyncer) translateUpdateBackwards(ctx *synccontext.SyncContext, pNode *corev1.Node, vNode *corev1.Node) (*corev1.Node, error) {
 	var updated *corev1.Node
 
 	// merge labels & taints
@@ -124,7 +124,7 @@ func (s *nodeSyncer) translateUpdateBackwards(pNode *corev1.Node, vNode *corev1.
 		updated.Labels = labels
 	}
 
-	return updated
+	return translator.ApplyFromHostPatchesUpdate(ctx, pNode, vNode, updated)
 }
 
 // translateUpdateStatus translates the node's status.
@@ -258,8 +258,35 @@ func (s *nodeSyncer) translateUpdateStatus(ctx *synccontext.SyncContext, pNode *
 
 	newNode := vNode.DeepCopy()
 	newNode.Status = *translatedStatus
+	err := translator.ApplyFromHostPatches(ctx, pNode, newNode)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return newNode, !equality.Semantic.DeepEqual(vNode.Status, newNode.Status), nil
+}
 
-	return newNode, !equality.Semantic.DeepEqual(vNode.Status, *translatedStatus), nil
+// hidePrivateNode removes information about the host node that would reveal the host topology to the virtual cluster,
+// such as the node name, provider id, addresses and machine ids. Returns the node unchanged if node privacy is disabled.
+// Selectors on the replaced hostname label are translated back to the host hostname via translate.HostHostname.
+func hidePrivateNode(pNode *corev1.Node, vName string) *corev1.Node {
+	if !translate.Nodes.Private() {
+		return pNode
+	}
+
+	pNode = pNode.DeepCopy()
+	if pNode.Labels != nil && pNode.Labels[corev1.LabelHostname] != "" {
+		pNode.Labels[corev1.LabelHostname] = vName
+	}
+	pNode.Annotations = nil
+	pNode.Spec.ProviderID = ""
+	pNode.Spec.PodCIDR = ""
+	pNode.Spec.PodCIDRs = nil
+	pNode.Status.Addresses = nil
+	pNode.Status.NodeInfo.MachineID = ""
+	pNode.Status.NodeInfo.SystemUUID = ""
+	pNode.Status.NodeInfo.BootID = ""
+	return pNode
 }
 
 func mergeStrings(physical []string, virtual []string, oldPhysical []string) []string {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	"gotest.tools/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -286,7 +287,8 @@ func TestTranslateBackwards(t *testing.T) {
 	s := &nodeSyncer{}
 	for _, testCase := range testCases {
 		fmt.Println(testCase.name)
-		result := s.translateUpdateBackwards(testCase.pNode, testCase.vNode)
+		result, err := s.translateUpdateBackwards(&synccontext.SyncContext{}, testCase.pNode, testCase.vNode)
+		assert.NilError(t, err)
 		if result == nil {
 			result = testCase.vNode
 		}
@@ -304,3 +306,62 @@ func mustMarshal(i interface{}) string {
 
 	return string(out)
 }
+
+func TestHidePrivateNode(t *testing.T) {
+	pNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "ip-10-0-1-23.ec2.internal",
+			Labels: map[string]string{
+				corev1.LabelHostname:     "ip-10-0-1-23",
+				corev1.LabelTopologyZone: "us-east-1a",
+			},
+			Annotations: map[string]string{
+				"csi.volume.kubernetes.io/nodeid": "i-0123456789",
+			},
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: "aws:///us-east-1a/i-0123456789",
+			PodCIDR:    "10.1.0.0/24",
+			PodCIDRs:   []string{"10.1.0.0/24"},
+		},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.1.23"}},
+			NodeInfo: corev1.NodeSystemInfo{
+				MachineID:      "machine",
+				SystemUUID:     "uuid",
+				BootID:         "boot",
+				KubeletVersion: "v1.30.0",
+			},
+		},
+	}
+
+	// privacy disabled
+	assert.Equal(t, hidePrivateNode(pNode, pNode.Name), pNode)
+
+	// privacy enabled
+	defer func(nodes translate.NodeTranslator) {
+		translate.Nodes = nodes
+	}(translate.Nodes)
+	translate.Nodes = translate.NewPrivateNodeTranslator("vcluster/vcluster")
+
+	vName := translate.Nodes.VirtualName(pNode.Name)
+	assert.Assert(t, vName != pNode.Name)
+	assert.Equal(t, vName, translate.Nodes.VirtualName(pNode.Name))
+	assert.Assert(t, translate.NewPrivateNodeTranslator("other/vcluster").VirtualName(pNode.Name) != vName)
+	hostName, ok := translate.Nodes.HostName(vName)
+	assert.Assert(t, ok)
+	assert.Equal(t, hostName, pNode.Name)
+	_, ok = translate.Nodes.HostName("node-unknown")
+	assert.Assert(t, !ok)
+
+	hiddenNode := hidePrivateNode(pNode, vName)
+	assert.DeepEqual(t, hiddenNode.Labels, map[string]string{
+		corev1.LabelHostname:     vName,
+		corev1.LabelTopologyZone: "us-east-1a",
+	})
+	assert.Assert(t, hiddenNode.Annotations == nil)
+	assert.DeepEqual(t, hiddenNode.Spec, corev1.NodeSpec{})
+	assert.Assert(t, hiddenNode.Status.Addresses == nil)
+	assert.DeepEqual(t, hiddenNode.Status.NodeInfo, corev1.NodeSystemInfo{KubeletVersion: "v1.30.0"})
+	assert.Equal(t, pNode.Labels[corev1.LabelHostname], "ip-10-0-1-23")
+}
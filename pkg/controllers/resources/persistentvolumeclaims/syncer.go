@@ -3,6 +3,7 @@ package persistentvolumeclaims
 import (
 	"context"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/persistentvolumes"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
@@ -41,6 +42,7 @@ func New(ctx *synccontext.RegisterContext) (syncer.Object, error) {
 		NamespacedTranslator: translator.NewNamespacedTranslator(ctx, "persistent-volume-claim", &corev1.PersistentVolumeClaim{}, excludedAnnotations...),
 
 		storageClassesEnabled:    storageClassesEnabled,
+		storageClassMappings:     ctx.Config.Sync.FromHost.StorageClasses.Mappings,
 		schedulerEnabled:         ctx.Config.ControlPlane.Advanced.VirtualScheduler.Enabled,
 		useFakePersistentVolumes: !ctx.Config.Sync.ToHost.PersistentVolumes.Enabled,
 	}, nil
@@ -50,6 +52,7 @@ type persistentVolumeClaimSyncer struct {
 	translator.NamespacedTranslator
 
 	storageClassesEnabled    bool
+	storageClassMappings     vclusterconfig.ClassMappings
 	schedulerEnabled         bool
 	useFakePersistentVolumes bool
 }
@@ -76,6 +79,12 @@ func (s *persistentVolumeClaimSyncer) SyncToHost(ctx *synccontext.SyncContext, v
 		return ctrl.Result{}, err
 	}
 
+	if storageClassName, allowed := s.isStorageClassAllowed(vPvc); !allowed {
+		ctx.Log.Infof("skip syncing persistent volume claim %s/%s, because storage class %s is not mapped to a host storage class", vPvc.Namespace, vPvc.Name, storageClassName)
+		s.EventRecorder().Eventf(vPvc, "Warning", "UnknownStorageClass", "Storage class %s is not available in this virtual cluster", storageClassName)
+		return ctrl.Result{}, nil
+	}
+
 	newPvc, err := s.translate(ctx, vPvc)
 	if err != nil {
 		s.EventRecorder().Event(vPvc, "Warning", "SyncError", err.Error())
@@ -156,7 +165,7 @@ func (s *persistentVolumeClaimSyncer) Sync(ctx *synccontext.SyncContext, pObj cl
 	}
 
 	// forward update
-	newPvc, err := s.translateUpdate(ctx.Context, pPvc, vPvc)
+	newPvc, err := s.translateUpdate(ctx, pPvc, vPvc)
 	if err != nil {
 		return ctrl.Result{}, err
 	} else if newPvc != nil {
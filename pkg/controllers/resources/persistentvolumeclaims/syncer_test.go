@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 )
 
 func TestSync(t *testing.T) {
@@ -134,6 +135,25 @@ func TestSync(t *testing.T) {
 		Status:     backwardUpdateStatusPvc.Status,
 	}
 
+	mappedStorageClassPvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: vObjectMeta,
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.To("fast"),
+		},
+	}
+	createdMappedStorageClassPvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: pObjectMeta,
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.To("host-fast"),
+		},
+	}
+	unmappedStorageClassPvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: vObjectMeta,
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.To("slow"),
+		},
+	}
+
 	generictesting.RunTestsWithContext(t, func(vConfig *config.VirtualClusterConfig, pClient *testingutil.FakeIndexClient, vClient *testingutil.FakeIndexClient) *synccontext.RegisterContext {
 		ctx := generictesting.NewFakeRegisterContext(vConfig, pClient, vClient)
 		ctx.Config.Sync.ToHost.StorageClasses.Enabled = false
@@ -311,5 +331,41 @@ func TestSync(t *testing.T) {
 				assert.NilError(t, err)
 			},
 		},
+		{
+			Name:                "Create forward with mapped storage class",
+			InitialVirtualState: []runtime.Object{mappedStorageClassPvc.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {mappedStorageClassPvc.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {createdMappedStorageClassPvc},
+			},
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.FromHost.StorageClasses.Mappings.ByName = map[string]string{"fast": "host-fast"}
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*persistentVolumeClaimSyncer).SyncToHost(syncCtx, mappedStorageClassPvc.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                "Reject unmapped storage class",
+			InitialVirtualState: []runtime.Object{unmappedStorageClassPvc.DeepCopy()},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {unmappedStorageClassPvc.DeepCopy()},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"): {},
+			},
+			AdjustConfig: func(vConfig *config.VirtualClusterConfig) {
+				vConfig.Sync.FromHost.StorageClasses.Mappings.ByName = map[string]string{"fast": "host-fast"}
+			},
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, ctx, New)
+				_, err := syncer.(*persistentVolumeClaimSyncer).SyncToHost(syncCtx, unmappedStorageClassPvc.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
 	})
 }
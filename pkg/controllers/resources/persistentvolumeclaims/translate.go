@@ -1,8 +1,6 @@
 package persistentvolumeclaims
 
 import (
-	"context"
-
 	"github.com/loft-sh/vcluster/pkg/constants"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
@@ -35,17 +33,22 @@ func (s *persistentVolumeClaimSyncer) translate(ctx *synccontext.SyncContext, vP
 		}
 	}
 
-	return newPvc, nil
+	return newPvc, translator.ApplyToHostPatches(ctx, vPvc, newPvc)
 }
 
 func (s *persistentVolumeClaimSyncer) translateSelector(ctx *synccontext.SyncContext, vPvc *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
 	vPvc = vPvc.DeepCopy()
 
-	storageClassName := ""
-	if vPvc.Spec.StorageClassName != nil && *vPvc.Spec.StorageClassName != "" {
-		storageClassName = *vPvc.Spec.StorageClassName
-	} else if vPvc.Annotations != nil && vPvc.Annotations[deprecatedStorageClassAnnotation] != "" {
-		storageClassName = vPvc.Annotations[deprecatedStorageClassAnnotation]
+	storageClassName := storageClassNameFromPvc(vPvc)
+
+	// map storage class to the configured host storage class
+	if s.storageClassMappings.Enabled() && (storageClassName != "" || vPvc.Spec.StorageClassName == nil) {
+		hostStorageClassName, ok := s.storageClassMappings.HostName(storageClassName)
+		if ok {
+			delete(vPvc.Annotations, deprecatedStorageClassAnnotation)
+			vPvc.Spec.StorageClassName = &hostStorageClassName
+			storageClassName = ""
+		}
 	}
 
 	// translate storage class if we manage those in vcluster
@@ -85,7 +88,29 @@ func (s *persistentVolumeClaimSyncer) translateSelector(ctx *synccontext.SyncCon
 	return vPvc, nil
 }
 
-func (s *persistentVolumeClaimSyncer) translateUpdate(ctx context.Context, pObj, vObj *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+// isStorageClassAllowed checks if the storage class of the persistent volume claim is either mapped or, if
+// storage classes are synced to the host, managed by the virtual cluster
+func (s *persistentVolumeClaimSyncer) isStorageClassAllowed(vPvc *corev1.PersistentVolumeClaim) (string, bool) {
+	storageClassName := storageClassNameFromPvc(vPvc)
+	if len(s.storageClassMappings.ByName) == 0 || storageClassName == "" || s.storageClassesEnabled {
+		return storageClassName, true
+	}
+
+	_, ok := s.storageClassMappings.HostName(storageClassName)
+	return storageClassName, ok
+}
+
+func storageClassNameFromPvc(vPvc *corev1.PersistentVolumeClaim) string {
+	if vPvc.Spec.StorageClassName != nil && *vPvc.Spec.StorageClassName != "" {
+		return *vPvc.Spec.StorageClassName
+	} else if vPvc.Annotations != nil && vPvc.Annotations[deprecatedStorageClassAnnotation] != "" {
+		return vPvc.Annotations[deprecatedStorageClassAnnotation]
+	}
+
+	return ""
+}
+
+func (s *persistentVolumeClaimSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
 	var updated *corev1.PersistentVolumeClaim
 
 	// allow storage size to be increased
@@ -97,14 +122,14 @@ func (s *persistentVolumeClaimSyncer) translateUpdate(ctx context.Context, pObj,
 		updated.Spec.Resources.Requests["storage"] = vObj.Spec.Resources.Requests["storage"]
 	}
 
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		updated = translator.NewIfNil(updated, pObj)
 		updated.Annotations = updatedAnnotations
 		updated.Labels = updatedLabels
 	}
 
-	return updated, nil
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
 
 func (s *persistentVolumeClaimSyncer) translateUpdateBackwards(pObj, vObj *corev1.PersistentVolumeClaim) *corev1.PersistentVolumeClaim {
@@ -34,7 +34,8 @@ func NewSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error) {
 	return &persistentVolumeSyncer{
 		Translator: translator.NewClusterTranslator(ctx, "persistentvolume", &corev1.PersistentVolume{}, NewPersistentVolumeTranslator(), HostClusterPersistentVolumeAnnotation),
 
-		virtualClient: ctx.VirtualManager.GetClient(),
+		virtualClient:  ctx.VirtualManager.GetClient(),
+		physicalClient: ctx.PhysicalManager.GetClient(),
 	}, nil
 }
 
@@ -66,7 +67,8 @@ func NewPersistentVolumeTranslator() translate.PhysicalNameTranslator {
 type persistentVolumeSyncer struct {
 	translator.Translator
 
-	virtualClient client.Client
+	virtualClient  client.Client
+	physicalClient client.Client
 }
 
 var _ syncertypes.IndicesRegisterer = &persistentVolumeSyncer{}
@@ -99,9 +101,13 @@ func (s *persistentVolumeSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj c
 		return ctrl.Result{}, ctx.VirtualClient.Delete(ctx.Context, vPv)
 	}
 
-	pPv := s.translate(ctx.Context, vPv)
+	pPv, err := s.translate(ctx, vPv)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	ctx.Log.Infof("create physical persistent volume %s, because there is a virtual persistent volume", pPv.Name)
-	err := ctx.PhysicalClient.Create(ctx.Context, pPv)
+	err = ctx.PhysicalClient.Create(ctx.Context, pPv)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
@@ -197,8 +203,10 @@ func (s *persistentVolumeSyncer) Sync(ctx *synccontext.SyncContext, pObj client.
 			return ctrl.Result{}, err
 		}
 
-		updatedPv := s.translateUpdate(ctx.Context, vPersistentVolume, pPersistentVolume)
-		if updatedPv != nil {
+		updatedPv, err := s.translateUpdate(ctx, vPersistentVolume, pPersistentVolume)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if updatedPv != nil {
 			ctx.Log.Infof("update physical persistent volume %s, because spec or annotations have changed", updatedPv.Name)
 			translator.PrintChanges(pPersistentVolume, updatedPv, ctx.Log)
 			err := ctx.PhysicalClient.Update(ctx.Context, updatedPv)
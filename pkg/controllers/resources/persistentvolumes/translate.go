@@ -3,20 +3,34 @@ package persistentvolumes
 import (
 	"context"
 
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (s *persistentVolumeSyncer) translate(ctx context.Context, vPv *corev1.PersistentVolume) *corev1.PersistentVolume {
+func (s *persistentVolumeSyncer) translate(ctx *synccontext.SyncContext, vPv *corev1.PersistentVolume) (*corev1.PersistentVolume, error) {
 	// translate the persistent volume
-	pPV := s.TranslateMetadata(ctx, vPv).(*corev1.PersistentVolume)
+	pPV := s.TranslateMetadata(ctx.Context, vPv).(*corev1.PersistentVolume)
 	pPV.Spec.ClaimRef = nil
 	pPV.Spec.StorageClassName = translateStorageClass(vPv.Spec.StorageClassName)
+	pPV.Spec.NodeAffinity = s.translateNodeAffinity(ctx.Context, vPv.Spec.NodeAffinity)
 
 	// TODO: translate the storage secrets
-	return pPV
+	return pPV, translator.ApplyToHostPatches(ctx, vPv, pPV)
+}
+
+// translateNodeAffinity translates the hostnames within the node affinity of local volumes, which refer to the
+// virtual node names if node privacy is enabled
+func (s *persistentVolumeSyncer) translateNodeAffinity(ctx context.Context, vNodeAffinity *corev1.VolumeNodeAffinity) *corev1.VolumeNodeAffinity {
+	if vNodeAffinity == nil || vNodeAffinity.Required == nil || !translate.Nodes.Private() {
+		return vNodeAffinity
+	}
+
+	pNodeAffinity := vNodeAffinity.DeepCopy()
+	translate.HostNodeSelector(ctx, s.physicalClient, pNodeAffinity.Required)
+	return pNodeAffinity
 }
 
 func translateStorageClass(vStorageClassName string) string {
@@ -107,7 +121,7 @@ func (s *persistentVolumeSyncer) translateUpdateBackwards(vPv *corev1.Persistent
 	return updated
 }
 
-func (s *persistentVolumeSyncer) translateUpdate(ctx context.Context, vPv *corev1.PersistentVolume, pPv *corev1.PersistentVolume) *corev1.PersistentVolume {
+func (s *persistentVolumeSyncer) translateUpdate(ctx *synccontext.SyncContext, vPv *corev1.PersistentVolume, pPv *corev1.PersistentVolume) (*corev1.PersistentVolume, error) {
 	var updated *corev1.PersistentVolume
 
 	// TODO: translate the storage secrets
@@ -137,9 +151,10 @@ func (s *persistentVolumeSyncer) translateUpdate(ctx context.Context, vPv *corev
 		updated.Spec.StorageClassName = translatedStorageClassName
 	}
 
-	if !equality.Semantic.DeepEqual(pPv.Spec.NodeAffinity, vPv.Spec.NodeAffinity) {
+	translatedNodeAffinity := s.translateNodeAffinity(ctx.Context, vPv.Spec.NodeAffinity)
+	if !equality.Semantic.DeepEqual(pPv.Spec.NodeAffinity, translatedNodeAffinity) {
 		updated = translator.NewIfNil(updated, pPv)
-		updated.Spec.NodeAffinity = vPv.Spec.NodeAffinity
+		updated.Spec.NodeAffinity = translatedNodeAffinity
 	}
 
 	if !equality.Semantic.DeepEqual(pPv.Spec.VolumeMode, vPv.Spec.VolumeMode) {
@@ -153,12 +168,12 @@ func (s *persistentVolumeSyncer) translateUpdate(ctx context.Context, vPv *corev
 	}
 
 	// check labels & annotations
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vPv, pPv)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vPv, pPv)
 	if changed {
 		updated = translator.NewIfNil(updated, pPv)
 		updated.Annotations = updatedAnnotations
 		updated.Labels = updatedLabels
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vPv, pPv, updated)
 }
@@ -20,14 +20,21 @@ type pdbSyncer struct {
 }
 
 func (pdb *pdbSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
-	return pdb.SyncToHostCreate(ctx, vObj, pdb.translate(ctx.Context, vObj.(*policyv1.PodDisruptionBudget)))
+	pObj, err := pdb.translate(ctx, vObj.(*policyv1.PodDisruptionBudget))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return pdb.SyncToHostCreate(ctx, vObj, pObj)
 }
 
 func (pdb *pdbSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
 	vPDB := vObj.(*policyv1.PodDisruptionBudget)
 	pPDB := pObj.(*policyv1.PodDisruptionBudget)
-	newPDB := pdb.translateUpdate(ctx.Context, pPDB, vPDB)
-	if newPDB != nil {
+	newPDB, err := pdb.translateUpdate(ctx, pPDB, vPDB)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if newPDB != nil {
 		translator.PrintChanges(pObj, newPDB, ctx.Log)
 	}
 
@@ -1,23 +1,22 @@
 package poddisruptionbudgets
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (pdb *pdbSyncer) translate(ctx context.Context, vObj *policyv1.PodDisruptionBudget) *policyv1.PodDisruptionBudget {
-	newPDB := pdb.TranslateMetadata(ctx, vObj).(*policyv1.PodDisruptionBudget)
+func (pdb *pdbSyncer) translate(ctx *synccontext.SyncContext, vObj *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
+	newPDB := pdb.TranslateMetadata(ctx.Context, vObj).(*policyv1.PodDisruptionBudget)
 	if newPDB.Spec.Selector != nil {
 		newPDB.Spec.Selector = translate.Default.TranslateLabelSelector(newPDB.Spec.Selector)
 	}
-	return newPDB
+	return newPDB, translator.ApplyToHostPatches(ctx, vObj, newPDB)
 }
 
-func (pdb *pdbSyncer) translateUpdate(ctx context.Context, pObj, vObj *policyv1.PodDisruptionBudget) *policyv1.PodDisruptionBudget {
+func (pdb *pdbSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *policyv1.PodDisruptionBudget) (*policyv1.PodDisruptionBudget, error) {
 	var updated *policyv1.PodDisruptionBudget
 
 	// check max available and min available in spec
@@ -29,7 +28,7 @@ func (pdb *pdbSyncer) translateUpdate(ctx context.Context, pObj, vObj *policyv1.
 	}
 
 	// check annotations
-	changed, updatedAnnotations, updatedLabels := pdb.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, updatedAnnotations, updatedLabels := pdb.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		updated = translator.NewIfNil(updated, pObj)
 		updated.Annotations = updatedAnnotations
@@ -43,5 +42,5 @@ func (pdb *pdbSyncer) translateUpdate(ctx context.Context, pObj, vObj *policyv1.
 		updated.Spec.Selector = vObjLabelSelector
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
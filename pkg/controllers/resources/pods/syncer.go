@@ -15,6 +15,7 @@ import (
 
 	translatepods "github.com/loft-sh/vcluster/pkg/controllers/resources/pods/translate"
 	"github.com/loft-sh/vcluster/pkg/util/toleration"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -198,13 +199,13 @@ func (s *podSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object)
 			}
 		} else {
 			// make sure the node does exist in the virtual cluster
-			err = ctx.VirtualClient.Get(ctx.Context, types.NamespacedName{Name: pPod.Spec.NodeName}, &corev1.Node{})
+			err = ctx.VirtualClient.Get(ctx.Context, types.NamespacedName{Name: vPod.Spec.NodeName}, &corev1.Node{})
 			if err != nil {
 				if !kerrors.IsNotFound(err) {
 					return ctrl.Result{}, err
 				}
 
-				s.EventRecorder().Eventf(vPod, "Warning", "SyncWarning", "Given nodeName %s does not exist in virtual cluster", pPod.Spec.NodeName)
+				s.EventRecorder().Eventf(vPod, "Warning", "SyncWarning", "Given nodeName %s does not exist in virtual cluster", vPod.Spec.NodeName)
 				return ctrl.Result{RequeueAfter: time.Second * 15}, nil
 			}
 		}
@@ -286,6 +287,15 @@ func (s *podSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj
 		return ctrl.Result{}, err
 	}
 
+	// map translated images back to the virtual images
+	strippedPod = translatepods.TranslateStatusImages(strippedPod)
+
+	// hide the host ip if node privacy is enabled
+	strippedPod, err = translateHostIP(ctx, vPod, strippedPod)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// update status physical -> virtual
 	if !equality.Semantic.DeepEqual(vPod.Status, strippedPod.Status) {
 		newPod := vPod.DeepCopy()
@@ -316,6 +326,14 @@ func (s *podSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj
 			envVar, envFrom := s.podTranslator.TranslateContainerEnv(vPod.Spec.EphemeralContainers[i].Env, vPod.Spec.EphemeralContainers[i].EnvFrom, vPod, serviceEnv)
 			vPod.Spec.EphemeralContainers[i].Env = envVar
 			vPod.Spec.EphemeralContainers[i].EnvFrom = envFrom
+			vPod.Spec.EphemeralContainers[i].Image, err = s.podTranslator.TranslateImage(ctx.Context, vPod.Spec.EphemeralContainers[i].Image)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			vPod.Spec.EphemeralContainers[i].SecurityContext, err = s.podTranslator.TranslateContainerSecurityContext(ctx.Context, vPod, vPod.Spec.EphemeralContainers[i].SecurityContext)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
 		}
 
 		// add ephemeralContainers subresource to physical pod
@@ -336,7 +354,7 @@ func (s *podSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj
 	}
 
 	// update the virtual pod if the spec has changed
-	updatedPod, err := s.translateUpdate(ctx.Context, ctx.PhysicalClient, pPod, vPod)
+	updatedPod, err := s.translateUpdate(ctx, ctx.PhysicalClient, pPod, vPod)
 	if err != nil {
 		return ctrl.Result{}, err
 	} else if updatedPod != nil {
@@ -353,10 +371,9 @@ func syncEphemeralContainers(vPod *corev1.Pod, pPod *corev1.Pod) bool {
 	if len(vPod.Spec.EphemeralContainers) != len(pPod.Spec.EphemeralContainers) {
 		return true
 	}
+	// ephemeral containers cannot be changed after they were added and the image of the physical container
+	// might be translated, so we only compare the names here
 	for i := range vPod.Spec.EphemeralContainers {
-		if vPod.Spec.EphemeralContainers[i].Image != pPod.Spec.EphemeralContainers[i].Image {
-			return true
-		}
 		if vPod.Spec.EphemeralContainers[i].Name != pPod.Spec.EphemeralContainers[i].Name {
 			return true
 		}
@@ -365,7 +382,8 @@ func syncEphemeralContainers(vPod *corev1.Pod, pPod *corev1.Pod) bool {
 }
 
 func (s *podSyncer) ensureNode(ctx *synccontext.SyncContext, pObj *corev1.Pod, vObj *corev1.Pod) (bool, error) {
-	if vObj.Spec.NodeName != pObj.Spec.NodeName && vObj.Spec.NodeName != "" {
+	nodeName := translate.Nodes.VirtualName(pObj.Spec.NodeName)
+	if vObj.Spec.NodeName != nodeName && vObj.Spec.NodeName != "" {
 		// node of virtual and physical pod are different, we delete the virtual pod to try to recover from this state
 		ctx.Log.Infof("delete virtual pod %s/%s, because virtual and physical pods have different assigned nodes", vObj.Namespace, vObj.Name)
 		err := ctx.VirtualClient.Delete(ctx.Context, vObj)
@@ -379,18 +397,18 @@ func (s *podSyncer) ensureNode(ctx *synccontext.SyncContext, pObj *corev1.Pod, v
 	// ensure the node is available in the virtual cluster, if not and we sync the pod to the virtual cluster,
 	// it will get deleted automatically by kubernetes so we ensure the node is synced
 	vNode := &corev1.Node{}
-	err := ctx.VirtualClient.Get(ctx.Context, types.NamespacedName{Name: pObj.Spec.NodeName}, vNode)
+	err := ctx.VirtualClient.Get(ctx.Context, types.NamespacedName{Name: nodeName}, vNode)
 	if err != nil {
 		if !kerrors.IsNotFound(err) {
-			ctx.Log.Infof("error retrieving virtual node %s: %v", pObj.Spec.NodeName, err)
+			ctx.Log.Infof("error retrieving virtual node %s: %v", nodeName, err)
 			return false, err
 		}
 
 		return true, nil
 	}
 
-	if vObj.Spec.NodeName != pObj.Spec.NodeName {
-		err = s.assignNodeToPod(ctx, pObj, vObj)
+	if vObj.Spec.NodeName != nodeName {
+		err = s.assignNodeToPod(ctx, nodeName, vObj)
 		if err != nil {
 			return false, err
 		}
@@ -401,8 +419,8 @@ func (s *podSyncer) ensureNode(ctx *synccontext.SyncContext, pObj *corev1.Pod, v
 	return false, nil
 }
 
-func (s *podSyncer) assignNodeToPod(ctx *synccontext.SyncContext, pObj *corev1.Pod, vObj *corev1.Pod) error {
-	ctx.Log.Infof("bind virtual pod %s/%s to node %s, because node name between physical and virtual is different", vObj.Namespace, vObj.Name, pObj.Spec.NodeName)
+func (s *podSyncer) assignNodeToPod(ctx *synccontext.SyncContext, nodeName string, vObj *corev1.Pod) error {
+	ctx.Log.Infof("bind virtual pod %s/%s to node %s, because node name between physical and virtual is different", vObj.Namespace, vObj.Name, nodeName)
 	err := s.virtualClusterClient.CoreV1().Pods(vObj.Namespace).Bind(ctx.Context, &corev1.Binding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      vObj.Name,
@@ -410,7 +428,7 @@ func (s *podSyncer) assignNodeToPod(ctx *synccontext.SyncContext, pObj *corev1.P
 		},
 		Target: corev1.ObjectReference{
 			Kind:       "Node",
-			Name:       pObj.Spec.NodeName,
+			Name:       nodeName,
 			APIVersion: "v1",
 		},
 	}, metav1.CreateOptions{})
@@ -438,6 +456,38 @@ func (s *podSyncer) assignNodeToPod(ctx *synccontext.SyncContext, pObj *corev1.P
 	return err
 }
 
+// translateHostIP replaces the host ip of the pod with the internal ip of the virtual node, which is the fake kubelet
+// ip if enabled. If the virtual node has no internal ip, the host ip is removed.
+func translateHostIP(ctx *synccontext.SyncContext, vPod *corev1.Pod, pPod *corev1.Pod) (*corev1.Pod, error) {
+	if !translate.Nodes.Private() || (pPod.Status.HostIP == "" && len(pPod.Status.HostIPs) == 0) {
+		return pPod, nil
+	}
+
+	hostIP := ""
+	if vPod.Spec.NodeName != "" {
+		vNode := &corev1.Node{}
+		err := ctx.VirtualClient.Get(ctx.Context, types.NamespacedName{Name: vPod.Spec.NodeName}, vNode)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		for _, address := range vNode.Status.Addresses {
+			if address.Type == corev1.NodeInternalIP {
+				hostIP = address.Address
+				break
+			}
+		}
+	}
+
+	pPod = pPod.DeepCopy()
+	pPod.Status.HostIP = hostIP
+	pPod.Status.HostIPs = nil
+	if hostIP != "" {
+		pPod.Status.HostIPs = []corev1.HostIP{{IP: hostIP}}
+	}
+	return pPod, nil
+}
+
 func stripHostRewriteContainer(pPod *corev1.Pod) *corev1.Pod {
 	if pPod.Annotations == nil || pPod.Annotations[translatepods.HostsRewrittenAnnotation] != "true" {
 		return pPod
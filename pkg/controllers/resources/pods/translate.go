@@ -1,12 +1,12 @@
 package pods
 
 import (
-	"context"
 	"errors"
 	"fmt"
 
 	podtranslate "github.com/loft-sh/vcluster/pkg/controllers/resources/pods/translate"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	"github.com/loft-sh/vcluster/pkg/specialservices"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	corev1 "k8s.io/api/core/v1"
@@ -25,7 +25,7 @@ func (s *podSyncer) translate(ctx *synccontext.SyncContext, vPod *corev1.Pod) (*
 		return nil, err
 	}
 
-	return pPod, err
+	return pPod, translator.ApplyToHostPatches(ctx, vPod, pPod)
 }
 
 func (s *podSyncer) getK8sIPDNSIPServiceList(ctx *synccontext.SyncContext, vPod *corev1.Pod) (string, string, []*corev1.Service, error) {
@@ -54,19 +54,24 @@ func (s *podSyncer) getK8sIPDNSIPServiceList(ctx *synccontext.SyncContext, vPod
 	return kubeIP, dnsIP, ptrServiceList, nil
 }
 
-func (s *podSyncer) translateUpdate(ctx context.Context, pClient client.Client, pObj, vObj *corev1.Pod) (*corev1.Pod, error) {
-	secret, err := podtranslate.GetSecretIfExists(ctx, pClient, vObj.Name, vObj.Namespace)
+func (s *podSyncer) translateUpdate(ctx *synccontext.SyncContext, pClient client.Client, pObj, vObj *corev1.Pod) (*corev1.Pod, error) {
+	secret, err := podtranslate.GetSecretIfExists(ctx.Context, pClient, vObj.Name, vObj.Namespace)
 	if err := podtranslate.IgnoreAcceptableErrors(err); err != nil {
 		return nil, err
 	} else if secret != nil {
 		// check if owner is vcluster service, if so, modify to pod as owner
-		err := podtranslate.SetPodAsOwner(ctx, pObj, pClient, secret)
+		err := podtranslate.SetPodAsOwner(ctx.Context, pObj, pClient, secret)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return s.podTranslator.Diff(ctx, vObj, pObj)
+	updated, err := s.podTranslator.Diff(ctx.Context, vObj, pObj)
+	if err != nil {
+		return nil, err
+	}
+
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
 
 func (s *podSyncer) findKubernetesIP(ctx *synccontext.SyncContext) (string, error) {
@@ -3,6 +3,7 @@ package translate
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/loft-sh/vcluster/pkg/util/translate"
@@ -22,7 +23,10 @@ func (t *translator) Diff(ctx context.Context, vPod, pPod *corev1.Pod) (*corev1.
 	}
 
 	var updatedPod *corev1.Pod
-	updatedPodSpec := t.calcSpecDiff(pPod, vPod)
+	updatedPodSpec, err := t.calcSpecDiff(ctx, pPod, vPod)
+	if err != nil {
+		return nil, err
+	}
 	if updatedPodSpec != nil {
 		updatedPod = pPod.DeepCopy()
 		updatedPod.Spec = *updatedPodSpec
@@ -53,6 +57,16 @@ func (t *translator) Diff(ctx context.Context, vPod, pPod *corev1.Pod) (*corev1.
 		delete(updatedAnnotations, OwnerSetKind)
 	}
 
+	// set translated images
+	pSpec := &pPod.Spec
+	if updatedPod != nil {
+		pSpec = &updatedPod.Spec
+	}
+	err = setTranslatedImagesAnnotation(updatedAnnotations, translatedImagesFromSpec(vPod, pSpec))
+	if err != nil {
+		return nil, err
+	}
+
 	if !equality.Semantic.DeepEqual(updatedAnnotations, pPod.Annotations) {
 		if updatedPod == nil {
 			updatedPod = pPod.DeepCopy()
@@ -64,6 +78,10 @@ func (t *translator) Diff(ctx context.Context, vPod, pPod *corev1.Pod) (*corev1.
 	for k, v := range vNamespace.GetLabels() {
 		updatedLabels[translate.ConvertLabelKeyWithPrefix(NamespaceLabelPrefix, k)] = v
 	}
+	err = t.translateWorkloadLabels(ctx, vPod, updatedLabels)
+	if err != nil {
+		return nil, fmt.Errorf("translate workload labels: %w", err)
+	}
 	if !equality.Semantic.DeepEqual(updatedLabels, pPod.Labels) {
 		if updatedPod == nil {
 			updatedPod = pPod.DeepCopy()
@@ -75,7 +93,7 @@ func (t *translator) Diff(ctx context.Context, vPod, pPod *corev1.Pod) (*corev1.
 }
 
 func getExcludedAnnotations(pPod *corev1.Pod) []string {
-	annotations := []string{ClusterAutoScalerAnnotation, OwnerReferences, OwnerSetKind, NamespaceAnnotation, NameAnnotation, UIDAnnotation, ServiceAccountNameAnnotation, HostsRewrittenAnnotation, VClusterLabelsAnnotation}
+	annotations := []string{ClusterAutoScalerAnnotation, OwnerReferences, OwnerSetKind, NamespaceAnnotation, NameAnnotation, UIDAnnotation, ServiceAccountNameAnnotation, HostsRewrittenAnnotation, VClusterLabelsAnnotation, TranslatedImagesAnnotation}
 	if pPod != nil {
 		for _, v := range pPod.Spec.Volumes {
 			if v.Projected != nil {
@@ -107,7 +125,7 @@ func getExcludedAnnotations(pPod *corev1.Pod) []string {
 // - spec.activeDeadlineSeconds
 //
 // TODO: check for ephemereal containers
-func (t *translator) calcSpecDiff(pObj, vObj *corev1.Pod) *corev1.PodSpec {
+func (t *translator) calcSpecDiff(ctx context.Context, pObj, vObj *corev1.Pod) (*corev1.PodSpec, error) {
 	var updatedPodSpec *corev1.PodSpec
 
 	// active deadlines different?
@@ -118,7 +136,10 @@ func (t *translator) calcSpecDiff(pObj, vObj *corev1.Pod) *corev1.PodSpec {
 	}
 
 	// is image different?
-	updatedContainer := calcContainerImageDiff(pObj.Spec.Containers, vObj.Spec.Containers, t.imageTranslator, nil)
+	updatedContainer, err := calcContainerImageDiff(ctx, pObj.Spec.Containers, vObj.Spec.Containers, t.imageTranslator, nil)
+	if err != nil {
+		return nil, err
+	}
 	if len(updatedContainer) != 0 {
 		if updatedPodSpec == nil {
 			updatedPodSpec = pObj.Spec.DeepCopy()
@@ -134,7 +155,10 @@ func (t *translator) calcSpecDiff(pObj, vObj *corev1.Pod) *corev1.PodSpec {
 		}
 	}
 
-	updatedContainer = calcContainerImageDiff(pObj.Spec.InitContainers, vObj.Spec.InitContainers, t.imageTranslator, skipContainers)
+	updatedContainer, err = calcContainerImageDiff(ctx, pObj.Spec.InitContainers, vObj.Spec.InitContainers, t.imageTranslator, skipContainers)
+	if err != nil {
+		return nil, err
+	}
 	if len(updatedContainer) != 0 {
 		if updatedPodSpec == nil {
 			updatedPodSpec = pObj.Spec.DeepCopy()
@@ -150,10 +174,42 @@ func (t *translator) calcSpecDiff(pObj, vObj *corev1.Pod) *corev1.PodSpec {
 		updatedPodSpec.SchedulingGates = vObj.Spec.SchedulingGates
 	}
 
-	return updatedPodSpec
+	return updatedPodSpec, nil
 }
 
-func calcContainerImageDiff(pContainers, vContainers []corev1.Container, translateImages ImageTranslator, skipContainers map[string]bool) []corev1.Container {
+// translatedImagesFromSpec returns the images of the host pod that differ from the images of the virtual pod
+func translatedImagesFromSpec(vPod *corev1.Pod, pSpec *corev1.PodSpec) map[string]string {
+	vImages := map[string]string{}
+	for _, container := range vPod.Spec.Containers {
+		vImages[container.Name] = container.Image
+	}
+	for _, container := range vPod.Spec.InitContainers {
+		vImages[container.Name] = container.Image
+	}
+	for _, container := range vPod.Spec.EphemeralContainers {
+		vImages[container.Name] = container.Image
+	}
+
+	translatedImages := map[string]string{}
+	addImage := func(name, image string) {
+		if vImage, ok := vImages[name]; ok && vImage != image {
+			translatedImages[image] = vImage
+		}
+	}
+	for _, container := range pSpec.Containers {
+		addImage(container.Name, container.Image)
+	}
+	for _, container := range pSpec.InitContainers {
+		addImage(container.Name, container.Image)
+	}
+	for _, container := range pSpec.EphemeralContainers {
+		addImage(container.Name, container.Image)
+	}
+
+	return translatedImages
+}
+
+func calcContainerImageDiff(ctx context.Context, pContainers, vContainers []corev1.Container, translateImages ImageTranslator, skipContainers map[string]bool) ([]corev1.Container, error) {
 	newContainers := []corev1.Container{}
 	changed := false
 	for _, p := range pContainers {
@@ -164,9 +220,19 @@ func calcContainerImageDiff(pContainers, vContainers []corev1.Container, transla
 
 		for _, v := range vContainers {
 			if p.Name == v.Name {
-				if p.Image != translateImages.Translate(v.Image) {
+				translatedImage, err := translateImages.Translate(ctx, v.Image)
+				if err != nil {
+					return nil, fmt.Errorf("translate image %s: %w", v.Image, err)
+				}
+
+				// keep the pinned digest, so containers are not restarted if the digests are unavailable
+				if pImage, _, found := strings.Cut(p.Image, "@"); found && pImage == translatedImage {
+					translatedImage = p.Image
+				}
+
+				if p.Image != translatedImage {
 					newContainer := *p.DeepCopy()
-					newContainer.Image = translateImages.Translate(v.Image)
+					newContainer.Image = translatedImage
 					newContainers = append(newContainers, newContainer)
 					changed = true
 				} else {
@@ -179,9 +245,9 @@ func calcContainerImageDiff(pContainers, vContainers []corev1.Container, transla
 	}
 
 	if !changed {
-		return nil
+		return nil, nil
 	}
-	return newContainers
+	return newContainers, nil
 }
 
 func isInt64Different(i1, i2 *int64) (*int64, bool) {
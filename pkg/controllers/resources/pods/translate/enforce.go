@@ -0,0 +1,277 @@
+package translate
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/strvals"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// podEnforcement holds the parsed settings of sync.toHost.pods.enforce
+type podEnforcement struct {
+	settings   enforceSettings
+	namespaces []namespaceEnforcement
+}
+
+type namespaceEnforcement struct {
+	selector labels.Selector
+	settings enforceSettings
+}
+
+type enforceSettings struct {
+	nodeSelector      map[string]string
+	nodeAffinity      *corev1.NodeSelector
+	runtimeClassName  string
+	priorityClassName string
+	schedulerName     string
+	securityContext   map[string]interface{}
+}
+
+func newPodEnforcement(enforce config.SyncPodsEnforce) (*podEnforcement, error) {
+	settings, err := parseEnforceSettings(enforce.SyncPodsEnforceSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	retEnforcement := &podEnforcement{
+		settings: settings,
+	}
+	for idx, namespace := range enforce.Namespaces {
+		if len(namespace.Selector.LabelSelector) == 0 {
+			return nil, fmt.Errorf("sync.toHost.pods.enforce.namespaces[%d].selector.labelSelector is required", idx)
+		}
+
+		settings, err := parseEnforceSettings(namespace.SyncPodsEnforceSettings)
+		if err != nil {
+			return nil, fmt.Errorf("sync.toHost.pods.enforce.namespaces[%d]: %w", idx, err)
+		}
+
+		retEnforcement.namespaces = append(retEnforcement.namespaces, namespaceEnforcement{
+			selector: labels.SelectorFromSet(namespace.Selector.LabelSelector),
+			settings: settings,
+		})
+	}
+
+	return retEnforcement, nil
+}
+
+func parseEnforceSettings(settings config.SyncPodsEnforceSettings) (enforceSettings, error) {
+	retSettings := enforceSettings{
+		nodeSelector:      settings.NodeSelector,
+		runtimeClassName:  settings.RuntimeClassName,
+		priorityClassName: settings.PriorityClassName,
+		schedulerName:     settings.SchedulerName,
+		securityContext:   settings.SecurityContext,
+	}
+	if len(settings.NodeAffinity) > 0 {
+		retSettings.nodeAffinity = &corev1.NodeSelector{}
+		err := convertMap(settings.NodeAffinity, retSettings.nodeAffinity)
+		if err != nil {
+			return enforceSettings{}, fmt.Errorf("parse node affinity: %w", err)
+		} else if len(retSettings.nodeAffinity.NodeSelectorTerms) == 0 {
+			return enforceSettings{}, fmt.Errorf("node affinity requires at least one node selector term")
+		}
+	}
+	if len(settings.SecurityContext) > 0 {
+		err := convertMap(settings.SecurityContext, &corev1.PodSecurityContext{})
+		if err != nil {
+			return enforceSettings{}, fmt.Errorf("parse security context: %w", err)
+		}
+		err = convertMap(settings.SecurityContext, &corev1.SecurityContext{})
+		if err != nil {
+			return enforceSettings{}, fmt.Errorf("parse container security context: %w", err)
+		}
+	}
+
+	return retSettings, nil
+}
+
+// settingsFor returns the enforced settings for pods within the given virtual namespace
+func (p *podEnforcement) settingsFor(vNamespace *corev1.Namespace) enforceSettings {
+	settings := p.settings
+	for _, namespace := range p.namespaces {
+		if !namespace.selector.Matches(labels.Set(vNamespace.Labels)) {
+			continue
+		}
+
+		settings = settings.override(namespace.settings)
+	}
+
+	return settings
+}
+
+func (e enforceSettings) override(other enforceSettings) enforceSettings {
+	if len(other.nodeSelector) > 0 {
+		nodeSelector := map[string]string{}
+		for k, v := range e.nodeSelector {
+			nodeSelector[k] = v
+		}
+		for k, v := range other.nodeSelector {
+			nodeSelector[k] = v
+		}
+		e.nodeSelector = nodeSelector
+	}
+	if other.nodeAffinity != nil {
+		e.nodeAffinity = other.nodeAffinity
+	}
+	if other.runtimeClassName != "" {
+		e.runtimeClassName = other.runtimeClassName
+	}
+	if other.priorityClassName != "" {
+		e.priorityClassName = other.priorityClassName
+	}
+	if other.schedulerName != "" {
+		e.schedulerName = other.schedulerName
+	}
+	if len(other.securityContext) > 0 {
+		e.securityContext = strvals.MergeMaps(e.securityContext, other.securityContext)
+	}
+
+	return e
+}
+
+// apply merges the enforced settings into the given host pod
+func (e enforceSettings) apply(pPod *corev1.Pod) error {
+	for k, v := range e.nodeSelector {
+		if pPod.Spec.NodeSelector == nil {
+			pPod.Spec.NodeSelector = map[string]string{}
+		}
+		pPod.Spec.NodeSelector[k] = v
+	}
+	if e.nodeAffinity != nil {
+		if pPod.Spec.Affinity == nil {
+			pPod.Spec.Affinity = &corev1.Affinity{}
+		}
+		if pPod.Spec.Affinity.NodeAffinity == nil {
+			pPod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+		}
+		pPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = mergeNodeSelectors(pPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution, e.nodeAffinity)
+	}
+	if e.runtimeClassName != "" {
+		pPod.Spec.RuntimeClassName = &e.runtimeClassName
+	}
+	if e.priorityClassName != "" {
+		// the priority is resolved by the host cluster from the priority class
+		pPod.Spec.PriorityClassName = e.priorityClassName
+		pPod.Spec.Priority = nil
+		pPod.Spec.PreemptionPolicy = nil
+	}
+	if e.schedulerName != "" {
+		pPod.Spec.SchedulerName = e.schedulerName
+	}
+	if len(e.securityContext) > 0 {
+		securityContext := map[string]interface{}{}
+		if pPod.Spec.SecurityContext != nil {
+			err := convertMap(pPod.Spec.SecurityContext, &securityContext)
+			if err != nil {
+				return err
+			}
+		}
+
+		newSecurityContext := &corev1.PodSecurityContext{}
+		err := convertMap(strvals.MergeMaps(securityContext, e.securityContext), newSecurityContext)
+		if err != nil {
+			return err
+		}
+		pPod.Spec.SecurityContext = newSecurityContext
+
+		err = e.applyContainerSecurityContext(pPod)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyContainerSecurityContext merges the enforced fields that exist on the container security context, such as
+// runAsUser or privileged, into every container, as these would otherwise take precedence over the pod security context.
+func (e enforceSettings) applyContainerSecurityContext(pPod *corev1.Pod) error {
+	var err error
+	for i := range pPod.Spec.InitContainers {
+		pPod.Spec.InitContainers[i].SecurityContext, err = e.containerSecurityContext(pPod.Spec.InitContainers[i].SecurityContext)
+		if err != nil {
+			return err
+		}
+	}
+	for i := range pPod.Spec.Containers {
+		pPod.Spec.Containers[i].SecurityContext, err = e.containerSecurityContext(pPod.Spec.Containers[i].SecurityContext)
+		if err != nil {
+			return err
+		}
+	}
+	for i := range pPod.Spec.EphemeralContainers {
+		pPod.Spec.EphemeralContainers[i].SecurityContext, err = e.containerSecurityContext(pPod.Spec.EphemeralContainers[i].SecurityContext)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// containerSecurityContext merges the enforced fields that exist on the container security context into the given
+// container security context. Returns the security context unchanged if none of these fields are enforced.
+func (e enforceSettings) containerSecurityContext(containerSecurityContext *corev1.SecurityContext) (*corev1.SecurityContext, error) {
+	// drop the pod only fields by converting the settings to a container security context and back
+	enforcedFields := &corev1.SecurityContext{}
+	err := convertMap(e.securityContext, enforcedFields)
+	if err != nil {
+		return nil, err
+	}
+	enforced := map[string]interface{}{}
+	err = convertMap(enforcedFields, &enforced)
+	if err != nil {
+		return nil, err
+	} else if len(enforced) == 0 {
+		return containerSecurityContext, nil
+	}
+
+	securityContext := map[string]interface{}{}
+	if containerSecurityContext != nil {
+		err := convertMap(containerSecurityContext, &securityContext)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newSecurityContext := &corev1.SecurityContext{}
+	err = convertMap(strvals.MergeMaps(securityContext, enforced), newSecurityContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSecurityContext, nil
+}
+
+// mergeNodeSelectors combines the node selector terms of the pod with the enforced terms. Terms are ORed, while the
+// requirements within a term are ANDed, so every pod term is combined with every enforced term.
+func mergeNodeSelectors(podSelector, enforcedSelector *corev1.NodeSelector) *corev1.NodeSelector {
+	if podSelector == nil || len(podSelector.NodeSelectorTerms) == 0 {
+		return enforcedSelector.DeepCopy()
+	}
+
+	retSelector := &corev1.NodeSelector{}
+	for _, podTerm := range podSelector.NodeSelectorTerms {
+		for _, enforcedTerm := range enforcedSelector.NodeSelectorTerms {
+			term := podTerm.DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, enforcedTerm.DeepCopy().MatchExpressions...)
+			term.MatchFields = append(term.MatchFields, enforcedTerm.DeepCopy().MatchFields...)
+			retSelector.NodeSelectorTerms = append(retSelector.NodeSelectorTerms, *term)
+		}
+	}
+
+	return retSelector
+}
+
+func convertMap(from interface{}, to interface{}) error {
+	out, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(out, to)
+}
@@ -0,0 +1,206 @@
+package translate
+
+import (
+	"testing"
+
+	"github.com/loft-sh/vcluster/config"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestPodEnforcement(t *testing.T) {
+	enforce := config.SyncPodsEnforce{
+		SyncPodsEnforceSettings: config.SyncPodsEnforceSettings{
+			NodeSelector: map[string]string{"pool": "tenant-a"},
+			NodeAffinity: map[string]interface{}{
+				"nodeSelectorTerms": []interface{}{
+					map[string]interface{}{
+						"matchExpressions": []interface{}{
+							map[string]interface{}{"key": "zone", "operator": "In", "values": []interface{}{"a", "b"}},
+						},
+					},
+				},
+			},
+			SchedulerName: "tenant-scheduler",
+			SecurityContext: map[string]interface{}{
+				"runAsNonRoot": true,
+			},
+		},
+		Namespaces: []config.SyncPodsEnforceNamespace{
+			{
+				Selector: config.Selector{LabelSelector: map[string]string{"tier": "gpu"}},
+				SyncPodsEnforceSettings: config.SyncPodsEnforceSettings{
+					NodeSelector:      map[string]string{"gpu": "true"},
+					RuntimeClassName:  "nvidia",
+					PriorityClassName: "tenant-high",
+				},
+			},
+		},
+	}
+	enforcement, err := newPodEnforcement(enforce)
+	assert.NilError(t, err)
+
+	testCases := []struct {
+		name      string
+		namespace *corev1.Namespace
+		pod       corev1.PodSpec
+
+		expectedPod corev1.PodSpec
+	}{
+		{
+			name:      "enforce global settings",
+			namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			pod: corev1.PodSpec{
+				NodeSelector:    map[string]string{"pool": "other", "disk": "ssd"},
+				SecurityContext: &corev1.PodSecurityContext{RunAsUser: ptr.To(int64(1000))},
+			},
+			expectedPod: corev1.PodSpec{
+				NodeSelector: map[string]string{"pool": "tenant-a", "disk": "ssd"},
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a", "b"}}}},
+							},
+						},
+					},
+				},
+				SchedulerName:   "tenant-scheduler",
+				SecurityContext: &corev1.PodSecurityContext{RunAsUser: ptr.To(int64(1000)), RunAsNonRoot: ptr.To(true)},
+			},
+		},
+		{
+			name:      "combine node affinity terms",
+			namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			pod: corev1.PodSpec{
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}}}},
+								{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64"}}}},
+							},
+						},
+					},
+				},
+			},
+			expectedPod: corev1.PodSpec{
+				NodeSelector: map[string]string{"pool": "tenant-a"},
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: "arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}},
+									{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a", "b"}},
+								}},
+								{MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: "arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64"}},
+									{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a", "b"}},
+								}},
+							},
+						},
+					},
+				},
+				SchedulerName:   "tenant-scheduler",
+				SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: ptr.To(true)},
+			},
+		},
+		{
+			name:      "enforce namespace settings",
+			namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "gpu", Labels: map[string]string{"tier": "gpu"}}},
+			pod: corev1.PodSpec{
+				PriorityClassName: "virtual-priority",
+				Priority:          ptr.To(int32(100)),
+			},
+			expectedPod: corev1.PodSpec{
+				NodeSelector: map[string]string{"pool": "tenant-a", "gpu": "true"},
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a", "b"}}}},
+							},
+						},
+					},
+				},
+				RuntimeClassName:  ptr.To("nvidia"),
+				PriorityClassName: "tenant-high",
+				SchedulerName:     "tenant-scheduler",
+				SecurityContext:   &corev1.PodSecurityContext{RunAsNonRoot: ptr.To(true)},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pPod := &corev1.Pod{Spec: testCase.pod}
+			err := enforcement.settingsFor(testCase.namespace).apply(pPod)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, pPod.Spec, testCase.expectedPod)
+		})
+	}
+}
+
+func TestPodEnforcementContainerSecurityContext(t *testing.T) {
+	enforcement, err := newPodEnforcement(config.SyncPodsEnforce{
+		SyncPodsEnforceSettings: config.SyncPodsEnforceSettings{
+			SecurityContext: map[string]interface{}{
+				"runAsUser":                1000,
+				"runAsNonRoot":             true,
+				"privileged":               false,
+				"allowPrivilegeEscalation": false,
+				"fsGroup":                  2000,
+			},
+		},
+	})
+	assert.NilError(t, err)
+
+	pPod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "init", SecurityContext: &corev1.SecurityContext{RunAsUser: ptr.To(int64(0)), RunAsNonRoot: ptr.To(false)}},
+			},
+			Containers: []corev1.Container{
+				{Name: "privileged", SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(true), ReadOnlyRootFilesystem: ptr.To(true)}},
+				{Name: "default"},
+			},
+			EphemeralContainers: []corev1.EphemeralContainer{
+				{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debug", SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(true)}}},
+			},
+		},
+	}
+	err = enforcement.settingsFor(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}).apply(pPod)
+	assert.NilError(t, err)
+
+	expectedSecurityContext := func(readOnlyRootFilesystem *bool) *corev1.SecurityContext {
+		return &corev1.SecurityContext{
+			RunAsUser:                ptr.To(int64(1000)),
+			RunAsNonRoot:             ptr.To(true),
+			Privileged:               ptr.To(false),
+			AllowPrivilegeEscalation: ptr.To(false),
+			ReadOnlyRootFilesystem:   readOnlyRootFilesystem,
+		}
+	}
+	assert.DeepEqual(t, pPod.Spec.SecurityContext, &corev1.PodSecurityContext{RunAsUser: ptr.To(int64(1000)), RunAsNonRoot: ptr.To(true), FSGroup: ptr.To(int64(2000))})
+	assert.DeepEqual(t, pPod.Spec.InitContainers[0].SecurityContext, expectedSecurityContext(nil))
+	assert.DeepEqual(t, pPod.Spec.Containers[0].SecurityContext, expectedSecurityContext(ptr.To(true)))
+	assert.DeepEqual(t, pPod.Spec.Containers[1].SecurityContext, expectedSecurityContext(nil))
+	assert.DeepEqual(t, pPod.Spec.EphemeralContainers[0].SecurityContext, expectedSecurityContext(nil))
+}
+
+func TestPodEnforcementInvalid(t *testing.T) {
+	_, err := newPodEnforcement(config.SyncPodsEnforce{
+		Namespaces: []config.SyncPodsEnforceNamespace{{}},
+	})
+	assert.ErrorContains(t, err, "selector.labelSelector is required")
+
+	_, err = newPodEnforcement(config.SyncPodsEnforce{
+		SyncPodsEnforceSettings: config.SyncPodsEnforceSettings{
+			NodeAffinity: map[string]interface{}{"nodeSelectorTerms": []interface{}{}},
+		},
+	})
+	assert.ErrorContains(t, err, "at least one node selector term")
+}
@@ -1,24 +1,257 @@
 package translate
 
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/loft-sh/vcluster/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultRegistry  = "docker.io"
+	defaultNamespace = "library"
+)
+
 type ImageTranslator interface {
-	Translate(image string) string
+	Translate(ctx context.Context, image string) (string, error)
 }
 
 type imageTranslator struct {
 	translateImages map[string]string
+	rules           []imageRewriteRule
+
+	digestsConfigMap types.NamespacedName
+	client           client.Client
+	eventRecorder    record.EventRecorder
+
+	// digests holds the parsed digests config map, it is only parsed again if its resource version changes
+	digestsMutex           sync.Mutex
+	digests                map[string]string
+	digestsResourceVersion string
+	digestsError           string
+}
+
+type imageRewriteRule struct {
+	// wildcard rules
+	prefix   string
+	suffix   string
+	wildcard bool
+
+	// regex rules
+	regex *regexp.Regexp
+
+	to string
 }
 
-func NewImageTranslator(translateImages map[string]string) (ImageTranslator, error) {
-	return &imageTranslator{
+func NewImageTranslator(translateImages map[string]string, rewriteImages config.SyncPodsRewriteImages, namespace string, hostClient client.Client, eventRecorder record.EventRecorder) (ImageTranslator, error) {
+	retTranslator := &imageTranslator{
 		translateImages: translateImages,
-	}, nil
+		client:          hostClient,
+		eventRecorder:   eventRecorder,
+	}
+	if rewriteImages.Digests.ConfigMap != "" {
+		retTranslator.digestsConfigMap = types.NamespacedName{Namespace: namespace, Name: rewriteImages.Digests.ConfigMap}
+	}
+
+	for idx, rule := range rewriteImages.Rules {
+		parsedRule, err := parseImageRewriteRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("sync.toHost.pods.rewriteImages.rules[%d]: %w", idx, err)
+		}
+
+		retTranslator.rules = append(retTranslator.rules, parsedRule)
+	}
+
+	return retTranslator, nil
 }
 
-func (i *imageTranslator) Translate(image string) string {
+func parseImageRewriteRule(rule config.ImageRewriteRule) (imageRewriteRule, error) {
+	if rule.From == "" || rule.To == "" {
+		return imageRewriteRule{}, fmt.Errorf("from and to are required")
+	}
+
+	if rule.Regex {
+		regex, err := regexp.Compile("^(?:" + rule.From + ")$")
+		if err != nil {
+			return imageRewriteRule{}, fmt.Errorf("parse regex %s: %w", rule.From, err)
+		}
+
+		return imageRewriteRule{regex: regex, to: rule.To}, nil
+	}
+
+	switch strings.Count(rule.From, "*") {
+	case 0:
+		if strings.Contains(rule.To, "*") {
+			return imageRewriteRule{}, fmt.Errorf("to %s cannot contain a wildcard if from %s does not contain one", rule.To, rule.From)
+		}
+
+		return imageRewriteRule{prefix: NormalizeImage(rule.From), to: rule.To}, nil
+	case 1:
+		prefix, suffix, _ := strings.Cut(rule.From, "*")
+		return imageRewriteRule{prefix: prefix, suffix: suffix, wildcard: true, to: rule.To}, nil
+	default:
+		return imageRewriteRule{}, fmt.Errorf("from %s can only contain a single wildcard", rule.From)
+	}
+}
+
+func (r imageRewriteRule) rewrite(image string) (string, bool) {
+	if r.regex != nil {
+		if !r.regex.MatchString(image) {
+			return "", false
+		}
+
+		return r.regex.ReplaceAllString(image, r.to), true
+	} else if !r.wildcard {
+		return r.to, image == r.prefix
+	}
+
+	if len(image) < len(r.prefix)+len(r.suffix) || !strings.HasPrefix(image, r.prefix) || !strings.HasSuffix(image, r.suffix) {
+		return "", false
+	}
+
+	return strings.ReplaceAll(r.to, "*", image[len(r.prefix):len(image)-len(r.suffix)]), true
+}
+
+func (i *imageTranslator) Translate(ctx context.Context, image string) (string, error) {
 	out, ok := i.translateImages[image]
-	if ok {
-		return out
+	if !ok {
+		out = i.rewrite(image)
+	}
+
+	return i.pinDigest(ctx, out), nil
+}
+
+func (i *imageTranslator) rewrite(image string) string {
+	if len(i.rules) == 0 {
+		return image
+	}
+
+	normalizedImage := NormalizeImage(image)
+	for _, rule := range i.rules {
+		out, ok := rule.rewrite(normalizedImage)
+		if ok {
+			return out
+		}
 	}
 
 	return image
 }
+
+// pinDigest appends the digest from the digests config map to the image. If the config map cannot be read,
+// the image is used without a digest and a warning event is recorded on the config map.
+func (i *imageTranslator) pinDigest(ctx context.Context, image string) string {
+	if i.digestsConfigMap.Name == "" || strings.Contains(image, "@") {
+		return image
+	}
+
+	digests, err := i.loadDigests(ctx)
+	if err != nil {
+		i.recordDigestsWarning("ImageDigestsUnavailable", fmt.Sprintf("Images are not pinned to digests: %v", err))
+		return image
+	}
+
+	digest, ok := digests[NormalizeImage(image)]
+	if !ok {
+		return image
+	}
+
+	return image + "@" + digest
+}
+
+// loadDigests returns the digests by normalized image. Keys of the config map are parsed in sorted order and
+// the first digest found for an image wins, keys that cannot be parsed are skipped.
+func (i *imageTranslator) loadDigests(ctx context.Context) (map[string]string, error) {
+	configMap := &corev1.ConfigMap{}
+	err := i.client.Get(ctx, i.digestsConfigMap, configMap)
+	if err != nil {
+		return nil, fmt.Errorf("get image digests config map %s: %w", i.digestsConfigMap.String(), err)
+	}
+
+	i.digestsMutex.Lock()
+	defer i.digestsMutex.Unlock()
+
+	if i.digests != nil && i.digestsResourceVersion == configMap.ResourceVersion {
+		return i.digests, nil
+	}
+
+	keys := make([]string, 0, len(configMap.Data))
+	for key := range configMap.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	digests := map[string]string{}
+	for _, key := range keys {
+		keyDigests := map[string]string{}
+		err = yaml.Unmarshal([]byte(configMap.Data[key]), &keyDigests)
+		if err != nil {
+			i.recordDigestsWarningLocked("InvalidImageDigests", fmt.Sprintf("Skipping key %s: %v", key, err))
+			continue
+		}
+
+		images := make([]string, 0, len(keyDigests))
+		for image := range keyDigests {
+			images = append(images, image)
+		}
+		sort.Strings(images)
+		for _, image := range images {
+			normalizedImage := NormalizeImage(image)
+			if _, ok := digests[normalizedImage]; !ok {
+				digests[normalizedImage] = keyDigests[image]
+			}
+		}
+	}
+
+	i.digests = digests
+	i.digestsResourceVersion = configMap.ResourceVersion
+	i.digestsError = ""
+	return digests, nil
+}
+
+func (i *imageTranslator) recordDigestsWarning(reason, message string) {
+	i.digestsMutex.Lock()
+	defer i.digestsMutex.Unlock()
+
+	i.recordDigestsWarningLocked(reason, message)
+}
+
+// recordDigestsWarningLocked records a warning on the digests config map, the same warning is only recorded once
+func (i *imageTranslator) recordDigestsWarningLocked(reason, message string) {
+	if i.digestsError == message {
+		return
+	}
+	i.digestsError = message
+
+	klog.Warningf("Image digests config map %s: %s", i.digestsConfigMap.String(), message)
+	if i.eventRecorder != nil {
+		i.eventRecorder.Eventf(&corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: i.digestsConfigMap.Name, Namespace: i.digestsConfigMap.Namespace},
+		}, "Warning", reason, message)
+	}
+}
+
+// NormalizeImage returns the fully qualified image, which means images without registry default to docker.io
+// and official docker hub images to docker.io/library, e.g. nginx:1.25 becomes docker.io/library/nginx:1.25
+func NormalizeImage(image string) string {
+	registry, remainder, found := strings.Cut(image, "/")
+	if !found || (!strings.ContainsAny(registry, ".:") && registry != "localhost") {
+		registry, remainder = defaultRegistry, image
+	}
+	if registry == defaultRegistry && !strings.Contains(remainder, "/") {
+		remainder = defaultNamespace + "/" + remainder
+	}
+
+	return registry + "/" + remainder
+}
@@ -0,0 +1,144 @@
+package translate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/loft-sh/vcluster/config"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestImageTranslator(t *testing.T) {
+	digests := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "digests", Namespace: "vcluster"},
+		Data: map[string]string{
+			"images.yaml":  "mirror.corp/dockerhub/library/nginx:1.25: sha256:1234\n",
+			"invalid.yaml": "- not a map",
+			"later.yaml":   "mirror.corp/dockerhub/library/nginx:1.25: sha256:5678\nregistry.k8s.io/pause:3.10: sha256:abcd\n",
+		},
+	}
+
+	rewriteImages := config.SyncPodsRewriteImages{
+		Rules: []config.ImageRewriteRule{
+			{From: "docker.io/*", To: "mirror.corp/dockerhub/*"},
+			{From: `ghcr\.io/([^/]+)/(.+)`, To: "mirror.corp/ghcr/$1-$2", Regex: true},
+			{From: "quay.io/prometheus/busybox", To: "mirror.corp/busybox:stable"},
+		},
+		Digests: config.ImageDigests{ConfigMap: "digests"},
+	}
+	recorder := record.NewFakeRecorder(10)
+	imageTranslator, err := NewImageTranslator(map[string]string{"nginx:exact": "exact/nginx:1.0"}, rewriteImages, "vcluster", fake.NewClientBuilder().WithObjects(digests).Build(), recorder)
+	assert.NilError(t, err)
+
+	testCases := []struct {
+		image    string
+		expected string
+	}{
+		{image: "nginx", expected: "mirror.corp/dockerhub/library/nginx"},
+		{image: "nginx:1.25", expected: "mirror.corp/dockerhub/library/nginx:1.25@sha256:1234"},
+		{image: "docker.io/library/nginx:1.25", expected: "mirror.corp/dockerhub/library/nginx:1.25@sha256:1234"},
+		{image: "bitnami/redis:7", expected: "mirror.corp/dockerhub/bitnami/redis:7"},
+		{image: "ghcr.io/loft-sh/vcluster:0.20", expected: "mirror.corp/ghcr/loft-sh-vcluster:0.20"},
+		{image: "quay.io/prometheus/busybox", expected: "mirror.corp/busybox:stable"},
+		{image: "quay.io/prometheus/busybox:latest", expected: "quay.io/prometheus/busybox:latest"},
+		{image: "registry.k8s.io/pause:3.9", expected: "registry.k8s.io/pause:3.9"},
+		{image: "registry.k8s.io/pause:3.10", expected: "registry.k8s.io/pause:3.10@sha256:abcd"},
+		{image: "localhost:5000/app@sha256:abcd", expected: "localhost:5000/app@sha256:abcd"},
+		{image: "nginx:exact", expected: "exact/nginx:1.0"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.image, func(t *testing.T) {
+			translatedImage, err := imageTranslator.Translate(context.Background(), testCase.image)
+			assert.NilError(t, err)
+			assert.Equal(t, translatedImage, testCase.expected)
+		})
+	}
+
+	// the invalid key is skipped and only reported once
+	assert.Equal(t, len(recorder.Events), 1)
+	assert.Assert(t, strings.HasPrefix(<-recorder.Events, "Warning InvalidImageDigests Skipping key invalid.yaml"))
+}
+
+func TestImageTranslatorMissingDigests(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	hostClient := fake.NewClientBuilder().Build()
+	imageTranslator, err := NewImageTranslator(nil, config.SyncPodsRewriteImages{Digests: config.ImageDigests{ConfigMap: "digests"}}, "vcluster", hostClient, recorder)
+	assert.NilError(t, err)
+
+	// images are not pinned until the config map exists
+	for range 2 {
+		translatedImage, err := imageTranslator.Translate(context.Background(), "nginx")
+		assert.NilError(t, err)
+		assert.Equal(t, translatedImage, "nginx")
+	}
+	assert.Equal(t, len(recorder.Events), 1)
+	assert.Assert(t, strings.Contains(<-recorder.Events, "Warning ImageDigestsUnavailable Images are not pinned to digests: get image digests config map vcluster/digests"))
+
+	err = hostClient.Create(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "digests", Namespace: "vcluster"},
+		Data:       map[string]string{"images.yaml": "nginx: sha256:1234\n"},
+	})
+	assert.NilError(t, err)
+	translatedImage, err := imageTranslator.Translate(context.Background(), "nginx")
+	assert.NilError(t, err)
+	assert.Equal(t, translatedImage, "nginx@sha256:1234")
+}
+
+func TestCalcContainerImageDiffKeepsDigest(t *testing.T) {
+	imageTranslator, err := NewImageTranslator(nil, config.SyncPodsRewriteImages{Digests: config.ImageDigests{ConfigMap: "digests"}}, "vcluster", fake.NewClientBuilder().Build(), nil)
+	assert.NilError(t, err)
+
+	pContainers := []corev1.Container{{Name: "nginx", Image: "nginx:1.25@sha256:1234"}}
+	updated, err := calcContainerImageDiff(context.Background(), pContainers, []corev1.Container{{Name: "nginx", Image: "nginx:1.25"}}, imageTranslator, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, len(updated), 0)
+
+	updated, err = calcContainerImageDiff(context.Background(), pContainers, []corev1.Container{{Name: "nginx", Image: "nginx:1.26"}}, imageTranslator, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, updated, []corev1.Container{{Name: "nginx", Image: "nginx:1.26"}})
+}
+
+func TestTranslateStatusImages(t *testing.T) {
+	vPod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "nginx", Image: "nginx:1.25"},
+				{Name: "sidecar", Image: "registry.k8s.io/pause:3.9"},
+			},
+		},
+	}
+	pPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "nginx", Image: "mirror.corp/dockerhub/library/nginx:1.25@sha256:1234"},
+				{Name: "sidecar", Image: "registry.k8s.io/pause:3.9"},
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "nginx", Image: "mirror.corp/dockerhub/library/nginx:1.25"},
+				{Name: "sidecar", Image: "registry.k8s.io/pause:3.9"},
+			},
+		},
+	}
+
+	err := setTranslatedImagesAnnotation(pPod.Annotations, translatedImagesFromSpec(vPod, &pPod.Spec))
+	assert.NilError(t, err)
+	assert.Equal(t, pPod.Annotations[TranslatedImagesAnnotation], `{"mirror.corp/dockerhub/library/nginx:1.25@sha256:1234":"nginx:1.25"}`)
+
+	translatedPod := TranslateStatusImages(pPod)
+	assert.DeepEqual(t, translatedPod.Status.ContainerStatuses, []corev1.ContainerStatus{
+		{Name: "nginx", Image: "nginx:1.25"},
+		{Name: "sidecar", Image: "registry.k8s.io/pause:3.9"},
+	})
+	assert.Equal(t, pPod.Status.ContainerStatuses[0].Image, "mirror.corp/dockerhub/library/nginx:1.25")
+}
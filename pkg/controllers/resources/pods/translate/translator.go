@@ -42,6 +42,7 @@ const (
 	ClusterAutoScalerDaemonSetAnnotation = "cluster-autoscaler.kubernetes.io/daemonset-pod"
 	ServiceAccountNameAnnotation         = "vcluster.loft.sh/service-account-name"
 	ServiceAccountTokenAnnotation        = "vcluster.loft.sh/token-"
+	TranslatedImagesAnnotation           = "vcluster.loft.sh/translated-images"
 )
 
 var (
@@ -56,10 +57,12 @@ type Translator interface {
 	Diff(ctx context.Context, vPod, pPod *corev1.Pod) (*corev1.Pod, error)
 
 	TranslateContainerEnv(envVar []corev1.EnvVar, envFrom []corev1.EnvFromSource, vPod *corev1.Pod, serviceEnvMap map[string]string) ([]corev1.EnvVar, []corev1.EnvFromSource)
+	TranslateImage(ctx context.Context, image string) (string, error)
+	TranslateContainerSecurityContext(ctx context.Context, vPod *corev1.Pod, securityContext *corev1.SecurityContext) (*corev1.SecurityContext, error)
 }
 
 func NewTranslator(ctx *synccontext.RegisterContext, eventRecorder record.EventRecorder) (Translator, error) {
-	imageTranslator, err := NewImageTranslator(ctx.Config.Sync.ToHost.Pods.TranslateImage)
+	imageTranslator, err := NewImageTranslator(ctx.Config.Sync.ToHost.Pods.TranslateImage, ctx.Config.Sync.ToHost.Pods.RewriteImages, ctx.CurrentNamespace, ctx.CurrentNamespaceClient, eventRecorder)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +86,12 @@ func NewTranslator(ctx *synccontext.RegisterContext, eventRecorder record.EventR
 		return nil, fmt.Errorf("parse init container resource requests: %w", err)
 	}
 
+	// parse enforced pod settings
+	enforcement, err := newPodEnforcement(ctx.Config.Sync.ToHost.Pods.Enforce)
+	if err != nil {
+		return nil, fmt.Errorf("parse enforced pod settings: %w", err)
+	}
+
 	return &translator{
 		vClientConfig: ctx.VirtualManager.GetConfig(),
 		vClient:       ctx.VirtualManager.GetClient(),
@@ -108,6 +117,8 @@ func NewTranslator(ctx *synccontext.RegisterContext, eventRecorder record.EventR
 		priorityClassesEnabled: ctx.Config.Sync.ToHost.PriorityClasses.Enabled,
 		enableScheduler:        ctx.Config.ControlPlane.Advanced.VirtualScheduler.Enabled,
 		syncedLabels:           ctx.Config.Experimental.SyncSettings.SyncLabels,
+		workloadLabels:         ctx.Config.Sync.ToHost.Pods.WorkloadLabels.Enabled,
+		enforcement:            enforcement,
 
 		mountPhysicalHostPaths: ctx.Config.ControlPlane.HostPathMapper.Enabled && !ctx.Config.ControlPlane.HostPathMapper.Central,
 
@@ -142,6 +153,8 @@ type translator struct {
 	priorityClassesEnabled       bool
 	enableScheduler              bool
 	syncedLabels                 []string
+	workloadLabels               bool
+	enforcement                  *podEnforcement
 
 	virtualLogsPath       string
 	virtualPodLogsPath    string
@@ -249,6 +262,12 @@ func (t *translator) Translate(ctx context.Context, vPod *corev1.Pod, services [
 	for k, v := range vNamespace.GetLabels() {
 		updatedLabels[translate.ConvertLabelKeyWithPrefix(NamespaceLabelPrefix, k)] = v
 	}
+
+	// Add workload labels
+	err = t.translateWorkloadLabels(ctx, vPod, updatedLabels)
+	if err != nil {
+		return nil, fmt.Errorf("translate workload labels: %w", err)
+	}
 	pPod.SetLabels(updatedLabels)
 
 	// translate services to environment variables
@@ -294,7 +313,6 @@ func (t *translator) Translate(ctx context.Context, vPod *corev1.Pod, services [
 		envVar, envFrom := t.TranslateContainerEnv(pPod.Spec.Containers[i].Env, pPod.Spec.Containers[i].EnvFrom, vPod, serviceEnv)
 		pPod.Spec.Containers[i].Env = envVar
 		pPod.Spec.Containers[i].EnvFrom = envFrom
-		pPod.Spec.Containers[i].Image = t.imageTranslator.Translate(pPod.Spec.Containers[i].Image)
 	}
 
 	// translate init containers
@@ -302,7 +320,6 @@ func (t *translator) Translate(ctx context.Context, vPod *corev1.Pod, services [
 		envVar, envFrom := t.TranslateContainerEnv(pPod.Spec.InitContainers[i].Env, pPod.Spec.InitContainers[i].EnvFrom, vPod, serviceEnv)
 		pPod.Spec.InitContainers[i].Env = envVar
 		pPod.Spec.InitContainers[i].EnvFrom = envFrom
-		pPod.Spec.InitContainers[i].Image = t.imageTranslator.Translate(pPod.Spec.InitContainers[i].Image)
 	}
 
 	// translate ephemeral containers
@@ -310,7 +327,12 @@ func (t *translator) Translate(ctx context.Context, vPod *corev1.Pod, services [
 		envVar, envFrom := t.TranslateContainerEnv(pPod.Spec.EphemeralContainers[i].Env, pPod.Spec.EphemeralContainers[i].EnvFrom, vPod, serviceEnv)
 		pPod.Spec.EphemeralContainers[i].Env = envVar
 		pPod.Spec.EphemeralContainers[i].EnvFrom = envFrom
-		pPod.Spec.EphemeralContainers[i].Image = t.imageTranslator.Translate(pPod.Spec.EphemeralContainers[i].Image)
+	}
+
+	// translate container images
+	err = t.translateImages(ctx, vPod, pPod)
+	if err != nil {
+		return nil, err
 	}
 
 	// translate image pull secrets
@@ -347,9 +369,170 @@ func (t *translator) Translate(ctx context.Context, vPod *corev1.Pod, services [
 		}
 	}
 
+	// translate node names if node privacy is enabled
+	err = t.translateNodeNames(ctx, pPod)
+	if err != nil {
+		return nil, err
+	}
+
+	// enforce scheduling constraints
+	err = t.enforcement.settingsFor(vNamespace).apply(pPod)
+	if err != nil {
+		return nil, fmt.Errorf("enforce pod settings: %w", err)
+	}
+
 	return pPod, nil
 }
 
+// translateNodeNames replaces the virtual node names with the host node names within the node name and
+// node affinity of the pod, which are only different if node privacy is enabled. Selectors on the
+// kubernetes.io/hostname label are translated as well, as the label holds the virtual node name.
+func (t *translator) translateNodeNames(ctx context.Context, pPod *corev1.Pod) error {
+	if !translate.Nodes.Private() {
+		return nil
+	}
+
+	if pPod.Spec.NodeName != "" {
+		hostName, ok := translate.Nodes.HostName(pPod.Spec.NodeName)
+		if !ok {
+			return fmt.Errorf("node %s not found", pPod.Spec.NodeName)
+		}
+
+		pPod.Spec.NodeName = hostName
+	}
+	if vHostname, ok := pPod.Spec.NodeSelector[corev1.LabelHostname]; ok {
+		if hostname, ok := translate.HostHostname(ctx, t.pClient, vHostname); ok {
+			pPod.Spec.NodeSelector[corev1.LabelHostname] = hostname
+		}
+	}
+
+	if pPod.Spec.Affinity == nil || pPod.Spec.Affinity.NodeAffinity == nil {
+		return nil
+	}
+	for i := range pPod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		translate.HostNodeSelectorRequirements(ctx, t.pClient, pPod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[i].Preference.MatchExpressions)
+	}
+	if pPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return nil
+	}
+	translate.HostNodeSelector(ctx, t.pClient, pPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+
+	// daemon set pods select their node by metadata.name
+	terms := pPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for i := range terms {
+		for j := range terms[i].MatchFields {
+			if terms[i].MatchFields[j].Key != metav1.ObjectNameField {
+				continue
+			}
+
+			values := terms[i].MatchFields[j].Values
+			for k := range values {
+				hostName, ok := translate.Nodes.HostName(values[k])
+				if !ok {
+					return fmt.Errorf("node %s not found", values[k])
+				}
+
+				values[k] = hostName
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *translator) TranslateImage(ctx context.Context, image string) (string, error) {
+	return t.imageTranslator.Translate(ctx, image)
+}
+
+// TranslateContainerSecurityContext enforces the security context of the virtual pod's namespace on the given container
+// security context, which is needed for ephemeral containers that are added to an already synced pod
+func (t *translator) TranslateContainerSecurityContext(ctx context.Context, vPod *corev1.Pod, securityContext *corev1.SecurityContext) (*corev1.SecurityContext, error) {
+	vNamespace := &corev1.Namespace{}
+	err := t.vClient.Get(ctx, client.ObjectKey{Name: vPod.Namespace}, vNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.enforcement.settingsFor(vNamespace).containerSecurityContext(securityContext)
+}
+
+// translateImages translates the images of all containers and records the translated images within an annotation,
+// so that the container statuses can be mapped back to the images of the virtual pod
+func (t *translator) translateImages(ctx context.Context, vPod, pPod *corev1.Pod) error {
+	images := []*string{}
+	for i := range pPod.Spec.Containers {
+		images = append(images, &pPod.Spec.Containers[i].Image)
+	}
+	for i := range pPod.Spec.InitContainers {
+		images = append(images, &pPod.Spec.InitContainers[i].Image)
+	}
+	for i := range pPod.Spec.EphemeralContainers {
+		images = append(images, &pPod.Spec.EphemeralContainers[i].Image)
+	}
+	for _, image := range images {
+		translatedImage, err := t.imageTranslator.Translate(ctx, *image)
+		if err != nil {
+			return fmt.Errorf("translate image %s: %w", *image, err)
+		}
+
+		*image = translatedImage
+	}
+
+	return setTranslatedImagesAnnotation(pPod.Annotations, translatedImagesFromSpec(vPod, &pPod.Spec))
+}
+
+func setTranslatedImagesAnnotation(annotations, translatedImages map[string]string) error {
+	if len(translatedImages) == 0 {
+		delete(annotations, TranslatedImagesAnnotation)
+		return nil
+	}
+
+	out, err := json.Marshal(translatedImages)
+	if err != nil {
+		return err
+	}
+
+	annotations[TranslatedImagesAnnotation] = string(out)
+	return nil
+}
+
+// TranslateStatusImages maps the images of the container statuses of the host pod back to the images of the virtual pod
+func TranslateStatusImages(pPod *corev1.Pod) *corev1.Pod {
+	if pPod.Annotations[TranslatedImagesAnnotation] == "" {
+		return pPod
+	}
+
+	translatedImages := map[string]string{}
+	err := json.Unmarshal([]byte(pPod.Annotations[TranslatedImagesAnnotation]), &translatedImages)
+	if err != nil {
+		return pPod
+	}
+
+	normalizedImages := map[string]string{}
+	for translatedImage, image := range translatedImages {
+		// the container runtime might report the image without the pinned digest
+		normalizedImages[NormalizeImage(translatedImage)] = image
+		if withoutDigest, _, found := strings.Cut(translatedImage, "@"); found {
+			normalizedImages[NormalizeImage(withoutDigest)] = image
+		}
+	}
+
+	newPod := pPod.DeepCopy()
+	translateStatuses := func(statuses []corev1.ContainerStatus) {
+		for i := range statuses {
+			if image, ok := translatedImages[statuses[i].Image]; ok {
+				statuses[i].Image = image
+			} else if image, ok := normalizedImages[NormalizeImage(statuses[i].Image)]; ok {
+				statuses[i].Image = image
+			}
+		}
+	}
+	translateStatuses(newPod.Status.InitContainerStatuses)
+	translateStatuses(newPod.Status.ContainerStatuses)
+	translateStatuses(newPod.Status.EphemeralContainerStatuses)
+	return newPod
+}
+
 func canAnnotateOwnerSetKind(kind string) bool {
 	return kind == "DaemonSet" || kind == "Job" || kind == "ReplicaSet" || kind == "StatefulSet"
 }
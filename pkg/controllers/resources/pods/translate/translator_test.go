@@ -8,6 +8,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/assert/cmp"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
@@ -224,6 +225,73 @@ func TestVolumeTranslation(t *testing.T) {
 	}
 }
 
+func TestWorkloadLabelsTranslation(t *testing.T) {
+	controller := true
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-deployment-abc",
+			Namespace: "test-ns",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "Deployment", Name: "my-deployment", Controller: &controller},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		owner          *metav1.OwnerReference
+		expectedLabels map[string]string
+	}{
+		{
+			name:           "no owner",
+			expectedLabels: map[string]string{},
+		},
+		{
+			name:  "deployment",
+			owner: &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "my-deployment-abc", Controller: &controller},
+			expectedLabels: map[string]string{
+				WorkloadKindLabel: "Deployment",
+				WorkloadNameLabel: "my-deployment",
+			},
+		},
+		{
+			name:  "missing replica set",
+			owner: &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "other", Controller: &controller},
+			expectedLabels: map[string]string{
+				WorkloadKindLabel: "ReplicaSet",
+				WorkloadNameLabel: "other",
+			},
+		},
+		{
+			name:  "stateful set",
+			owner: &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "my-statefulset", Controller: &controller},
+			expectedLabels: map[string]string{
+				WorkloadKindLabel: "StatefulSet",
+				WorkloadNameLabel: "my-statefulset",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tr := &translator{
+				vClient:        fake.NewClientBuilder().WithObjects(replicaSet).Build(),
+				workloadLabels: true,
+			}
+
+			vPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-name", Namespace: "test-ns"}}
+			if testCase.owner != nil {
+				vPod.OwnerReferences = []metav1.OwnerReference{*testCase.owner}
+			}
+
+			labels := map[string]string{WorkloadKindLabel: "Stale"}
+			err := tr.translateWorkloadLabels(context.Background(), vPod, labels)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, labels, testCase.expectedLabels)
+		})
+	}
+}
+
 type translatePodVolumesTestCase struct {
 	name            string
 	vPod            corev1.Pod
@@ -239,3 +307,60 @@ func appendNamespacesToMatchExpressions(source *metav1.LabelSelector, namespaces
 	})
 	return ls
 }
+
+func TestNodeNamesTranslation(t *testing.T) {
+	defer func(nodes translate.NodeTranslator) {
+		translate.Nodes = nodes
+	}(translate.Nodes)
+	translate.Nodes = translate.NewPrivateNodeTranslator("vcluster/vcluster")
+
+	pNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "ip-10-0-0-1.ec2.internal",
+			Labels: map[string]string{corev1.LabelHostname: "ip-10-0-0-1"},
+		},
+	}
+	vName := translate.Nodes.VirtualName(pNode.Name)
+	tr := &translator{
+		pClient: fake.NewClientBuilder().WithObjects(pNode).Build(),
+	}
+
+	pPod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			NodeSelector: map[string]string{corev1.LabelHostname: vName},
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: corev1.LabelHostname, Operator: corev1.NodeSelectorOpIn, Values: []string{vName, "unknown"}},
+								{Key: corev1.LabelTopologyZone, Operator: corev1.NodeSelectorOpIn, Values: []string{vName}},
+							},
+							MatchFields: []corev1.NodeSelectorRequirement{
+								{Key: metav1.ObjectNameField, Operator: corev1.NodeSelectorOpIn, Values: []string{vName}},
+							},
+						}},
+					},
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{
+						Weight: 1,
+						Preference: corev1.NodeSelectorTerm{
+							MatchExpressions: []corev1.NodeSelectorRequirement{
+								{Key: corev1.LabelHostname, Operator: corev1.NodeSelectorOpNotIn, Values: []string{vName}},
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	err := tr.translateNodeNames(context.Background(), pPod)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pPod.Spec.NodeSelector, map[string]string{corev1.LabelHostname: "ip-10-0-0-1"})
+	required := pPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0]
+	assert.DeepEqual(t, required.MatchExpressions[0].Values, []string{"ip-10-0-0-1", "unknown"})
+	assert.DeepEqual(t, required.MatchExpressions[1].Values, []string{vName})
+	assert.DeepEqual(t, required.MatchFields[0].Values, []string{pNode.Name})
+	preferred := pPod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].Preference
+	assert.DeepEqual(t, preferred.MatchExpressions[0].Values, []string{"ip-10-0-0-1"})
+}
@@ -0,0 +1,73 @@
+package translate
+
+import (
+	"context"
+
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	WorkloadKindLabel = "vcluster.loft.sh/workload-kind"
+	WorkloadNameLabel = "vcluster.loft.sh/workload-name"
+)
+
+// translateWorkloadLabels sets or removes the labels of the workload owning the virtual pod
+func (t *translator) translateWorkloadLabels(ctx context.Context, vPod *corev1.Pod, labels map[string]string) error {
+	delete(labels, WorkloadKindLabel)
+	delete(labels, WorkloadNameLabel)
+	if !t.workloadLabels {
+		return nil
+	}
+
+	kind, name, err := t.resolveWorkload(ctx, vPod)
+	if err != nil {
+		return err
+	} else if kind == "" {
+		return nil
+	}
+
+	labels[WorkloadKindLabel] = kind
+	labels[WorkloadNameLabel] = translate.SafeConcatName(name)
+	return nil
+}
+
+// resolveWorkload returns the kind and name of the top level controller of the virtual pod. Pods of
+// replica sets are resolved to their deployment and pods of jobs to their cron job.
+func (t *translator) resolveWorkload(ctx context.Context, vPod *corev1.Pod) (string, string, error) {
+	controller := metav1.GetControllerOf(vPod)
+	if controller == nil {
+		return "", "", nil
+	}
+
+	var owner client.Object
+	switch {
+	case controller.APIVersion == appsv1.SchemeGroupVersion.String() && controller.Kind == "ReplicaSet":
+		owner = &appsv1.ReplicaSet{}
+	case controller.APIVersion == batchv1.SchemeGroupVersion.String() && controller.Kind == "Job":
+		owner = &batchv1.Job{}
+	default:
+		return controller.Kind, controller.Name, nil
+	}
+
+	err := t.vClient.Get(ctx, client.ObjectKey{Namespace: vPod.Namespace, Name: controller.Name}, owner)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return controller.Kind, controller.Name, nil
+		}
+
+		return "", "", err
+	}
+
+	ownerController := metav1.GetControllerOf(owner)
+	if ownerController == nil {
+		return controller.Kind, controller.Name, nil
+	}
+
+	return ownerController.Kind, ownerController.Name, nil
+}
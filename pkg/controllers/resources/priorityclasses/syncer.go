@@ -36,9 +36,13 @@ func (s *priorityClassSyncer) RegisterIndices(ctx *synccontext.RegisterContext)
 var _ syncer.Syncer = &priorityClassSyncer{}
 
 func (s *priorityClassSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
-	newPriorityClass := s.translate(ctx.Context, vObj.(*schedulingv1.PriorityClass))
+	newPriorityClass, err := s.translate(ctx, vObj.(*schedulingv1.PriorityClass))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	ctx.Log.Infof("create physical priority class %s", newPriorityClass.Name)
-	err := ctx.PhysicalClient.Create(ctx.Context, newPriorityClass)
+	err = ctx.PhysicalClient.Create(ctx.Context, newPriorityClass)
 	if err != nil {
 		ctx.Log.Infof("error syncing %s to physical cluster: %v", vObj.GetName(), err)
 		return ctrl.Result{}, err
@@ -63,8 +67,7 @@ func (s *priorityClassSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Obj
 	pPriorityClass, vPriorityClass, sourceObject, targetObject := synccontext.Cast[*schedulingv1.PriorityClass](ctx, pObj, vObj)
 
 	// did the priority class change?
-	s.translateUpdate(ctx.Context, pPriorityClass, vPriorityClass, sourceObject, targetObject)
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, s.translateUpdate(ctx, pPriorityClass, vPriorityClass, sourceObject, targetObject)
 }
 
 func NewPriorityClassTranslator() translate.PhysicalNameTranslator {
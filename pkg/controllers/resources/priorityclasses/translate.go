@@ -1,24 +1,24 @@
 package priorityclasses
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *priorityClassSyncer) translate(ctx context.Context, vObj client.Object) *schedulingv1.PriorityClass {
+func (s *priorityClassSyncer) translate(ctx *synccontext.SyncContext, vObj client.Object) (*schedulingv1.PriorityClass, error) {
 	// translate the priority class
-	priorityClass := s.TranslateMetadata(ctx, vObj).(*schedulingv1.PriorityClass)
+	priorityClass := s.TranslateMetadata(ctx.Context, vObj).(*schedulingv1.PriorityClass)
 	priorityClass.GlobalDefault = false
 	if priorityClass.Value > 1000000000 {
 		priorityClass.Value = 1000000000
 	}
-	return priorityClass
+	return priorityClass, translator.ApplyToHostPatches(ctx, vObj, priorityClass)
 }
 
-func (s *priorityClassSyncer) translateUpdate(ctx context.Context, pObj, vObj, sourceObject, targetObject *schedulingv1.PriorityClass) {
+func (s *priorityClassSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj, sourceObject, targetObject *schedulingv1.PriorityClass) error {
 	// check subsets
 	if !equality.Semantic.DeepEqual(vObj.PreemptionPolicy, pObj.PreemptionPolicy) {
 		targetObject.PreemptionPolicy = sourceObject.PreemptionPolicy
@@ -30,7 +30,7 @@ func (s *priorityClassSyncer) translateUpdate(ctx context.Context, pObj, vObj, s
 	}
 
 	// check annotations
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		pObj.Annotations = updatedAnnotations
 		pObj.Labels = updatedLabels
@@ -44,4 +44,6 @@ func (s *priorityClassSyncer) translateUpdate(ctx context.Context, pObj, vObj, s
 	if translatedValue != pObj.Value {
 		pObj.Value = translatedValue
 	}
+
+	return translator.ApplyToHostPatches(ctx, vObj, pObj)
 }
@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	"github.com/loft-sh/vcluster/pkg/constants"
+	"github.com/loft-sh/vcluster/pkg/controllers/resources/gatewayapi"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/ingresses"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/ingresses/legacy"
 	"github.com/loft-sh/vcluster/pkg/controllers/resources/pods"
@@ -23,6 +24,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -45,6 +47,7 @@ func NewSyncer(ctx *synccontext.RegisterContext, useLegacy bool) (syncer.Object,
 
 		useLegacyIngress: useLegacy,
 		includeIngresses: ctx.Config.Sync.ToHost.Ingresses.Enabled,
+		includeGateways:  ctx.Config.Sync.ToHost.GatewayAPI.Enabled && ctx.Config.Sync.ToHost.GatewayAPI.Gateways.Enabled,
 
 		syncAllSecrets: ctx.Config.Sync.ToHost.Secrets.All,
 	}, nil
@@ -55,6 +58,7 @@ type secretSyncer struct {
 
 	useLegacyIngress bool
 	includeIngresses bool
+	includeGateways  bool
 
 	syncAllSecrets bool
 }
@@ -80,6 +84,15 @@ func (s *secretSyncer) RegisterIndices(ctx *synccontext.RegisterContext) error {
 		}
 	}
 
+	if s.includeGateways {
+		err := ctx.VirtualManager.GetFieldIndexer().IndexField(ctx.Context, gatewayapi.NewObject(gatewayapi.GatewayGVK), constants.IndexByGatewaySecret, func(rawObj client.Object) []string {
+			return gatewayapi.SecretNamesFromGateway(rawObj.(*unstructured.Unstructured))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	err := ctx.VirtualManager.GetFieldIndexer().IndexField(ctx.Context, &corev1.Pod{}, constants.IndexByPodSecret, func(rawObj client.Object) []string {
 		return pods.SecretNamesFromPod(rawObj.(*corev1.Pod))
 	})
@@ -100,6 +113,10 @@ func (s *secretSyncer) ModifyController(_ *synccontext.RegisterContext, builder
 		}
 	}
 
+	if s.includeGateways {
+		builder = builder.Watches(gatewayapi.NewObject(gatewayapi.GatewayGVK), handler.EnqueueRequestsFromMapFunc(mapGateways))
+	}
+
 	return builder.Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(mapPods)), nil
 }
 
@@ -111,7 +128,12 @@ func (s *secretSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Obje
 		return ctrl.Result{}, nil
 	}
 
-	return s.SyncToHostCreate(ctx, vObj, s.create(ctx.Context, vObj.(*corev1.Secret)))
+	pObj, err := s.create(ctx, vObj.(*corev1.Secret))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return s.SyncToHostCreate(ctx, vObj, pObj)
 }
 
 func (s *secretSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (_ ctrl.Result, retErr error) {
@@ -161,6 +183,12 @@ func (s *secretSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vO
 		pSecret.Labels = updatedLabels
 	}
 
+	// apply the sync patches before the objects are compared
+	err = translator.ApplyToHostPatches(ctx, vObj, pSecret)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -200,6 +228,20 @@ func (s *secretSyncer) isSecretUsed(ctx *synccontext.SyncContext, vObj runtime.O
 		}
 	}
 
+	// check if we also sync gateways
+	if s.includeGateways {
+		gatewayList := gatewayapi.NewObjectList(gatewayapi.GatewayGVK)
+		err := ctx.VirtualClient.List(ctx.Context, gatewayList, client.MatchingFields{constants.IndexByGatewaySecret: secret.Namespace + "/" + secret.Name})
+		if err != nil {
+			return false, err
+		}
+
+		isUsed = meta.LenList(gatewayList) > 0
+		if isUsed {
+			return true, nil
+		}
+	}
+
 	if s.syncAllSecrets {
 		return true, nil
 	}
@@ -253,6 +295,29 @@ func mapIngressesLegacy(_ context.Context, obj client.Object) []reconcile.Reques
 	return requests
 }
 
+func mapGateways(_ context.Context, obj client.Object) []reconcile.Request {
+	gateway, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	names := gatewayapi.SecretNamesFromGateway(gateway)
+	for _, name := range names {
+		splitted := strings.Split(name, "/")
+		if len(splitted) == 2 {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: splitted[0],
+					Name:      splitted[1],
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
 func mapPods(_ context.Context, obj client.Object) []reconcile.Request {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
@@ -1,16 +1,16 @@
 package secrets
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	corev1 "k8s.io/api/core/v1"
 )
 
-func (s *secretSyncer) create(ctx context.Context, vObj *corev1.Secret) *corev1.Secret {
-	newSecret := s.TranslateMetadata(ctx, vObj).(*corev1.Secret)
+func (s *secretSyncer) create(ctx *synccontext.SyncContext, vObj *corev1.Secret) (*corev1.Secret, error) {
+	newSecret := s.TranslateMetadata(ctx.Context, vObj).(*corev1.Secret)
 	if newSecret.Type == corev1.SecretTypeServiceAccountToken {
 		newSecret.Type = corev1.SecretTypeOpaque
 	}
 
-	return newSecret
+	return newSecret, translator.ApplyToHostPatches(ctx, vObj, newSecret)
 }
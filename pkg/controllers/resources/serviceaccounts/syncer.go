@@ -21,13 +21,20 @@ type serviceAccountSyncer struct {
 }
 
 func (s *serviceAccountSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
-	return s.SyncToHostCreate(ctx, vObj, s.translate(ctx.Context, vObj.(*corev1.ServiceAccount)))
+	pObj, err := s.translate(ctx, vObj.(*corev1.ServiceAccount))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return s.SyncToHostCreate(ctx, vObj, pObj)
 }
 
 func (s *serviceAccountSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
 	// did the service account change?
-	newServiceAccount := s.translateUpdate(ctx.Context, pObj.(*corev1.ServiceAccount), vObj.(*corev1.ServiceAccount))
-	if newServiceAccount != nil {
+	newServiceAccount, err := s.translateUpdate(ctx, pObj.(*corev1.ServiceAccount), vObj.(*corev1.ServiceAccount))
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if newServiceAccount != nil {
 		translator.PrintChanges(pObj, newServiceAccount, ctx.Log)
 	}
 
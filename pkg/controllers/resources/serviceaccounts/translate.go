@@ -1,8 +1,7 @@
 package serviceaccounts
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -12,26 +11,26 @@ var (
 	f = false
 )
 
-func (s *serviceAccountSyncer) translate(ctx context.Context, vObj client.Object) *corev1.ServiceAccount {
-	pObj := s.TranslateMetadata(ctx, vObj).(*corev1.ServiceAccount)
+func (s *serviceAccountSyncer) translate(ctx *synccontext.SyncContext, vObj client.Object) (*corev1.ServiceAccount, error) {
+	pObj := s.TranslateMetadata(ctx.Context, vObj).(*corev1.ServiceAccount)
 
 	// Don't sync the secrets here as we will override them anyways
 	pObj.Secrets = nil
 	pObj.AutomountServiceAccountToken = &f
 	pObj.ImagePullSecrets = nil
-	return pObj
+	return pObj, translator.ApplyToHostPatches(ctx, vObj, pObj)
 }
 
-func (s *serviceAccountSyncer) translateUpdate(ctx context.Context, pObj, vObj *corev1.ServiceAccount) *corev1.ServiceAccount {
+func (s *serviceAccountSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *corev1.ServiceAccount) (*corev1.ServiceAccount, error) {
 	var updated *corev1.ServiceAccount
 
 	// check annotations & labels
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		updated = translator.NewIfNil(updated, pObj)
 		updated.Labels = updatedLabels
 		updated.Annotations = updatedAnnotations
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
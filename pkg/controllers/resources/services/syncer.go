@@ -45,7 +45,12 @@ func (s *serviceSyncer) WithOptions() *syncertypes.Options {
 }
 
 func (s *serviceSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
-	return s.SyncToHostCreate(ctx, vObj, s.translate(ctx.Context, vObj.(*corev1.Service)))
+	pObj, err := s.translate(ctx, vObj.(*corev1.Service))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return s.SyncToHostCreate(ctx, vObj, pObj)
 }
 
 func (s *serviceSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
@@ -98,8 +103,10 @@ func (s *serviceSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, v
 	}
 
 	// forward update
-	newService = s.translateUpdate(ctx.Context, pService, vService)
-	if newService != nil {
+	newService, err := s.translateUpdate(ctx, pService, vService)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if newService != nil {
 		translator.PrintChanges(pService, newService, ctx.Log)
 	}
 
@@ -1,16 +1,15 @@
 package services
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (s *serviceSyncer) translate(ctx context.Context, vObj *corev1.Service) *corev1.Service {
-	newService := s.TranslateMetadata(ctx, vObj).(*corev1.Service)
+func (s *serviceSyncer) translate(ctx *synccontext.SyncContext, vObj *corev1.Service) (*corev1.Service, error) {
+	newService := s.TranslateMetadata(ctx.Context, vObj).(*corev1.Service)
 	newService.Spec.Selector = translate.Default.TranslateLabels(vObj.Spec.Selector, vObj.Namespace, nil)
 	if newService.Spec.ClusterIP != "None" {
 		newService.Spec.ClusterIP = ""
@@ -28,7 +27,7 @@ func (s *serviceSyncer) translate(ctx context.Context, vObj *corev1.Service) *co
 	newService.Spec.IPFamilyPolicy = nil
 
 	StripNodePorts(newService)
-	return newService
+	return newService, translator.ApplyToHostPatches(ctx, vObj, newService)
 }
 
 func StripNodePorts(vObj *corev1.Service) {
@@ -76,11 +75,11 @@ func (s *serviceSyncer) translateUpdateBackwards(pObj, vObj *corev1.Service) *co
 	return updated
 }
 
-func (s *serviceSyncer) translateUpdate(ctx context.Context, pObj, vObj *corev1.Service) *corev1.Service {
+func (s *serviceSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *corev1.Service) (*corev1.Service, error) {
 	var updated *corev1.Service
 
 	// check annotations
-	_, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	_, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	// remove the ServiceBlockDeletion annotation if it's not needed
 	if vObj.Spec.ClusterIP == pObj.Spec.ClusterIP {
 		delete(updatedAnnotations, ServiceBlockDeletion)
@@ -156,5 +155,5 @@ func (s *serviceSyncer) translateUpdate(ctx context.Context, pObj, vObj *corev1.
 		updated.Spec.Selector = translated.Spec.Selector
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
@@ -1,6 +1,7 @@
 package storageclasses
 
 import (
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	syncer "github.com/loft-sh/vcluster/pkg/types"
@@ -9,20 +10,41 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewHostStorageClassSyncer(*synccontext.RegisterContext) (syncer.Object, error) {
+func NewHostStorageClassSyncer(ctx *synccontext.RegisterContext) (syncer.Object, error) {
+	mappings := ctx.Config.Sync.FromHost.StorageClasses.Mappings
+	if len(mappings.ByName) > 0 {
+		return &hostStorageClassSyncer{
+			Translator: translator.NewMappedPhysicalTranslator("host-storageclass", &storagev1.StorageClass{}, mappings.ByName),
+
+			mappings: mappings,
+		}, nil
+	}
+
 	return &hostStorageClassSyncer{
 		Translator: translator.NewMirrorPhysicalTranslator("host-storageclass", &storagev1.StorageClass{}),
+
+		mappings: mappings,
 	}, nil
 }
 
 type hostStorageClassSyncer struct {
 	translator.Translator
+
+	mappings vclusterconfig.ClassMappings
 }
 
 var _ syncer.ToVirtualSyncer = &hostStorageClassSyncer{}
 
 func (s *hostStorageClassSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pObj client.Object) (ctrl.Result, error) {
-	vObj := s.translateBackwards(ctx.Context, pObj.(*storagev1.StorageClass))
+	if !s.isMapped(pObj) {
+		return ctrl.Result{}, nil
+	}
+
+	vObj, err := s.translateBackwards(ctx, pObj.(*storagev1.StorageClass))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	ctx.Log.Infof("create storage class %s, because it does not exist in virtual cluster", vObj.Name)
 	return ctrl.Result{}, ctx.VirtualClient.Create(ctx.Context, vObj)
 }
@@ -30,9 +52,21 @@ func (s *hostStorageClassSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pOb
 var _ syncer.Syncer = &hostStorageClassSyncer{}
 
 func (s *hostStorageClassSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
+	if !s.isMapped(pObj) {
+		// the virtual storage class could be mapped to another host storage class
+		if _, ok := s.mappings.ByName[vObj.GetName()]; ok {
+			return ctrl.Result{}, nil
+		}
+
+		ctx.Log.Infof("delete virtual storage class %s, because it is not mapped to a host storage class", vObj.GetName())
+		return ctrl.Result{}, ctx.VirtualClient.Delete(ctx.Context, vObj)
+	}
+
 	// check if there is a change
-	updated := s.translateUpdateBackwards(ctx.Context, pObj.(*storagev1.StorageClass), vObj.(*storagev1.StorageClass))
-	if updated != nil {
+	updated, err := s.translateUpdateBackwards(ctx, pObj.(*storagev1.StorageClass), vObj.(*storagev1.StorageClass))
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if updated != nil {
 		ctx.Log.Infof("update storage class %s", vObj.GetName())
 		translator.PrintChanges(pObj, updated, ctx.Log)
 		return ctrl.Result{}, ctx.VirtualClient.Update(ctx.Context, updated)
@@ -41,6 +75,16 @@ func (s *hostStorageClassSyncer) Sync(ctx *synccontext.SyncContext, pObj client.
 	return ctrl.Result{}, nil
 }
 
+// isMapped returns true if no storage class mappings are configured or the host storage class is mapped
+func (s *hostStorageClassSyncer) isMapped(pObj client.Object) bool {
+	if len(s.mappings.ByName) == 0 {
+		return true
+	}
+
+	_, ok := s.mappings.VirtualName(pObj.GetName())
+	return ok
+}
+
 func (s *hostStorageClassSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
 	ctx.Log.Infof("delete virtual storage class %s, because physical object is missing", vObj.GetName())
 	return ctrl.Result{}, ctx.VirtualClient.Delete(ctx.Context, vObj)
@@ -1,22 +1,24 @@
 package storageclasses
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (s *hostStorageClassSyncer) translateBackwards(ctx context.Context, pStorageClass *storagev1.StorageClass) *storagev1.StorageClass {
-	return s.TranslateMetadata(ctx, pStorageClass).(*storagev1.StorageClass)
+func (s *hostStorageClassSyncer) translateBackwards(ctx *synccontext.SyncContext, pStorageClass *storagev1.StorageClass) (*storagev1.StorageClass, error) {
+	vStorageClass := s.TranslateMetadata(ctx.Context, pStorageClass).(*storagev1.StorageClass)
+	vStorageClass.Annotations = translator.TranslateDefaultClassAnnotation(s.mappings, pStorageClass.Name, DefaultStorageClassAnnotation, vStorageClass.Annotations)
+	return vStorageClass, translator.ApplyFromHostPatches(ctx, pStorageClass, vStorageClass)
 }
 
-func (s *hostStorageClassSyncer) translateUpdateBackwards(ctx context.Context, pObj, vObj *storagev1.StorageClass) *storagev1.StorageClass {
+func (s *hostStorageClassSyncer) translateUpdateBackwards(ctx *synccontext.SyncContext, pObj, vObj *storagev1.StorageClass) (*storagev1.StorageClass, error) {
 	var updated *storagev1.StorageClass
 
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
-	if changed {
+	_, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
+	updatedAnnotations = translator.TranslateDefaultClassAnnotation(s.mappings, pObj.Name, DefaultStorageClassAnnotation, updatedAnnotations)
+	if !equality.Semantic.DeepEqual(updatedAnnotations, vObj.Annotations) || !equality.Semantic.DeepEqual(updatedLabels, vObj.Labels) {
 		updated = translator.NewIfNil(updated, vObj)
 		updated.Labels = updatedLabels
 		updated.Annotations = updatedAnnotations
@@ -57,5 +59,5 @@ func (s *hostStorageClassSyncer) translateUpdateBackwards(ctx context.Context, p
 		updated.AllowedTopologies = pObj.AllowedTopologies
 	}
 
-	return updated
+	return translator.ApplyFromHostPatchesUpdate(ctx, pObj, vObj, updated)
 }
@@ -1,6 +1,7 @@
 package storageclasses
 
 import (
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/constants"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
@@ -16,11 +17,15 @@ var DefaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class
 func New(ctx *synccontext.RegisterContext) (syncer.Object, error) {
 	return &storageClassSyncer{
 		Translator: translator.NewClusterTranslator(ctx, "storageclass", &storagev1.StorageClass{}, NewStorageClassTranslator(), DefaultStorageClassAnnotation),
+
+		mappings: ctx.Config.Sync.FromHost.StorageClasses.Mappings,
 	}, nil
 }
 
 type storageClassSyncer struct {
 	translator.Translator
+
+	mappings vclusterconfig.ClassMappings
 }
 
 var _ syncer.IndicesRegisterer = &storageClassSyncer{}
@@ -35,11 +40,13 @@ var _ syncer.Syncer = &storageClassSyncer{}
 
 func (s *storageClassSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (ctrl.Result, error) {
 	// did the storage class change?
-	updated := s.translateUpdate(ctx.Context, pObj.(*storagev1.StorageClass), vObj.(*storagev1.StorageClass))
-	if updated != nil {
+	updated, err := s.translateUpdate(ctx, pObj.(*storagev1.StorageClass), vObj.(*storagev1.StorageClass))
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if updated != nil {
 		ctx.Log.Infof("updating physical storage class %s, because virtual storage class has changed", updated.Name)
 		translator.PrintChanges(pObj, updated, ctx.Log)
-		err := ctx.PhysicalClient.Update(ctx.Context, updated)
+		err = ctx.PhysicalClient.Update(ctx.Context, updated)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -49,9 +56,18 @@ func (s *storageClassSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Obje
 }
 
 func (s *storageClassSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
-	newStorageClass := s.translate(ctx.Context, vObj.(*storagev1.StorageClass))
+	// mapped storage classes already exist in the host cluster
+	if _, ok := s.mappings.ByName[vObj.GetName()]; ok {
+		return ctrl.Result{}, nil
+	}
+
+	newStorageClass, err := s.translate(ctx, vObj.(*storagev1.StorageClass))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	ctx.Log.Infof("create physical storage class %s", newStorageClass.Name)
-	err := ctx.PhysicalClient.Create(ctx.Context, newStorageClass)
+	err = ctx.PhysicalClient.Create(ctx.Context, newStorageClass)
 	if err != nil {
 		ctx.Log.Infof("error syncing %s to physical cluster: %v", vObj.GetName(), err)
 		return ctrl.Result{}, err
@@ -3,6 +3,7 @@ package storageclasses
 import (
 	"testing"
 
+	"github.com/loft-sh/vcluster/pkg/config"
 	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	"gotest.tools/assert"
@@ -97,3 +98,97 @@ func TestSync(t *testing.T) {
 		},
 	})
 }
+
+func TestHostSyncMappings(t *testing.T) {
+	pMappedObject := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "host-fast",
+			Annotations: map[string]string{
+				DefaultStorageClassAnnotation: "false",
+			},
+		},
+		Provisioner: "my-provisioner",
+	}
+	vMappedObject := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fast",
+			Annotations: map[string]string{
+				DefaultStorageClassAnnotation: "true",
+			},
+		},
+		Provisioner: "my-provisioner",
+	}
+	pUnmappedObject := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "host-slow",
+			Annotations: map[string]string{
+				DefaultStorageClassAnnotation: "true",
+			},
+		},
+		Provisioner: "my-provisioner",
+	}
+	vStaleObject := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fast",
+		},
+		Provisioner: "old-provisioner",
+	}
+	adjustMappings := func(vConfig *config.VirtualClusterConfig) {
+		vConfig.Sync.ToHost.StorageClasses.Enabled = false
+		vConfig.Sync.FromHost.StorageClasses.Enabled = "true"
+		vConfig.Sync.FromHost.StorageClasses.Mappings.ByName = map[string]string{"fast": "host-fast"}
+		vConfig.Sync.FromHost.StorageClasses.Mappings.Default = "host-fast"
+	}
+
+	generictesting.RunTests(t, []*generictesting.SyncTest{
+		{
+			Name:                 "Sync Up mapped",
+			InitialPhysicalState: []runtime.Object{pMappedObject},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				storagev1.SchemeGroupVersion.WithKind("StorageClass"): {vMappedObject},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				storagev1.SchemeGroupVersion.WithKind("StorageClass"): {pMappedObject},
+			},
+			AdjustConfig: adjustMappings,
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, ctx, NewHostStorageClassSyncer)
+				_, err := syncer.(*hostStorageClassSyncer).SyncToVirtual(syncCtx, pMappedObject)
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Sync Up unmapped",
+			InitialPhysicalState: []runtime.Object{pUnmappedObject},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				storagev1.SchemeGroupVersion.WithKind("StorageClass"): {},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				storagev1.SchemeGroupVersion.WithKind("StorageClass"): {pUnmappedObject},
+			},
+			AdjustConfig: adjustMappings,
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, ctx, NewHostStorageClassSyncer)
+				_, err := syncer.(*hostStorageClassSyncer).SyncToVirtual(syncCtx, pUnmappedObject)
+				assert.NilError(t, err)
+			},
+		},
+		{
+			Name:                 "Sync mapped",
+			InitialVirtualState:  []runtime.Object{vStaleObject},
+			InitialPhysicalState: []runtime.Object{pMappedObject},
+			ExpectedVirtualState: map[schema.GroupVersionKind][]runtime.Object{
+				storagev1.SchemeGroupVersion.WithKind("StorageClass"): {vMappedObject},
+			},
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				storagev1.SchemeGroupVersion.WithKind("StorageClass"): {pMappedObject},
+			},
+			AdjustConfig: adjustMappings,
+			Sync: func(ctx *synccontext.RegisterContext) {
+				syncCtx, syncer := generictesting.FakeStartSyncer(t, ctx, NewHostStorageClassSyncer)
+				_, err := syncer.(*hostStorageClassSyncer).Sync(syncCtx, pMappedObject, vStaleObject.DeepCopy())
+				assert.NilError(t, err)
+			},
+		},
+	})
+}
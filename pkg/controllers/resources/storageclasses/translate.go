@@ -1,21 +1,21 @@
 package storageclasses
 
 import (
-	"context"
-
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 )
 
-func (s *storageClassSyncer) translate(ctx context.Context, vStorageClass *storagev1.StorageClass) *storagev1.StorageClass {
-	return s.TranslateMetadata(ctx, vStorageClass).(*storagev1.StorageClass)
+func (s *storageClassSyncer) translate(ctx *synccontext.SyncContext, vStorageClass *storagev1.StorageClass) (*storagev1.StorageClass, error) {
+	pStorageClass := s.TranslateMetadata(ctx.Context, vStorageClass).(*storagev1.StorageClass)
+	return pStorageClass, translator.ApplyToHostPatches(ctx, vStorageClass, pStorageClass)
 }
 
-func (s *storageClassSyncer) translateUpdate(ctx context.Context, pObj, vObj *storagev1.StorageClass) *storagev1.StorageClass {
+func (s *storageClassSyncer) translateUpdate(ctx *synccontext.SyncContext, pObj, vObj *storagev1.StorageClass) (*storagev1.StorageClass, error) {
 	var updated *storagev1.StorageClass
 
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vObj, pObj)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vObj, pObj)
 	if changed {
 		updated = translator.NewIfNil(updated, pObj)
 		updated.Labels = updatedLabels
@@ -57,5 +57,5 @@ func (s *storageClassSyncer) translateUpdate(ctx context.Context, pObj, vObj *st
 		updated.AllowedTopologies = vObj.AllowedTopologies
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vObj, pObj, updated)
 }
@@ -148,8 +148,10 @@ func (s *volumeSnapshotSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Ob
 	}
 
 	// forward update
-	updated = s.translateUpdate(ctx.Context, pVS, vVS)
-	if updated != nil {
+	updated, err := s.translateUpdate(ctx, pVS, vVS)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if updated != nil {
 		translator.PrintChanges(pVS, updated, ctx.Log)
 	}
 
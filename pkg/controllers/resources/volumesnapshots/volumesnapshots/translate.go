@@ -1,7 +1,6 @@
 package volumesnapshots
 
 import (
-	"context"
 	"fmt"
 
 	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
@@ -34,10 +33,10 @@ func (s *volumeSnapshotSyncer) translate(ctx *synccontext.SyncContext, vVS *volu
 	}
 
 	pVS.Spec.VolumeSnapshotClassName = vVS.Spec.VolumeSnapshotClassName
-	return pVS, nil
+	return pVS, translator.ApplyToHostPatches(ctx, vVS, pVS)
 }
 
-func (s *volumeSnapshotSyncer) translateUpdate(ctx context.Context, pVS, vVS *volumesnapshotv1.VolumeSnapshot) *volumesnapshotv1.VolumeSnapshot {
+func (s *volumeSnapshotSyncer) translateUpdate(ctx *synccontext.SyncContext, pVS, vVS *volumesnapshotv1.VolumeSnapshot) (*volumesnapshotv1.VolumeSnapshot, error) {
 	var updated *volumesnapshotv1.VolumeSnapshot
 
 	// snapshot class can be updated
@@ -47,14 +46,14 @@ func (s *volumeSnapshotSyncer) translateUpdate(ctx context.Context, pVS, vVS *vo
 	}
 
 	// check if metadata changed
-	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx, vVS, pVS)
+	changed, updatedAnnotations, updatedLabels := s.TranslateMetadataUpdate(ctx.Context, vVS, pVS)
 	if changed {
 		updated = translator.NewIfNil(updated, pVS)
 		updated.Annotations = updatedAnnotations
 		updated.Labels = updatedLabels
 	}
 
-	return updated
+	return translator.ApplyToHostPatchesUpdate(ctx, vVS, pVS, updated)
 }
 
 func (s *volumeSnapshotSyncer) translateUpdateBackwards(pObj, vObj *volumesnapshotv1.VolumeSnapshot) *volumesnapshotv1.VolumeSnapshot {
@@ -3,6 +3,7 @@ package context
 import (
 	"context"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/config"
 	"github.com/loft-sh/vcluster/pkg/util/loghelper"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -27,6 +28,12 @@ type SyncContext struct {
 	CurrentNamespaceClient client.Client
 
 	EventSource EventSource
+
+	// ToHostPatches are the sync.toHost patches configured for the synced resource
+	ToHostPatches []*vclusterconfig.Patch
+
+	// FromHostPatches are the sync.fromHost patches configured for the synced resource
+	FromHostPatches []*vclusterconfig.Patch
 }
 
 // Cast returns the given objects as types as well as
@@ -0,0 +1,163 @@
+package syncer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "vcluster"
+	metricsSubsystem = "syncer"
+)
+
+const (
+	OperationSyncToHost    = "sync_to_host"
+	OperationSync          = "sync"
+	OperationSyncToVirtual = "sync_to_virtual"
+	OperationDelete        = "delete"
+	OperationNone          = "none"
+)
+
+var (
+	reconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "reconcile_total",
+		Help:      "Total number of reconciliations per syncer, event source and operation.",
+	}, []string{"syncer", "event_source", "operation"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of reconciliations per syncer and operation.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"syncer", "operation"})
+
+	reconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "reconcile_errors_total",
+		Help:      "Total number of reconcile errors per syncer and reason.",
+	}, []string{"syncer", "reason"})
+
+	queueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "queue_depth",
+		Help:      "Current number of requests waiting in the queue of a syncer.",
+	}, []string{"syncer"})
+
+	objectsManaged = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "objects_managed",
+		Help:      "Current number of host objects managed by a syncer.",
+	}, []string{"syncer"})
+
+	uidMismatchDeletions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "uid_mismatch_deletions_total",
+		Help:      "Total number of host objects deleted because the virtual object uid differed.",
+	}, []string{"syncer"})
+
+	consecutiveErrors = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "consecutive_errors",
+		Help:      "Number of reconcile errors of a syncer since its last successful reconciliation. A growing value indicates an unhealthy syncer.",
+	}, []string{"syncer"})
+
+	lastSuccessfulReconcile = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "last_successful_reconcile_timestamp_seconds",
+		Help:      "Unix timestamp of the last successful reconciliation of a syncer.",
+	}, []string{"syncer"})
+)
+
+func init() {
+	// the controller-runtime registry is served on the host and virtual metrics bind address
+	metrics.Registry.MustRegister(
+		reconcileTotal,
+		reconcileDuration,
+		reconcileErrors,
+		queueDepth,
+		objectsManaged,
+		uidMismatchDeletions,
+		consecutiveErrors,
+		lastSuccessfulReconcile,
+	)
+}
+
+// syncerMetrics records the metrics of a single syncer
+type syncerMetrics struct {
+	name string
+
+	m                 sync.Mutex
+	consecutiveErrors int
+	managedObjects    map[types.NamespacedName]struct{}
+}
+
+func newSyncerMetrics(name string) *syncerMetrics {
+	return &syncerMetrics{
+		name:           name,
+		managedObjects: map[types.NamespacedName]struct{}{},
+	}
+}
+
+// observeReconcile records a finished reconciliation
+func (s *syncerMetrics) observeReconcile(eventSource, operation string, start time.Time, err error) {
+	reconcileTotal.WithLabelValues(s.name, eventSource, operation).Inc()
+	reconcileDuration.WithLabelValues(s.name, operation).Observe(time.Since(start).Seconds())
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if err != nil {
+		reconcileErrors.WithLabelValues(s.name, errorReason(err)).Inc()
+		s.consecutiveErrors++
+	} else {
+		s.consecutiveErrors = 0
+		lastSuccessfulReconcile.WithLabelValues(s.name).SetToCurrentTime()
+	}
+	consecutiveErrors.WithLabelValues(s.name).Set(float64(s.consecutiveErrors))
+}
+
+// observeQueueDepth records the current length of the syncer queue
+func (s *syncerMetrics) observeQueueDepth(length int) {
+	queueDepth.WithLabelValues(s.name).Set(float64(length))
+}
+
+// observeManagedObject tracks a managed host object
+func (s *syncerMetrics) observeManagedObject(name types.NamespacedName, isDelete bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if isDelete {
+		delete(s.managedObjects, name)
+	} else {
+		s.managedObjects[name] = struct{}{}
+	}
+	objectsManaged.WithLabelValues(s.name).Set(float64(len(s.managedObjects)))
+}
+
+// observeUIDMismatchDeletion records a host object deletion because of a different virtual object uid
+func (s *syncerMetrics) observeUIDMismatchDeletion() {
+	uidMismatchDeletions.WithLabelValues(s.name).Inc()
+}
+
+func errorReason(err error) string {
+	reason := kerrors.ReasonForError(err)
+	if reason == "" {
+		return "Unknown"
+	}
+
+	return string(reason)
+}
@@ -0,0 +1,35 @@
+package syncer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/v3/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSyncerMetrics(t *testing.T) {
+	m := newSyncerMetrics("metrics-test")
+
+	// consecutive errors are reset after a successful reconcile
+	m.observeReconcile("virtual", OperationSync, time.Now(), errors.New("boom"))
+	m.observeReconcile("virtual", OperationSync, time.Now(), kerrors.NewConflict(schema.GroupResource{Resource: "pods"}, "test", errors.New("conflict")))
+	assert.Equal(t, testutil.ToFloat64(consecutiveErrors.WithLabelValues("metrics-test")), float64(2))
+	assert.Equal(t, testutil.ToFloat64(reconcileErrors.WithLabelValues("metrics-test", "Unknown")), float64(1))
+	assert.Equal(t, testutil.ToFloat64(reconcileErrors.WithLabelValues("metrics-test", "Conflict")), float64(1))
+	m.observeReconcile("host", OperationSyncToHost, time.Now(), nil)
+	assert.Equal(t, testutil.ToFloat64(consecutiveErrors.WithLabelValues("metrics-test")), float64(0))
+	assert.Equal(t, testutil.ToFloat64(reconcileTotal.WithLabelValues("metrics-test", "virtual", OperationSync)), float64(2))
+
+	// managed objects are counted once
+	m.observeManagedObject(types.NamespacedName{Namespace: "test", Name: "a"}, false)
+	m.observeManagedObject(types.NamespacedName{Namespace: "test", Name: "a"}, false)
+	m.observeManagedObject(types.NamespacedName{Namespace: "test", Name: "b"}, false)
+	assert.Equal(t, testutil.ToFloat64(objectsManaged.WithLabelValues("metrics-test")), float64(2))
+	m.observeManagedObject(types.NamespacedName{Namespace: "test", Name: "a"}, true)
+	assert.Equal(t, testutil.ToFloat64(objectsManaged.WithLabelValues("metrics-test")), float64(1))
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/constants"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
 	"github.com/moby/locker"
@@ -23,6 +25,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -35,6 +38,14 @@ func NewSyncController(ctx *synccontext.RegisterContext, syncer syncertypes.Sync
 		options = optionsProvider.WithOptions()
 	}
 
+	// find the sync patches configured for the synced resource
+	var toHostPatches, fromHostPatches []*vclusterconfig.Patch
+	gvk, err := apiutil.GVKForObject(syncer.Resource(), ctx.VirtualManager.GetScheme())
+	if err == nil && ctx.Config != nil {
+		toHostPatches = ctx.Config.ToHostPatches()[gvk.GroupKind()]
+		fromHostPatches = ctx.Config.FromHostPatches()[gvk.GroupKind()]
+	}
+
 	return &SyncController{
 		syncer:         syncer,
 		log:            loghelper.New(syncer.Name()),
@@ -47,7 +58,11 @@ func NewSyncController(ctx *synccontext.RegisterContext, syncer syncertypes.Sync
 		virtualClient: ctx.VirtualManager.GetClient(),
 		options:       options,
 
-		locker: locker.New(),
+		toHostPatches:   toHostPatches,
+		fromHostPatches: fromHostPatches,
+
+		locker:  locker.New(),
+		metrics: newSyncerMetrics(syncer.Name()),
 	}
 }
 
@@ -69,10 +84,32 @@ type SyncController struct {
 	virtualClient client.Client
 	options       *syncertypes.Options
 
+	toHostPatches   []*vclusterconfig.Patch
+	fromHostPatches []*vclusterconfig.Patch
+
 	locker *locker.Locker
+
+	metrics *syncerMetrics
+	queue   atomic.Pointer[workqueue.RateLimitingInterface]
 }
 
 func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_ ctrl.Result, err error) {
+	// determine event source
+	eventSource := synccontext.EventSourceVirtual
+	if isHostRequest(origReq) {
+		eventSource = synccontext.EventSourceHost
+	}
+
+	// record metrics
+	start := time.Now()
+	operation := OperationNone
+	defer func() {
+		r.metrics.observeReconcile(string(eventSource), operation, start, err)
+		if queue := r.queue.Load(); queue != nil {
+			r.metrics.observeQueueDepth((*queue).Len())
+		}
+	}()
+
 	// if host request we need to find the virtual object
 	vReq, pReq, err := r.extractRequest(ctx, origReq)
 	if err != nil {
@@ -90,12 +127,6 @@ func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_
 		_ = r.locker.Unlock(vReq.String())
 	}()
 
-	// determine event source
-	eventSource := synccontext.EventSourceVirtual
-	if isHostRequest(origReq) {
-		eventSource = synccontext.EventSourceHost
-	}
-
 	// create sync context
 	log := loghelper.NewFromExisting(r.log.Base(), vReq.Name)
 	syncContext := &synccontext.SyncContext{
@@ -106,6 +137,8 @@ func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_
 		CurrentNamespaceClient: r.currentNamespaceClient,
 		VirtualClient:          r.virtualClient,
 		EventSource:            eventSource,
+		ToHostPatches:          r.toHostPatches,
+		FromHostPatches:        r.fromHostPatches,
 	}
 
 	// check if we should skip reconcile
@@ -126,6 +159,7 @@ func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_
 
 	// check what function we should call
 	if vObj != nil && pObj == nil {
+		operation = OperationSyncToHost
 		return r.syncer.SyncToHost(syncContext, vObj)
 	} else if vObj != nil && pObj != nil {
 		// make sure the object uid matches
@@ -137,9 +171,12 @@ func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_
 			}
 
 			// delete physical object
+			operation = OperationDelete
+			r.metrics.observeUIDMismatchDeletion()
 			return DeleteObject(syncContext, pObj, "virtual object uid is different")
 		}
 
+		operation = OperationSync
 		return r.syncer.Sync(syncContext, pObj, vObj)
 	} else if pObj != nil {
 		if pObj.GetAnnotations() != nil {
@@ -152,9 +189,11 @@ func (r *SyncController) Reconcile(ctx context.Context, origReq ctrl.Request) (_
 		// check if virtual syncer
 		toVirtual, ok := r.syncer.(syncertypes.ToVirtualSyncer)
 		if ok {
+			operation = OperationSyncToVirtual
 			return toVirtual.SyncToVirtual(syncContext, pObj)
 		}
 
+		operation = OperationDelete
 		return DeleteObject(syncContext, pObj, "virtual object was deleted")
 	}
 
@@ -338,6 +377,7 @@ func (r *SyncController) enqueueVirtual(ctx context.Context, obj client.Object,
 	if obj == nil {
 		return
 	}
+	r.queue.CompareAndSwap(nil, &q)
 
 	// add a new request for the host object as otherwise this information might be lost after a delete event
 	if isDelete {
@@ -371,6 +411,8 @@ func (r *SyncController) enqueuePhysical(ctx context.Context, obj client.Object,
 	} else if !managed {
 		return
 	}
+	r.queue.CompareAndSwap(nil, &q)
+	r.metrics.observeManagedObject(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, isDelete)
 
 	// add a new request for the virtual object as otherwise this information might be lost after a delete event
 	if isDelete {
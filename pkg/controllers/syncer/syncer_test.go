@@ -6,6 +6,7 @@ import (
 	"sort"
 	"testing"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/scheme"
 	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
 	"github.com/loft-sh/vcluster/pkg/util/translate"
@@ -39,6 +40,9 @@ func NewMockSyncer(ctx *synccontext.RegisterContext) (syncertypes.Object, error)
 
 func (s *mockSyncer) naiveTranslateCreate(ctx *synccontext.SyncContext, vObj client.Object) client.Object {
 	pObj := s.TranslateMetadata(ctx.Context, vObj)
+	if translator.ApplyToHostPatches(ctx, vObj, pObj) != nil {
+		return nil
+	}
 	return pObj
 }
 
@@ -91,6 +95,8 @@ func TestReconcile(t *testing.T) {
 
 		Compare generictesting.Compare
 
+		ToHostPatches []*vclusterconfig.Patch
+
 		shouldErr bool
 		errMsg    string
 	}
@@ -244,6 +250,59 @@ func TestReconcile(t *testing.T) {
 			shouldErr: true,
 			errMsg:    "conflict: cannot sync virtual object default/a as unmanaged physical object test/a-x-default-x-suffix exists with desired name",
 		},
+		{
+			Name:   "should apply sync patches",
+			Syncer: NewMockSyncer,
+
+			EnqueObjs: []types.NamespacedName{
+				{Name: "a", Namespace: namespaceInVclusterA},
+			},
+
+			InitialVirtualState: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "a",
+						Namespace: namespaceInVclusterA,
+						UID:       "123",
+					},
+				},
+			},
+
+			ToHostPatches: []*vclusterconfig.Patch{
+				{
+					Operation: vclusterconfig.PatchTypeAdd,
+					Path:      "metadata.labels.team",
+					Value:     "a",
+				},
+				{
+					Operation:  vclusterconfig.PatchTypeExpression,
+					Path:       "metadata.annotations.origin",
+					Expression: `source.metadata.namespace + "/" + source.metadata.name`,
+				},
+			},
+
+			ExpectedPhysicalState: map[schema.GroupVersionKind][]runtime.Object{
+				corev1.SchemeGroupVersion.WithKind("Secret"): {
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      translator.PhysicalName("a", namespaceInVclusterA),
+							Namespace: vclusterNamespace,
+							Annotations: map[string]string{
+								translate.NameAnnotation:      "a",
+								translate.NamespaceAnnotation: namespaceInVclusterA,
+								translate.UIDAnnotation:       "123",
+								translate.KindAnnotation:      corev1.SchemeGroupVersion.WithKind("Secret").String(),
+								"origin":                      namespaceInVclusterA + "/a",
+							},
+							Labels: map[string]string{
+								translate.NamespaceLabel: namespaceInVclusterA,
+								"team":                   "a",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	sort.SliceStable(testCases, func(i, j int) bool {
 		// place focused tests first
@@ -288,7 +347,10 @@ func TestReconcile(t *testing.T) {
 			virtualClient: vClient,
 			options:       options,
 
-			locker: locker.New(),
+			toHostPatches: tc.ToHostPatches,
+
+			locker:  locker.New(),
+			metrics: newSyncerMetrics(syncer.Name()),
 		}
 
 		// execute
@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
 const (
@@ -45,6 +46,13 @@ func FakeStartSyncer(t *testing.T, ctx *synccontext.RegisterContext, create func
 
 	syncCtx := synccontext.ConvertContext(ctx, object.Name())
 	syncCtx.Log = loghelper.NewFromExisting(log.NewLog(0), object.Name())
+
+	// the sync controller passes the sync patches configured for the synced resource
+	gvk, err := apiutil.GVKForObject(object.Resource(), ctx.VirtualManager.GetScheme())
+	if err == nil && ctx.Config != nil {
+		syncCtx.ToHostPatches = ctx.Config.ToHostPatches()[gvk.GroupKind()]
+		syncCtx.FromHostPatches = ctx.Config.FromHostPatches()[gvk.GroupKind()]
+	}
 	return syncCtx, object
 }
 
@@ -3,6 +3,7 @@ package translator
 import (
 	"context"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,3 +55,74 @@ func (n *mirrorPhysicalTranslator) VirtualToHost(_ context.Context, req types.Na
 func (n *mirrorPhysicalTranslator) HostToVirtual(_ context.Context, req types.NamespacedName, _ client.Object) types.NamespacedName {
 	return req
 }
+
+// NewMappedPhysicalTranslator mirrors physical objects into the virtual cluster, but renames the objects according
+// to the given mappings from virtual to physical names. Objects that are not mapped keep their name.
+func NewMappedPhysicalTranslator(name string, obj client.Object, mappings map[string]string) Translator {
+	reverseMappings := map[string]string{}
+	for virtualName, physicalName := range mappings {
+		reverseMappings[physicalName] = virtualName
+	}
+
+	return &mappedPhysicalTranslator{
+		mirrorPhysicalTranslator: mirrorPhysicalTranslator{
+			name: name,
+			obj:  obj,
+		},
+
+		mappings:        mappings,
+		reverseMappings: reverseMappings,
+	}
+}
+
+type mappedPhysicalTranslator struct {
+	mirrorPhysicalTranslator
+
+	mappings        map[string]string
+	reverseMappings map[string]string
+}
+
+func (n *mappedPhysicalTranslator) TranslateMetadata(ctx context.Context, pObj client.Object) client.Object {
+	vObj := n.mirrorPhysicalTranslator.TranslateMetadata(ctx, pObj)
+	vObj.SetName(n.HostToVirtual(ctx, types.NamespacedName{Name: pObj.GetName()}, pObj).Name)
+	return vObj
+}
+
+func (n *mappedPhysicalTranslator) VirtualToHost(_ context.Context, req types.NamespacedName, _ client.Object) types.NamespacedName {
+	if physicalName, ok := n.mappings[req.Name]; ok {
+		return types.NamespacedName{Namespace: req.Namespace, Name: physicalName}
+	}
+
+	return req
+}
+
+func (n *mappedPhysicalTranslator) HostToVirtual(_ context.Context, req types.NamespacedName, _ client.Object) types.NamespacedName {
+	if virtualName, ok := n.reverseMappings[req.Name]; ok {
+		return types.NamespacedName{Namespace: req.Namespace, Name: virtualName}
+	}
+
+	return req
+}
+
+// TranslateDefaultClassAnnotation marks an imported host class as default within the virtual cluster if it is the
+// configured default class of the mappings and removes the given default annotation otherwise
+func TranslateDefaultClassAnnotation(mappings vclusterconfig.ClassMappings, pName, defaultAnnotation string, annotations map[string]string) map[string]string {
+	if mappings.Default == "" {
+		return annotations
+	}
+
+	newAnnotations := map[string]string{}
+	for k, v := range annotations {
+		newAnnotations[k] = v
+	}
+	if pName == mappings.Default {
+		newAnnotations[defaultAnnotation] = "true"
+	} else {
+		delete(newAnnotations, defaultAnnotation)
+	}
+	if len(newAnnotations) == 0 {
+		return nil
+	}
+
+	return newAnnotations
+}
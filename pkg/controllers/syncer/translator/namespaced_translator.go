@@ -73,31 +73,41 @@ func (n *namespacedTranslator) RegisterIndices(ctx *context.RegisterContext) err
 }
 
 func (n *namespacedTranslator) SyncToHostCreate(ctx *context.SyncContext, vObj, pObj client.Object) (ctrl.Result, error) {
-	ctx.Log.Infof("create physical %s %s/%s", n.name, pObj.GetNamespace(), pObj.GetName())
+	return SyncToHostCreate(ctx, n.name, n.eventRecorder, vObj, pObj)
+}
+
+func (n *namespacedTranslator) SyncToHostUpdate(ctx *context.SyncContext, vObj, pObj client.Object) (ctrl.Result, error) {
+	return SyncToHostUpdate(ctx, n.name, n.eventRecorder, vObj, pObj)
+}
+
+// SyncToHostCreate creates the host object and records an event on the virtual object if that fails
+func SyncToHostCreate(ctx *context.SyncContext, name string, eventRecorder record.EventRecorder, vObj, pObj client.Object) (ctrl.Result, error) {
+	ctx.Log.Infof("create physical %s %s", name, objectName(pObj))
 	err := ctx.PhysicalClient.Create(ctx.Context, pObj)
 	if err != nil {
 		if kerrors.IsNotFound(err) {
-			ctx.Log.Debugf("error syncing %s %s/%s to physical cluster: %v", n.name, vObj.GetNamespace(), vObj.GetName(), err)
+			ctx.Log.Debugf("error syncing %s %s to physical cluster: %v", name, objectName(vObj), err)
 			return ctrl.Result{RequeueAfter: time.Second}, nil
 		}
-		ctx.Log.Infof("error syncing %s %s/%s to physical cluster: %v", n.name, vObj.GetNamespace(), vObj.GetName(), err)
-		n.eventRecorder.Eventf(vObj, "Warning", "SyncError", "Error syncing to physical cluster: %v", err)
+		ctx.Log.Infof("error syncing %s %s to physical cluster: %v", name, objectName(vObj), err)
+		eventRecorder.Eventf(vObj, "Warning", "SyncError", "Error syncing to physical cluster: %v", err)
 		return ctrl.Result{}, err
 	}
 
 	return ctrl.Result{}, nil
 }
 
-func (n *namespacedTranslator) SyncToHostUpdate(ctx *context.SyncContext, vObj, pObj client.Object) (ctrl.Result, error) {
+// SyncToHostUpdate updates the host object if there is one and records an event on the virtual object if that fails
+func SyncToHostUpdate(ctx *context.SyncContext, name string, eventRecorder record.EventRecorder, vObj, pObj client.Object) (ctrl.Result, error) {
 	// this is needed because of interface nil check
 	if !(pObj == nil || (reflect.ValueOf(pObj).Kind() == reflect.Ptr && reflect.ValueOf(pObj).IsNil())) {
-		ctx.Log.Infof("updating physical %s/%s, because virtual %s have changed", pObj.GetNamespace(), pObj.GetName(), n.name)
+		ctx.Log.Infof("updating physical %s, because virtual %s have changed", objectName(pObj), name)
 		err := ctx.PhysicalClient.Update(ctx.Context, pObj)
 		if kerrors.IsConflict(err) {
-			ctx.Log.Debugf("conflict syncing physical %s %s/%s", n.name, pObj.GetNamespace(), pObj.GetName())
+			ctx.Log.Debugf("conflict syncing physical %s %s", name, objectName(pObj))
 			return ctrl.Result{Requeue: true}, nil
 		} else if err != nil {
-			n.eventRecorder.Eventf(vObj, "Warning", "SyncError", "Error syncing to physical cluster: %v", err)
+			eventRecorder.Eventf(vObj, "Warning", "SyncError", "Error syncing to physical cluster: %v", err)
 			return ctrl.Result{}, err
 		}
 	}
@@ -0,0 +1,90 @@
+package translator
+
+import (
+	"fmt"
+	"reflect"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/patches"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ApplyToHostPatches applies the configured sync.toHost patches to the translated host object. Syncers call this
+// in their translate step, so the patched object is what gets compared against the existing host object.
+func ApplyToHostPatches(ctx *context.SyncContext, vObj, pObj client.Object) error {
+	if len(ctx.ToHostPatches) == 0 {
+		return nil
+	}
+
+	return applyPatches(pObj, vObj, ctx.ToHostPatches, patches.NewVirtualToHostNameResolver(vObj.GetNamespace(), vObj.GetNamespace() == ""))
+}
+
+// ApplyFromHostPatches applies the configured sync.fromHost patches to the translated virtual object
+func ApplyFromHostPatches(ctx *context.SyncContext, pObj, vObj client.Object) error {
+	if len(ctx.FromHostPatches) == 0 {
+		return nil
+	}
+
+	return applyPatches(vObj, pObj, ctx.FromHostPatches, patches.NewHostToVirtualNameResolver())
+}
+
+// ApplyToHostPatchesUpdate applies the configured sync.toHost patches to the updated host object or to a copy of
+// the existing host object if the syncer found no changes. It returns nil if the patched object doesn't differ
+// from the existing host object.
+func ApplyToHostPatchesUpdate[T any, P interface {
+	*T
+	client.Object
+}](ctx *context.SyncContext, vObj client.Object, pObj, updated P) (P, error) {
+	if len(ctx.ToHostPatches) == 0 {
+		return updated, nil
+	}
+
+	return applyPatchesUpdate(pObj, updated, func(obj client.Object) error {
+		return ApplyToHostPatches(ctx, vObj, obj)
+	})
+}
+
+// ApplyFromHostPatchesUpdate applies the configured sync.fromHost patches to the updated virtual object or to a
+// copy of the existing virtual object if the syncer found no changes. It returns nil if the patched object doesn't
+// differ from the existing virtual object.
+func ApplyFromHostPatchesUpdate[T any, P interface {
+	*T
+	client.Object
+}](ctx *context.SyncContext, pObj client.Object, vObj, updated P) (P, error) {
+	if len(ctx.FromHostPatches) == 0 {
+		return updated, nil
+	}
+
+	return applyPatchesUpdate(vObj, updated, func(obj client.Object) error {
+		return ApplyFromHostPatches(ctx, pObj, obj)
+	})
+}
+
+func applyPatchesUpdate[T any, P interface {
+	*T
+	client.Object
+}](existing, updated P, apply func(obj client.Object) error) (P, error) {
+	patched := NewIfNil(updated, existing)
+	err := apply(patched)
+	if err != nil {
+		return nil, err
+	} else if updated == nil && equality.Semantic.DeepEqual(patched, existing) {
+		return nil, nil
+	}
+
+	return patched, nil
+}
+
+func applyPatches(destObj, sourceObj client.Object, objPatches []*vclusterconfig.Patch, nameResolver patches.NameResolver) error {
+	// patch a copy, so destObj stays untouched if a patch fails
+	patched := destObj.DeepCopyObject().(client.Object)
+	err := patches.ApplyPatches(patched, sourceObj, objPatches, nil, nameResolver)
+	if err != nil {
+		return fmt.Errorf("apply sync patches to %s: %w", objectName(destObj), err)
+	}
+
+	reflect.ValueOf(destObj).Elem().Set(reflect.ValueOf(patched).Elem())
+	return nil
+}
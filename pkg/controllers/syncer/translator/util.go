@@ -27,3 +27,12 @@ func NewIfNil[T any, P interface {
 
 	return updated
 }
+
+// objectName returns namespace/name for namespaced objects and only the name for cluster scoped objects
+func objectName(obj client.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
@@ -0,0 +1,56 @@
+package dryrun
+
+import (
+	"strings"
+)
+
+// Diff returns a line based diff between from and to. Removed lines are prefixed with "-",
+// added lines with "+" and unchanged lines with a space.
+func Diff(from, to string) string {
+	fromLines := splitLines(from)
+	toLines := splitLines(to)
+
+	// compute the longest common subsequence table
+	lcs := make([][]int, len(fromLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(toLines)+1)
+	}
+	for i := len(fromLines) - 1; i >= 0; i-- {
+		for j := len(toLines) - 1; j >= 0; j-- {
+			if fromLines[i] == toLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	// walk the table and write the diff
+	out := &strings.Builder{}
+	i, j := 0, 0
+	for i < len(fromLines) || j < len(toLines) {
+		switch {
+		case i < len(fromLines) && j < len(toLines) && fromLines[i] == toLines[j]:
+			out.WriteString("  " + fromLines[i] + "\n")
+			i++
+			j++
+		case i < len(fromLines) && (j == len(toLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			out.WriteString("- " + fromLines[i] + "\n")
+			i++
+		default:
+			out.WriteString("+ " + toLines[j] + "\n")
+			j++
+		}
+	}
+
+	return out.String()
+}
+
+func splitLines(text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(text, "\n")
+}
@@ -0,0 +1,45 @@
+package dryrun
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     string
+		to       string
+		expected string
+	}{
+		{
+			name:     "equal",
+			from:     "a: 1\nb: 2\n",
+			to:       "a: 1\nb: 2\n",
+			expected: "  a: 1\n  b: 2\n",
+		},
+		{
+			name:     "changed line",
+			from:     "a: 1\nb: 2\nc: 3\n",
+			to:       "a: 1\nb: 3\nc: 3\n",
+			expected: "  a: 1\n- b: 2\n+ b: 3\n  c: 3\n",
+		},
+		{
+			name:     "added and removed lines",
+			from:     "a: 1\nb: 2\n",
+			to:       "b: 2\nc: 3\n",
+			expected: "- a: 1\n  b: 2\n+ c: 3\n",
+		},
+		{
+			name:     "empty from",
+			from:     "",
+			to:       "a: 1\n",
+			expected: "+ a: 1\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, Diff(testCase.from, testCase.to), testCase.expected, testCase.name)
+	}
+}
@@ -0,0 +1,218 @@
+package dryrun
+
+import (
+	"fmt"
+	"io"
+
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
+	"github.com/loft-sh/vcluster/pkg/util/dryrunclient"
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+// Result is the outcome of previewing the sync of a single virtual object
+type Result struct {
+	// Syncer is the name of the syncer that translated the object
+	Syncer string
+
+	// Virtual is the virtual object that was previewed
+	Virtual client.Object
+
+	// Host is the current host object or nil if it does not exist yet
+	Host client.Object
+
+	// Changes are the writes the syncer would have done
+	Changes []dryrunclient.Change
+}
+
+// Preview runs the syncer responsible for the given virtual object and records the changes it would
+// write to the host and virtual cluster. The register context clients are expected to be wrapped
+// with the dry run client of the given recorder.
+func Preview(ctx *synccontext.RegisterContext, recorder *dryrunclient.Recorder, syncers []syncertypes.Object, vManifest *unstructured.Unstructured) (*Result, error) {
+	gvk := vManifest.GroupVersionKind()
+	syncer, err := findSyncer(syncers, vManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	// convert the manifest into the syncer object
+	vObj := syncer.Resource()
+	if _, ok := vObj.(*unstructured.Unstructured); ok {
+		vObj = vManifest.DeepCopy()
+	} else {
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(vManifest.Object, vObj)
+		if err != nil {
+			return nil, fmt.Errorf("convert %s %s: %w", gvk.Kind, vManifest.GetName(), err)
+		}
+	}
+
+	// default the namespace
+	mapping, err := ctx.VirtualManager.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("find resource for %s: %w", gvk.String(), err)
+	} else if mapping.Scope.Name() == meta.RESTScopeNameNamespace && vObj.GetNamespace() == "" {
+		vObj.SetNamespace("default")
+	}
+
+	result := &Result{
+		Syncer:  syncer.Name(),
+		Virtual: vObj,
+	}
+	if excludeVirtual(syncer, vObj) {
+		return result, nil
+	}
+
+	syncContext := &synccontext.SyncContext{
+		Context:                ctx.Context,
+		Log:                    loghelper.New(syncer.Name()),
+		PhysicalClient:         ctx.PhysicalManager.GetClient(),
+		CurrentNamespace:       ctx.CurrentNamespace,
+		CurrentNamespaceClient: ctx.CurrentNamespaceClient,
+		VirtualClient:          ctx.VirtualManager.GetClient(),
+		EventSource:            synccontext.EventSourceVirtual,
+	}
+
+	// get the current host object
+	pObj := syncer.Resource()
+	pName := syncer.VirtualToHost(ctx.Context, types.NamespacedName{Namespace: vObj.GetNamespace(), Name: vObj.GetName()}, vObj)
+	err = syncContext.PhysicalClient.Get(ctx.Context, pName, pObj)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("get host object %s: %w", pName.String(), err)
+		}
+
+		pObj = nil
+	} else {
+		isManaged, err := syncer.IsManaged(ctx.Context, pObj)
+		if err != nil {
+			return nil, fmt.Errorf("check if host object %s is managed: %w", pName.String(), err)
+		} else if !isManaged {
+			return nil, fmt.Errorf("conflict: cannot sync virtual object %s/%s as unmanaged host object %s exists with desired name", vObj.GetNamespace(), vObj.GetName(), pName.String())
+		}
+
+		result.Host = pObj.DeepCopyObject().(client.Object)
+		if hostGVK, err := apiutil.GVKForObject(result.Host, scheme.Scheme); err == nil {
+			result.Host.GetObjectKind().SetGroupVersionKind(hostGVK)
+		}
+	}
+
+	// run the syncer
+	recorder.Reset()
+	if pObj == nil {
+		_, err = syncer.SyncToHost(syncContext, vObj.DeepCopyObject().(client.Object))
+	} else {
+		_, err = syncer.Sync(syncContext, pObj, vObj.DeepCopyObject().(client.Object))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sync %s %s/%s: %w", gvk.Kind, vObj.GetNamespace(), vObj.GetName(), err)
+	}
+
+	result.Changes = recorder.Changes()
+	return result, nil
+}
+
+// Print writes the host object that would be produced and the diff against the current host object
+func (r *Result) Print(w io.Writer) error {
+	gvk, err := apiutil.GVKForObject(r.Virtual, scheme.Scheme)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(w, "# %s %s (syncer %s)\n", gvk.Kind, objectName(r.Virtual), r.Syncer)
+	if len(r.Changes) == 0 {
+		_, _ = fmt.Fprintf(w, "# no changes\n")
+		return nil
+	}
+
+	current := ""
+	if r.Host != nil {
+		current, err = toYAML(r.Host)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, change := range r.Changes {
+		target := objectName(change.Object)
+		if change.SubResource != "" {
+			target += " (" + change.SubResource + ")"
+		}
+		_, _ = fmt.Fprintf(w, "# would %s %s %s %s\n", change.Operation, change.Cluster, change.Object.GetObjectKind().GroupVersionKind().Kind, target)
+
+		// we only print the contents of host objects
+		if change.Cluster != dryrunclient.ClusterHost || change.Operation == dryrunclient.OperationDelete || change.Operation == dryrunclient.OperationDeleteAllOf {
+			continue
+		}
+
+		desired, err := toYAML(change.Object)
+		if err != nil {
+			return err
+		}
+		if current == "" {
+			_, _ = fmt.Fprint(w, desired)
+			continue
+		}
+
+		_, _ = fmt.Fprint(w, Diff(current, desired))
+	}
+
+	return nil
+}
+
+func findSyncer(syncers []syncertypes.Object, vObj *unstructured.Unstructured) (syncertypes.Syncer, error) {
+	for _, s := range syncers {
+		syncer, ok := s.(syncertypes.Syncer)
+		if !ok {
+			continue
+		}
+
+		gvk, err := apiutil.GVKForObject(syncer.Resource(), scheme.Scheme)
+		if err != nil {
+			return nil, err
+		} else if gvk == vObj.GroupVersionKind() {
+			return syncer, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no syncer is enabled for %s", vObj.GroupVersionKind().String())
+}
+
+func excludeVirtual(syncer syncertypes.Syncer, vObj client.Object) bool {
+	excluder, ok := syncer.(syncertypes.ObjectExcluder)
+	if ok {
+		return excluder.ExcludeVirtual(vObj)
+	}
+
+	return vObj.GetLabels()[translate.ControllerLabel] != ""
+}
+
+func objectName(obj client.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// toYAML converts the object into yaml without managed fields
+func toYAML(obj client.Object) (string, error) {
+	obj = obj.DeepCopyObject().(client.Object)
+	obj.SetManagedFields(nil)
+
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,324 @@
+package metricsserver
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/apiservice"
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/server/filters"
+	"github.com/loft-sh/vcluster/pkg/server/handler"
+	requestpkg "github.com/loft-sh/vcluster/pkg/util/request"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	customMetricsHostPort   = 9002
+	externalMetricsHostPort = 9003
+
+	NamespaceMetricsResource = "metrics"
+)
+
+var (
+	CustomMetricsGroupVersions = []schema.GroupVersion{
+		{Group: "custom.metrics.k8s.io", Version: "v1beta1"},
+		{Group: "custom.metrics.k8s.io", Version: "v1beta2"},
+	}
+
+	ExternalMetricsGroupVersion = schema.GroupVersion{
+		Group:   "external.metrics.k8s.io",
+		Version: "v1beta1",
+	}
+)
+
+func registerMetricsAPIs(ctx *config.ControllerContext) error {
+	customMetrics := ctx.Config.Integrations.MetricsServer.CustomMetrics
+	if customMetrics.Enabled {
+		err := startMetricsAPIProxy(ctx, customMetrics.APIService, customMetricsHostPort)
+		if err != nil {
+			return fmt.Errorf("start custom metrics api service proxy: %w", err)
+		}
+	}
+
+	externalMetrics := ctx.Config.Integrations.MetricsServer.ExternalMetrics
+	if externalMetrics.Enabled {
+		err := startMetricsAPIProxy(ctx, externalMetrics.APIService, externalMetricsHostPort)
+		if err != nil {
+			return fmt.Errorf("start external metrics api service proxy: %w", err)
+		}
+	}
+
+	if customMetrics.Enabled || externalMetrics.Enabled {
+		ctx.PostServerHooks = append(ctx.PostServerHooks, func(h http.Handler, clients config.Clients) http.Handler {
+			return WithMetricsAPIProxy(
+				h,
+				clients.CachedVirtualClient,
+				clients.HostConfig,
+				ctx.Config.Experimental.MultiNamespaceMode.Enabled,
+				customMetrics.Enabled,
+				externalMetrics.Enabled,
+			)
+		})
+	}
+
+	return nil
+}
+
+func startMetricsAPIProxy(ctx *config.ControllerContext, apiService vclusterconfig.APIService, hostPort int) error {
+	targetService := cmp.Or(apiService.Service.Name, "prometheus-adapter")
+	targetServiceNamespace := cmp.Or(apiService.Service.Namespace, "monitoring")
+	targetServicePort := cmp.Or(apiService.Service.Port, 443)
+	return apiservice.StartAPIServiceProxy(ctx, targetService, targetServiceNamespace, targetServicePort, hostPort)
+}
+
+func registerOrDeregisterMetricsAPIServices(ctx *config.ControllerContext) error {
+	for _, groupVersion := range CustomMetricsGroupVersions {
+		err := registerOrDeregisterAPIService(ctx, ctx.Config.Integrations.MetricsServer.CustomMetrics.Enabled, "custom-metrics-apiserver", customMetricsHostPort, groupVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	return registerOrDeregisterAPIService(ctx, ctx.Config.Integrations.MetricsServer.ExternalMetrics.Enabled, "external-metrics-apiserver", externalMetricsHostPort, ExternalMetricsGroupVersion)
+}
+
+func registerOrDeregisterAPIService(ctx *config.ControllerContext, enabled bool, serviceName string, hostPort int, groupVersion schema.GroupVersion) error {
+	if enabled {
+		return apiservice.RegisterAPIService(ctx, serviceName, hostPort, groupVersion)
+	}
+
+	return apiservice.DeregisterAPIService(ctx, groupVersion)
+}
+
+func WithMetricsAPIProxy(
+	h http.Handler,
+	cachedVirtualClient client.Client,
+	hostConfig *rest.Config,
+	multiNamespaceMode bool,
+	customMetrics bool,
+	externalMetrics bool,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, ok := request.RequestInfoFrom(req.Context())
+		if !ok {
+			requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, fmt.Errorf("request info is missing"))
+			return
+		}
+
+		if customMetrics && isCustomMetricsRequest(info) {
+			handleCustomMetricsRequest(w, req, info, cachedVirtualClient, hostConfig, multiNamespaceMode)
+			return
+		} else if externalMetrics && isExternalMetricsRequest(info) {
+			handleExternalMetricsRequest(w, req, info, hostConfig)
+			return
+		}
+
+		h.ServeHTTP(w, req)
+	})
+}
+
+func isCustomMetricsRequest(r *request.RequestInfo) bool {
+	return r.IsResourceRequest && r.APIGroup == CustomMetricsGroupVersions[0].Group
+}
+
+func isExternalMetricsRequest(r *request.RequestInfo) bool {
+	return r.IsResourceRequest && r.APIGroup == ExternalMetricsGroupVersion.Group
+}
+
+// handleExternalMetricsRequest proxies a request in the form of
+// /apis/external.metrics.k8s.io/v1beta1/namespaces/NAMESPACE/METRIC to the host cluster. The label
+// selector refers to the metric labels and is therefore not translated.
+func handleExternalMetricsRequest(w http.ResponseWriter, req *http.Request, info *request.RequestInfo, hostConfig *rest.Config) {
+	splitted := strings.Split(req.URL.Path, "/")
+	if info.Namespace == "" || len(splitted) < 7 {
+		requestpkg.FailWithStatus(w, req, http.StatusForbidden, fmt.Errorf("external metrics are only supported within a namespace"))
+		return
+	}
+
+	splitted[5] = translate.Default.PhysicalNamespace(info.Namespace)
+	req.URL.Path = strings.Join(splitted, "/")
+
+	proxyHandler, err := handler.Handler("", hostConfig, nil)
+	if err != nil {
+		requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+		return
+	}
+
+	req.Header.Del("Authorization")
+	proxyHandler.ServeHTTP(w, req)
+}
+
+// handleCustomMetricsRequest proxies requests in the form of
+// /apis/custom.metrics.k8s.io/VERSION/namespaces/NAMESPACE/RESOURCE/NAME/METRIC or
+// /apis/custom.metrics.k8s.io/VERSION/namespaces/NAMESPACE/metrics/METRIC to the host cluster and
+// translates the described objects of the returned metric values back.
+func handleCustomMetricsRequest(w http.ResponseWriter, req *http.Request, info *request.RequestInfo, cachedVirtualClient client.Client, hostConfig *rest.Config, multiNamespaceMode bool) {
+	splitted := strings.Split(req.URL.Path, "/")
+	if info.Namespace == "" || len(splitted) < 8 {
+		requestpkg.FailWithStatus(w, req, http.StatusForbidden, fmt.Errorf("cluster scoped custom metrics are not supported"))
+		return
+	}
+
+	// metrics of the namespace itself are only isolated if each virtual namespace has its own host namespace
+	if info.Resource == NamespaceMetricsResource && !multiNamespaceMode {
+		requestpkg.FailWithStatus(w, req, http.StatusForbidden, fmt.Errorf("namespace custom metrics are only supported in multi namespace mode"))
+		return
+	}
+
+	splitted[5] = translate.Default.PhysicalNamespace(info.Namespace)
+	if info.Resource != NamespaceMetricsResource {
+		if info.Name != "*" {
+			splitted[7] = translate.Default.PhysicalName(info.Name, info.Namespace)
+		}
+
+		err := translateCustomMetricsLabelSelector(req, info.Namespace)
+		if err != nil {
+			requestpkg.FailWithStatus(w, req, http.StatusBadRequest, err)
+			return
+		}
+	}
+	req.URL.Path = strings.Join(splitted, "/")
+
+	proxyHandler, err := handler.Handler("", hostConfig, nil)
+	if err != nil {
+		requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+		return
+	}
+
+	// custom metrics apis only serve json
+	req.Header.Del("Authorization")
+	req.Header.Set("Accept", "application/json")
+	code, header, data, err := filters.ExecuteRequest(req, proxyHandler)
+	if err != nil {
+		requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+		return
+	} else if code != http.StatusOK {
+		filters.WriteWithHeader(w, code, header, data)
+		return
+	}
+
+	data, err = rewriteCustomMetricsData(req.Context(), cachedVirtualClient, info, data)
+	if err != nil {
+		requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+		return
+	}
+
+	header.Del("Content-Length")
+	filters.WriteWithHeader(w, code, header, data)
+}
+
+// translateCustomMetricsLabelSelector translates the label selector to the host labels and makes sure
+// only objects of the requested virtual namespace are selected
+func translateCustomMetricsLabelSelector(req *http.Request, vNamespace string) error {
+	query := req.URL.Query()
+	labelSelector, err := metav1.ParseToLabelSelector(query.Get(LabelSelectorQueryParam))
+	if err != nil {
+		return fmt.Errorf("parse label selector: %w", err)
+	}
+
+	translatedLabelSelector := translate.Default.TranslateLabelSelector(labelSelector)
+	if translate.Default.SingleNamespaceTarget() {
+		if translatedLabelSelector.MatchLabels == nil {
+			translatedLabelSelector.MatchLabels = map[string]string{}
+		}
+		translatedLabelSelector.MatchLabels[translate.MarkerLabel] = translate.VClusterName
+		translatedLabelSelector.MatchLabels[translate.NamespaceLabel] = vNamespace
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(translatedLabelSelector)
+	if err != nil {
+		return fmt.Errorf("translate label selector: %w", err)
+	}
+
+	query.Set(LabelSelectorQueryParam, selector.String())
+	req.URL.RawQuery = query.Encode()
+	return nil
+}
+
+// rewriteCustomMetricsData translates the described objects of a MetricValueList back to their virtual
+// names and drops metric values of objects that do not belong to the virtual cluster
+func rewriteCustomMetricsData(ctx context.Context, cachedVirtualClient client.Client, info *request.RequestInfo, data []byte) ([]byte, error) {
+	metricValueList := map[string]interface{}{}
+	err := json.Unmarshal(data, &metricValueList)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal metric value list: %w", err)
+	}
+
+	items, _ := metricValueList["items"].([]interface{})
+	var hostToVirtual map[string]string
+	filteredItems := []interface{}{}
+	for _, item := range items {
+		metricValue, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		describedObject, ok := metricValue["describedObject"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch {
+		case info.Resource == NamespaceMetricsResource:
+			describedObject["name"] = info.Namespace
+		case info.Name != "*":
+			describedObject["name"] = info.Name
+			describedObject["namespace"] = info.Namespace
+		default:
+			// build the name mapping from the virtual objects of the described kind
+			if hostToVirtual == nil {
+				apiVersion, _ := describedObject["apiVersion"].(string)
+				kind, _ := describedObject["kind"].(string)
+				hostToVirtual, err = getVirtualObjectNames(ctx, cachedVirtualClient, apiVersion, kind, info.Namespace)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			name, _ := describedObject["name"].(string)
+			vName, found := hostToVirtual[name]
+			if !found {
+				continue
+			}
+
+			describedObject["name"] = vName
+			describedObject["namespace"] = info.Namespace
+		}
+
+		filteredItems = append(filteredItems, metricValue)
+	}
+	metricValueList["items"] = filteredItems
+
+	return json.Marshal(metricValueList)
+}
+
+// getVirtualObjectNames returns a map of host object names to virtual object names for the given kind in the virtual namespace
+func getVirtualObjectNames(ctx context.Context, cachedVirtualClient client.Client, apiVersion, kind, namespace string) (map[string]string, error) {
+	groupVersion, err := schema.ParseGroupVersion(strings.TrimPrefix(apiVersion, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parse described object api version %s: %w", apiVersion, err)
+	}
+
+	objectList := &metav1.PartialObjectMetadataList{}
+	objectList.SetGroupVersionKind(groupVersion.WithKind(kind + "List"))
+	err = cachedVirtualClient.List(ctx, objectList, client.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("list virtual %s: %w", kind, err)
+	}
+
+	hostToVirtual := make(map[string]string, len(objectList.Items))
+	for _, obj := range objectList.Items {
+		hostToVirtual[translate.Default.PhysicalName(obj.Name, namespace)] = obj.Name
+	}
+
+	return hostToVirtual, nil
+}
@@ -0,0 +1,81 @@
+package metricsserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestTranslateCustomMetricsLabelSelector(t *testing.T) {
+	translate.Default = translate.NewSingleNamespaceTranslator("test")
+
+	req, err := http.NewRequest(http.MethodGet, "/apis/custom.metrics.k8s.io/v1beta2/namespaces/default/pods/*/requests?labelSelector=app%3Dnginx", nil)
+	assert.NilError(t, err)
+
+	err = translateCustomMetricsLabelSelector(req, "default")
+	assert.NilError(t, err)
+
+	selector, err := metav1.ParseToLabelSelector(req.URL.Query().Get(LabelSelectorQueryParam))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, selector.MatchLabels, map[string]string{
+		translate.Default.ConvertLabelKey("app"): "nginx",
+		translate.MarkerLabel:                    translate.VClusterName,
+		translate.NamespaceLabel:                 "default",
+	})
+}
+
+func TestRewriteCustomMetricsData(t *testing.T) {
+	translate.Default = translate.NewSingleNamespaceTranslator("test")
+
+	vClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "default"}},
+	).Build()
+
+	data, err := json.Marshal(map[string]interface{}{
+		"kind":       "MetricValueList",
+		"apiVersion": "custom.metrics.k8s.io/v1beta2",
+		"items": []interface{}{
+			map[string]interface{}{
+				"describedObject": map[string]interface{}{
+					"kind":       "Pod",
+					"apiVersion": "/v1",
+					"namespace":  "test",
+					"name":       translate.Default.PhysicalName("nginx", "default"),
+				},
+				"value": "10",
+			},
+			map[string]interface{}{
+				"describedObject": map[string]interface{}{
+					"kind":       "Pod",
+					"apiVersion": "/v1",
+					"namespace":  "test",
+					"name":       "other",
+				},
+				"value": "20",
+			},
+		},
+	})
+	assert.NilError(t, err)
+
+	data, err = rewriteCustomMetricsData(context.TODO(), vClient, &request.RequestInfo{Namespace: "default", Resource: "pods", Name: "*"}, data)
+	assert.NilError(t, err)
+
+	metricValueList := map[string]interface{}{}
+	err = json.Unmarshal(data, &metricValueList)
+	assert.NilError(t, err)
+
+	items := metricValueList["items"].([]interface{})
+	assert.Equal(t, len(items), 1)
+	describedObject := items[0].(map[string]interface{})["describedObject"].(map[string]interface{})
+	assert.Equal(t, describedObject["name"], "nginx")
+	assert.Equal(t, describedObject["namespace"], "default")
+}
@@ -68,15 +68,16 @@ func Register(ctx *config.ControllerContext) error {
 		})
 	}
 
-	return nil
+	return registerMetricsAPIs(ctx)
 }
 
 func RegisterOrDeregisterAPIService(ctx *config.ControllerContext) error {
-	if ctx.Config.Integrations.MetricsServer.Enabled {
-		return apiservice.RegisterAPIService(ctx, "metrics-server", hostPort, GroupVersion)
+	err := registerOrDeregisterMetricsAPIServices(ctx)
+	if err != nil {
+		return err
 	}
 
-	return apiservice.DeregisterAPIService(ctx, GroupVersion)
+	return registerOrDeregisterAPIService(ctx, ctx.Config.Integrations.MetricsServer.Enabled, "metrics-server", hostPort, GroupVersion)
 }
 
 func WithMetricsServerProxy(
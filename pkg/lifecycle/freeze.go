@@ -0,0 +1,49 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/loft-sh/vcluster/pkg/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// IsFrozen returns true if the vCluster should start frozen, which means without syncing and read-only
+func IsFrozen(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string) (bool, error) {
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, "vc-config-"+name, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("get config secret: %w", err)
+	}
+
+	return secret.Annotations[constants.FrozenAnnotation] == "true", nil
+}
+
+// SetFrozen marks whether the vCluster should start frozen. The annotation is only read during startup.
+func SetFrozen(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string, frozen bool) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, "vc-config-"+name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("get config secret: %w", err)
+		} else if (secret.Annotations[constants.FrozenAnnotation] == "true") == frozen {
+			return nil
+		}
+
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		if frozen {
+			secret.Annotations[constants.FrozenAnnotation] = "true"
+		} else {
+			delete(secret.Annotations, constants.FrozenAnnotation)
+		}
+
+		_, err = kubeClient.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("update config secret: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,32 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSetFrozen(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vc-config-test", Namespace: "test"},
+	})
+
+	frozen, err := IsFrozen(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, !frozen)
+
+	assert.NilError(t, SetFrozen(ctx, kubeClient, "test", "test", true))
+	frozen, err = IsFrozen(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, frozen)
+
+	assert.NilError(t, SetFrozen(ctx, kubeClient, "test", "test", false))
+	frozen, err = IsFrozen(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, !frozen)
+}
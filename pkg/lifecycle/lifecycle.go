@@ -19,7 +19,7 @@ import (
 )
 
 // PauseVCluster pauses a running vcluster
-func PauseVCluster(ctx context.Context, kubeClient *kubernetes.Clientset, name, namespace string, log log.BaseLogger) error {
+func PauseVCluster(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string, log log.BaseLogger) error {
 	// scale down vcluster itself
 	labelSelector := "app=vcluster,release=" + name
 	found, err := scaleDownStatefulSet(ctx, kubeClient, labelSelector, namespace, log)
@@ -56,7 +56,7 @@ func PauseVCluster(ctx context.Context, kubeClient *kubernetes.Clientset, name,
 }
 
 // DeletePods deletes all pods associated with a running vcluster
-func DeletePods(ctx context.Context, kubeClient *kubernetes.Clientset, labelSelector, namespace string, log log.BaseLogger) error {
+func DeletePods(ctx context.Context, kubeClient kubernetes.Interface, labelSelector, namespace string, log log.BaseLogger) error {
 	list, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
 	if err != nil {
 		return err
@@ -78,7 +78,7 @@ func DeletePods(ctx context.Context, kubeClient *kubernetes.Clientset, labelSele
 	return nil
 }
 
-func DeleteMultiNamespaceVClusterWorkloads(ctx context.Context, client *kubernetes.Clientset, vclusterName, vclusterNamespace string, _ log.BaseLogger) error {
+func DeleteMultiNamespaceVClusterWorkloads(ctx context.Context, client kubernetes.Interface, vclusterName, vclusterNamespace string, _ log.BaseLogger) error {
 	// get all host namespaces managed by this multinamespace mode enabled vcluster
 	namespaces, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
 		LabelSelector: labels.FormatLabels(map[string]string{
@@ -96,7 +96,7 @@ func DeleteMultiNamespaceVClusterWorkloads(ctx context.Context, client *kubernet
 	for _, ns := range namespaces.Items {
 		podList, podListErr := client.CoreV1().Pods(ns.Name).List(ctx, metav1.ListOptions{})
 		if podListErr != nil {
-			return errors.Wrapf(err, "error listing pods in namespace %s", ns.Name)
+			return errors.Wrapf(podListErr, "error listing pods in namespace %s", ns.Name)
 		}
 
 		for _, pod := range podList.Items {
@@ -235,7 +235,7 @@ func scaleDownStatefulSet(ctx context.Context, kubeClient kubernetes.Interface,
 }
 
 // ResumeVCluster resumes a paused vcluster
-func ResumeVCluster(ctx context.Context, kubeClient *kubernetes.Clientset, name, namespace string, log log.BaseLogger) error {
+func ResumeVCluster(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string, log log.BaseLogger) error {
 	// scale up vcluster itself
 	labelSelector := "app=vcluster,release=" + name
 	found, err := scaleUpStatefulSet(ctx, kubeClient, labelSelector, namespace, log)
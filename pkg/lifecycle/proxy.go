@@ -0,0 +1,241 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/loft-sh/log"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+var (
+	// SleepCheckInterval is the interval in which the proxy checks if the vCluster should go to sleep
+	SleepCheckInterval = time.Minute
+
+	// WakeTimeout is the maximum time the proxy holds a connection while the vCluster wakes up
+	WakeTimeout = time.Minute * 5
+)
+
+// WakeProxyOptions holds the options for the sleep mode proxy
+type WakeProxyOptions struct {
+	// Name is the name of the vCluster
+	Name string
+
+	// Namespace is the namespace of the vCluster
+	Namespace string
+
+	// ListenAddress is the address the proxy listens on
+	ListenAddress string
+
+	// TargetPort is the port of the vCluster pods the proxy forwards to
+	TargetPort int
+
+	// AfterInactivity is the duration without activity after which the vCluster goes to sleep
+	AfterInactivity time.Duration
+}
+
+type wakeProxy struct {
+	kubeClient kubernetes.Interface
+	podLister  corev1listers.PodLister
+	options    WakeProxyOptions
+	log        log.BaseLogger
+
+	// wakeMutex makes sure the vCluster is not put to sleep and woken up at the same time
+	wakeMutex sync.Mutex
+}
+
+// StartWakeProxy starts a tcp proxy in front of the vCluster control plane. The proxy puts the vCluster to sleep
+// after it wasn't used for the configured duration and wakes it up again as soon as a new connection comes in.
+// Connections are held until the vCluster is ready again. Blocks until the context is done.
+func StartWakeProxy(ctx context.Context, kubeClient kubernetes.Interface, options WakeProxyOptions, log log.BaseLogger) error {
+	// watch the vCluster pods
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 0, informers.WithNamespace(options.Namespace), informers.WithTweakListOptions(func(listOptions *metav1.ListOptions) {
+		listOptions.LabelSelector = "app=vcluster,release=" + options.Name
+	}))
+	podLister := informerFactory.Core().V1().Pods().Lister()
+	informerFactory.Start(ctx.Done())
+	for informerType, synced := range informerFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("wait for %s cache sync", informerType.String())
+		}
+	}
+
+	listener, err := net.Listen("tcp", options.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", options.ListenAddress, err)
+	}
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+
+	p := &wakeProxy{
+		kubeClient: kubeClient,
+		podLister:  podLister,
+		options:    options,
+		log:        log,
+	}
+	go wait.UntilWithContext(ctx, p.sleepIfInactive, SleepCheckInterval)
+
+	log.Infof("Sleep mode proxy for vCluster %s/%s listening on %s", options.Namespace, options.Name, options.ListenAddress)
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("accept connection: %w", err)
+		}
+
+		go p.handle(ctx, conn)
+	}
+}
+
+func (p *wakeProxy) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	target, err := p.wake(ctx)
+	if err != nil {
+		p.log.Errorf("Error waking up vCluster %s/%s: %v", p.options.Namespace, p.options.Name, err)
+		return
+	}
+
+	targetConn, err := net.DialTimeout("tcp", target, time.Second*10)
+	if err != nil {
+		p.log.Errorf("Error connecting to vCluster %s/%s: %v", p.options.Namespace, p.options.Name, err)
+		return
+	}
+	defer targetConn.Close()
+
+	// forward traffic until one of the connections is closed
+	errChan := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(targetConn, conn)
+		errChan <- err
+	}()
+	go func() {
+		_, err := io.Copy(conn, targetConn)
+		errChan <- err
+	}()
+	<-errChan
+}
+
+// wake returns the address of a ready vCluster pod and resumes the vCluster if it is sleeping
+func (p *wakeProxy) wake(ctx context.Context) (string, error) {
+	target, err := p.readyTarget()
+	if err != nil || target != "" {
+		return target, err
+	}
+
+	// only a single connection should wake up the vCluster
+	p.wakeMutex.Lock()
+	defer p.wakeMutex.Unlock()
+
+	paused, err := IsPaused(ctx, p.kubeClient, p.options.Name, p.options.Namespace)
+	if err != nil {
+		return "", err
+	} else if paused {
+		p.log.Infof("Waking up vCluster %s/%s", p.options.Namespace, p.options.Name)
+
+		// reset the activity, so the vCluster doesn't go to sleep again right away
+		err = RecordActivity(ctx, p.kubeClient, p.options.Name, p.options.Namespace, time.Now())
+		if err != nil {
+			return "", fmt.Errorf("record activity: %w", err)
+		}
+
+		err = ResumeVCluster(ctx, p.kubeClient, p.options.Name, p.options.Namespace, p.log)
+		if err != nil {
+			return "", fmt.Errorf("resume vCluster: %w", err)
+		}
+	}
+
+	// wait until the vCluster is ready
+	err = wait.PollUntilContextTimeout(ctx, time.Second, WakeTimeout, true, func(context.Context) (bool, error) {
+		target, err = p.readyTarget()
+		return target != "", err
+	})
+	if err != nil {
+		return "", fmt.Errorf("wait for vCluster to become ready: %w", err)
+	}
+
+	return target, nil
+}
+
+// readyTarget returns the address of a random ready vCluster pod or an empty string if there is none
+func (p *wakeProxy) readyTarget() (string, error) {
+	pods, err := p.podLister.Pods(p.options.Namespace).List(labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	targets := []string{}
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || pod.Status.PodIP == "" || !isPodReady(pod) {
+			continue
+		}
+
+		targets = append(targets, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(p.options.TargetPort)))
+	}
+	if len(targets) == 0 {
+		return "", nil
+	}
+
+	return targets[rand.Intn(len(targets))], nil
+}
+
+func (p *wakeProxy) sleepIfInactive(ctx context.Context) {
+	p.wakeMutex.Lock()
+	defer p.wakeMutex.Unlock()
+
+	paused, err := IsPaused(ctx, p.kubeClient, p.options.Name, p.options.Namespace)
+	if err != nil {
+		p.log.Errorf("Error checking if vCluster %s/%s is paused: %v", p.options.Namespace, p.options.Name, err)
+		return
+	} else if paused {
+		return
+	}
+
+	lastActivity, err := GetLastActivity(ctx, p.kubeClient, p.options.Name, p.options.Namespace)
+	if err != nil {
+		p.log.Errorf("Error retrieving last activity of vCluster %s/%s: %v", p.options.Namespace, p.options.Name, err)
+		return
+	} else if lastActivity.IsZero() {
+		// the vCluster has not recorded any activity yet, so we start counting from now
+		err = RecordActivity(ctx, p.kubeClient, p.options.Name, p.options.Namespace, time.Now())
+		if err != nil {
+			p.log.Errorf("Error recording activity of vCluster %s/%s: %v", p.options.Namespace, p.options.Name, err)
+		}
+		return
+	} else if time.Since(lastActivity) < p.options.AfterInactivity {
+		return
+	}
+
+	p.log.Infof("vCluster %s/%s was not used since %s, putting it to sleep", p.options.Namespace, p.options.Name, lastActivity.Format(time.RFC3339))
+	err = SleepVCluster(ctx, p.kubeClient, p.options.Name, p.options.Namespace, p.log)
+	if err != nil {
+		p.log.Errorf("Error putting vCluster %s/%s to sleep: %v", p.options.Namespace, p.options.Name, err)
+	}
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,211 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/loft-sh/log"
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+)
+
+func TestReadyTarget(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pods     []*corev1.Pod
+		expected string
+	}{
+		{
+			name:     "no pods",
+			expected: "",
+		},
+		{
+			name: "ready pod",
+			pods: []*corev1.Pod{
+				newPod("test-0", "10.0.0.1", true),
+			},
+			expected: "10.0.0.1:8443",
+		},
+		{
+			name: "pods not ready",
+			pods: []*corev1.Pod{
+				newPod("test-0", "10.0.0.1", false),
+				newPod("test-1", "", true),
+			},
+			expected: "",
+		},
+		{
+			name: "terminating pod",
+			pods: []*corev1.Pod{
+				func() *corev1.Pod {
+					pod := newPod("test-0", "10.0.0.1", true)
+					pod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+					return pod
+				}(),
+				newPod("test-1", "10.0.0.2", true),
+			},
+			expected: "10.0.0.2:8443",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p, indexer := newTestWakeProxy(fake.NewSimpleClientset())
+			for _, pod := range testCase.pods {
+				assert.NilError(t, indexer.Add(pod))
+			}
+
+			target, err := p.readyTarget()
+			assert.NilError(t, err)
+			assert.Equal(t, target, testCase.expected)
+		})
+	}
+}
+
+func TestSleepIfInactive(t *testing.T) {
+	testCases := []struct {
+		name          string
+		lastActivity  time.Duration
+		expectedPause bool
+	}{
+		{
+			name:          "no activity recorded",
+			expectedPause: false,
+		},
+		{
+			name:          "recent activity",
+			lastActivity:  time.Minute * 5,
+			expectedPause: false,
+		},
+		{
+			name:          "inactive",
+			lastActivity:  time.Hour * 2,
+			expectedPause: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			kubeClient := fake.NewSimpleClientset(newStatefulSet(false))
+			if testCase.lastActivity > 0 {
+				assert.NilError(t, RecordActivity(ctx, kubeClient, "test", "test", time.Now().Add(-testCase.lastActivity)))
+			}
+
+			p, _ := newTestWakeProxy(kubeClient)
+			p.sleepIfInactive(ctx)
+
+			paused, err := IsPaused(ctx, kubeClient, "test", "test")
+			assert.NilError(t, err)
+			assert.Equal(t, paused, testCase.expectedPause)
+
+			// the proxy starts counting from now if there was no activity yet
+			lastActivity, err := GetLastActivity(ctx, kubeClient, "test", "test")
+			assert.NilError(t, err)
+			assert.Assert(t, !lastActivity.IsZero())
+		})
+	}
+}
+
+func TestWake(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := fake.NewSimpleClientset(newStatefulSet(true))
+	p, indexer := newTestWakeProxy(kubeClient)
+
+	// the vCluster pod becomes ready as soon as the statefulSet is scaled up
+	kubeClient.PrependReactor("patch", "statefulsets", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return false, nil, indexer.Add(newPod("test-0", "10.0.0.1", true))
+	})
+
+	target, err := p.wake(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, target, "10.0.0.1:8443")
+
+	paused, err := IsPaused(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, !paused)
+
+	statefulSet, err := kubeClient.AppsV1().StatefulSets("test").Get(ctx, "test", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, *statefulSet.Spec.Replicas, int32(2))
+
+	// waking up resets the activity, so the vCluster doesn't go to sleep right away
+	lastActivity, err := GetLastActivity(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, time.Since(lastActivity) < time.Minute)
+
+	// a running vCluster is not touched again
+	kubeClient.ClearActions()
+	target, err = p.wake(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, target, "10.0.0.1:8443")
+	assert.Equal(t, len(kubeClient.Actions()), 0)
+}
+
+func newTestWakeProxy(kubeClient kubernetes.Interface) (*wakeProxy, cache.Indexer) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	return &wakeProxy{
+		kubeClient: kubeClient,
+		podLister:  corev1listers.NewPodLister(indexer),
+		options: WakeProxyOptions{
+			Name:            "test",
+			Namespace:       "test",
+			TargetPort:      8443,
+			AfterInactivity: time.Hour,
+		},
+		log: log.Discard,
+	}, indexer
+}
+
+func newStatefulSet(paused bool) *appsv1.StatefulSet {
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+			Labels:    map[string]string{"app": "vcluster", "release": "test"},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(2)),
+		},
+	}
+	if paused {
+		statefulSet.Annotations = map[string]string{
+			constants.PausedAnnotation:         "true",
+			constants.PausedReplicasAnnotation: "2",
+		}
+		statefulSet.Spec.Replicas = ptr.To(int32(0))
+	}
+
+	return statefulSet
+}
+
+func newPod(name, podIP string, ready bool) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+		},
+		Status: corev1.PodStatus{
+			PodIP: podIP,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: readyStatus},
+			},
+		},
+	}
+}
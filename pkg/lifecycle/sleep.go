@@ -0,0 +1,187 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/loft-sh/log"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	"github.com/loft-sh/vcluster/pkg/constants"
+)
+
+const (
+	// LastActivityKey is the key within the activity config map that holds the time of the last request to the vCluster
+	LastActivityKey = "lastActivity"
+
+	// ActivityPublishInterval is the interval in which the recorded activity is written to the activity config map
+	ActivityPublishInterval = time.Second * 30
+)
+
+// ActivityConfigMapName returns the name of the config map that holds the last activity of the vCluster
+func ActivityConfigMapName(vClusterName string) string {
+	return "vc-activity-" + vClusterName
+}
+
+// ActivityTracker keeps track of the last request to the vCluster
+type ActivityTracker struct {
+	lastActivity atomic.Int64
+}
+
+// NewActivityTracker creates a new activity tracker, starting with an activity right now
+func NewActivityTracker() *ActivityTracker {
+	tracker := &ActivityTracker{}
+	tracker.Record()
+	return tracker
+}
+
+// Record records an activity right now
+func (a *ActivityTracker) Record() {
+	a.lastActivity.Store(time.Now().Unix())
+}
+
+// LastActivity returns the time of the last recorded activity
+func (a *ActivityTracker) LastActivity() time.Time {
+	return time.Unix(a.lastActivity.Load(), 0)
+}
+
+// StartActivityPublisher periodically writes the last activity of the tracker to the activity config map, so the
+// sleep mode proxy knows when the vCluster was used the last time. This needs to run in every replica.
+func StartActivityPublisher(ctx context.Context, kubeClient kubernetes.Interface, vClusterName, namespace string, tracker *ActivityTracker) {
+	var published time.Time
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		lastActivity := tracker.LastActivity()
+		if !lastActivity.After(published) {
+			return
+		}
+
+		err := RecordActivity(ctx, kubeClient, vClusterName, namespace, lastActivity)
+		if err != nil {
+			klog.Errorf("Error recording vCluster activity: %v", err)
+			return
+		}
+
+		published = lastActivity
+	}, ActivityPublishInterval)
+}
+
+// GetLastActivity returns the last recorded activity of the vCluster. Returns a zero time if there was no activity
+// recorded yet.
+func GetLastActivity(ctx context.Context, kubeClient kubernetes.Interface, vClusterName, namespace string) (time.Time, error) {
+	configMap, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, ActivityConfigMapName(vClusterName), metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, fmt.Errorf("get activity config map: %w", err)
+	}
+
+	return parseLastActivity(configMap)
+}
+
+// RecordActivity writes the given activity to the activity config map, if it is newer than the already recorded one
+func RecordActivity(ctx context.Context, kubeClient kubernetes.Interface, vClusterName, namespace string, activity time.Time) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, ActivityConfigMapName(vClusterName), metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			_, err = kubeClient.CoreV1().ConfigMaps(namespace).Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ActivityConfigMapName(vClusterName),
+					Namespace: namespace,
+					Labels: map[string]string{
+						"app":     "vcluster",
+						"release": vClusterName,
+					},
+				},
+				Data: map[string]string{
+					LastActivityKey: activity.UTC().Format(time.RFC3339),
+				},
+			}, metav1.CreateOptions{})
+			if kerrors.IsAlreadyExists(err) {
+				return kerrors.NewConflict(corev1.Resource("configmaps"), ActivityConfigMapName(vClusterName), err)
+			}
+
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		lastActivity, err := parseLastActivity(configMap)
+		if err == nil && !activity.After(lastActivity) {
+			return nil
+		}
+
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[LastActivityKey] = activity.UTC().Format(time.RFC3339)
+		_, err = kubeClient.CoreV1().ConfigMaps(namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func parseLastActivity(configMap *corev1.ConfigMap) (time.Time, error) {
+	if configMap.Data[LastActivityKey] == "" {
+		return time.Time{}, nil
+	}
+
+	lastActivity, err := time.Parse(time.RFC3339, configMap.Data[LastActivityKey])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse last activity %s: %w", configMap.Data[LastActivityKey], err)
+	}
+
+	return lastActivity, nil
+}
+
+// SleepVCluster pauses the vCluster and deletes all of its workloads
+func SleepVCluster(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string, log log.BaseLogger) error {
+	err := PauseVCluster(ctx, kubeClient, name, namespace, log)
+	if err != nil {
+		return err
+	}
+
+	err = DeletePods(ctx, kubeClient, "vcluster.loft.sh/managed-by="+name, namespace, log)
+	if err != nil {
+		return fmt.Errorf("delete vcluster workloads: %w", err)
+	}
+
+	err = DeleteMultiNamespaceVClusterWorkloads(ctx, kubeClient, name, namespace, log)
+	if err != nil {
+		return fmt.Errorf("delete vcluster multinamespace workloads: %w", err)
+	}
+
+	return nil
+}
+
+// IsPaused returns true if the vCluster was paused
+func IsPaused(ctx context.Context, kubeClient kubernetes.Interface, name, namespace string) (bool, error) {
+	labelSelector := "app=vcluster,release=" + name
+	statefulSets, err := kubeClient.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return false, err
+	}
+	for _, statefulSet := range statefulSets.Items {
+		if statefulSet.Annotations[constants.PausedAnnotation] == "true" {
+			return true, nil
+		}
+	}
+
+	deployments, err := kubeClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return false, err
+	}
+	for _, deployment := range deployments.Items {
+		if deployment.Annotations[constants.PausedAnnotation] == "true" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,83 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/constants"
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRecordActivity(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := fake.NewSimpleClientset()
+
+	lastActivity, err := GetLastActivity(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, lastActivity.IsZero())
+
+	now := time.Now().Truncate(time.Second)
+	assert.NilError(t, RecordActivity(ctx, kubeClient, "test", "test", now))
+	lastActivity, err = GetLastActivity(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, lastActivity.Equal(now))
+
+	// older activity should not overwrite newer activity
+	assert.NilError(t, RecordActivity(ctx, kubeClient, "test", "test", now.Add(-time.Hour)))
+	lastActivity, err = GetLastActivity(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, lastActivity.Equal(now))
+
+	assert.NilError(t, RecordActivity(ctx, kubeClient, "test", "test", now.Add(time.Hour)))
+	lastActivity, err = GetLastActivity(ctx, kubeClient, "test", "test")
+	assert.NilError(t, err)
+	assert.Assert(t, lastActivity.Equal(now.Add(time.Hour)))
+}
+
+func TestIsPaused(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		labels      map[string]string
+		expected    bool
+	}{
+		{
+			name:     "running",
+			labels:   map[string]string{"app": "vcluster", "release": "test"},
+			expected: false,
+		},
+		{
+			name:        "paused",
+			labels:      map[string]string{"app": "vcluster", "release": "test"},
+			annotations: map[string]string{constants.PausedAnnotation: "true"},
+			expected:    true,
+		},
+		{
+			name:        "other vCluster paused",
+			labels:      map[string]string{"app": "vcluster", "release": "other"},
+			annotations: map[string]string{constants.PausedAnnotation: "true"},
+			expected:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset(&appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "test",
+					Labels:      testCase.labels,
+					Annotations: testCase.annotations,
+				},
+			})
+
+			paused, err := IsPaused(context.Background(), kubeClient, "test", "test")
+			assert.NilError(t, err)
+			assert.Equal(t, paused, testCase.expected)
+		})
+	}
+}
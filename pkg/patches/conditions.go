@@ -7,9 +7,9 @@ import (
 	yaml "gopkg.in/yaml.v3"
 )
 
-func ValidateAllConditions(obj *yaml.Node, match *yaml.Node, conditions []*config.PatchCondition) (bool, error) {
+func ValidateAllConditions(obj, source *yaml.Node, match *yaml.Node, conditions []*config.PatchCondition) (bool, error) {
 	for _, condition := range conditions {
-		matched, err := ValidateCondition(obj, match, condition)
+		matched, err := ValidateCondition(obj, source, match, condition)
 		if err != nil {
 			return false, err
 		} else if !matched {
@@ -20,9 +20,11 @@ func ValidateAllConditions(obj *yaml.Node, match *yaml.Node, conditions []*confi
 	return true, nil
 }
 
-func ValidateCondition(obj *yaml.Node, match *yaml.Node, condition *config.PatchCondition) (bool, error) {
+func ValidateCondition(obj, source *yaml.Node, match *yaml.Node, condition *config.PatchCondition) (bool, error) {
 	if condition == nil {
 		return true, nil
+	} else if condition.Expression != "" {
+		return validateExpressionCondition(obj, source, match, condition)
 	}
 
 	var matches []*yaml.Node
@@ -98,6 +100,40 @@ func ValidateCondition(obj *yaml.Node, match *yaml.Node, condition *config.Patch
 	return false, nil
 }
 
+func validateExpressionCondition(obj, source *yaml.Node, match *yaml.Node, condition *config.PatchCondition) (bool, error) {
+	var err error
+	values := []*yaml.Node{match}
+	if condition.SubPath != "" {
+		values = nil
+		if match != nil {
+			values, err = FindMatches(match, condition.SubPath)
+			if err != nil {
+				return false, errors.Wrap(err, "find sub path matches")
+			}
+		}
+	} else if condition.Path != "" {
+		values, err = FindMatches(obj, condition.Path)
+		if err != nil {
+			return false, errors.Wrap(err, "find matches")
+		}
+	}
+	if len(values) == 0 {
+		values = []*yaml.Node{nil}
+	}
+
+	// only one value needs to fulfill our condition
+	for _, value := range values {
+		matched, err := EvaluateCondition(condition.Expression, obj, source, value)
+		if err != nil {
+			return false, err
+		} else if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func getStringValue(value interface{}) string {
 	strValue, ok := value.(string)
 	if ok {
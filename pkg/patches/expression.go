@@ -0,0 +1,195 @@
+package patches
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	"github.com/loft-sh/vcluster/config"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+	yaml "gopkg.in/yaml.v3"
+)
+
+const (
+	// ExpressionObjectVariable is the object that is patched or checked
+	ExpressionObjectVariable = "object"
+	// ExpressionSourceVariable is the other object, e.g. the virtual object for a patch on the host object
+	ExpressionSourceVariable = "source"
+	// ExpressionValueVariable is the value at the patch or condition path
+	ExpressionValueVariable = "value"
+)
+
+var (
+	expressionEnv     *cel.Env
+	expressionEnvErr  error
+	expressionEnvOnce sync.Once
+
+	programCache sync.Map
+)
+
+func getExpressionEnv() (*cel.Env, error) {
+	expressionEnvOnce.Do(func() {
+		expressionEnv, expressionEnvErr = cel.NewEnv(
+			cel.Variable(ExpressionObjectVariable, cel.DynType),
+			cel.Variable(ExpressionSourceVariable, cel.DynType),
+			cel.Variable(ExpressionValueVariable, cel.DynType),
+			ext.Strings(),
+			ext.Encoders(),
+			ext.Lists(),
+			ext.Math(),
+		)
+	})
+
+	return expressionEnv, expressionEnvErr
+}
+
+// CompileExpression parses and checks the given CEL expression. Compiled programs are cached, so this can
+// also be used to validate expressions upfront.
+func CompileExpression(expression string) (cel.Program, error) {
+	if program, ok := programCache.Load(expression); ok {
+		return program.(cel.Program), nil
+	}
+
+	env, err := getExpressionEnv()
+	if err != nil {
+		return nil, errors.Wrap(err, "create cel environment")
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("compile expression %q: %w", expression, issues.Err())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("create program for expression %q: %w", expression, err)
+	}
+
+	programCache.Store(expression, program)
+	return program, nil
+}
+
+// EvaluateExpression evaluates the CEL expression and returns its result as a plain value (nil, bool, float64,
+// string, []interface{} or map[string]interface{}).
+func EvaluateExpression(expression string, obj, source, value *yaml.Node) (interface{}, error) {
+	program, err := CompileExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := map[string]interface{}{}
+	for name, node := range map[string]*yaml.Node{
+		ExpressionObjectVariable: obj,
+		ExpressionSourceVariable: source,
+		ExpressionValueVariable:  value,
+	} {
+		variables[name], err = nodeToValue(node)
+		if err != nil {
+			return nil, errors.Wrapf(err, "convert %s", name)
+		}
+	}
+
+	out, _, err := program.Eval(variables)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate expression %q: %w", expression, err)
+	} else if out == types.NullValue {
+		return nil, nil
+	}
+
+	nativeValue, err := out.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("convert result of expression %q: %w", expression, err)
+	}
+
+	return nativeValue.(*structpb.Value).AsInterface(), nil
+}
+
+// EvaluateCondition evaluates the CEL expression and expects it to return a boolean
+func EvaluateCondition(expression string, obj, source, value *yaml.Node) (bool, error) {
+	out, err := EvaluateExpression(expression, obj, source, value)
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q returned %T instead of bool", expression, out)
+	}
+
+	return result, nil
+}
+
+// Expression sets the result of the patch expression at the patch path
+func Expression(obj1, obj2 *yaml.Node, patch *config.Patch) error {
+	matches, err := FindMatches(obj1, patch.Path)
+	if err != nil {
+		return errors.Wrap(err, "find matches")
+	}
+
+	if len(matches) == 0 {
+		validated, err := ValidateAllConditions(obj1, obj2, nil, patch.Conditions)
+		if err != nil {
+			return errors.Wrap(err, "validate conditions")
+		} else if !validated {
+			return nil
+		}
+
+		result, err := EvaluateExpression(patch.Expression, obj1, obj2, nil)
+		if err != nil {
+			return err
+		} else if result == nil {
+			return nil
+		}
+
+		value, err := NewNode(result)
+		if err != nil {
+			return errors.Wrap(err, "new node from expression result")
+		}
+
+		return createPath(obj1, patch.Path, value)
+	}
+
+	for _, m := range matches {
+		validated, err := ValidateAllConditions(obj1, obj2, m, patch.Conditions)
+		if err != nil {
+			return errors.Wrap(err, "validate conditions")
+		} else if !validated {
+			continue
+		}
+
+		result, err := EvaluateExpression(patch.Expression, obj1, obj2, m)
+		if err != nil {
+			return err
+		} else if result == nil {
+			removeNode(obj1, m)
+			continue
+		}
+
+		value, err := NewNode(result)
+		if err != nil {
+			return errors.Wrap(err, "new node from expression result")
+		}
+
+		ReplaceNode(obj1, m, value)
+	}
+
+	return nil
+}
+
+func nodeToValue(node *yaml.Node) (interface{}, error) {
+	if node == nil {
+		return nil, nil
+	}
+
+	var out interface{}
+	err := node.Decode(&out)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
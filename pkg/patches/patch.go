@@ -3,6 +3,7 @@ package patches
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 
 	vclusterconfig "github.com/loft-sh/vcluster/config"
@@ -64,6 +65,9 @@ func ApplyPatches(destObj, sourceObj client.Object, patchesConf []*vclusterconfi
 		return errors.Wrap(err, "marshal yaml")
 	}
 
+	// reset the object first, otherwise removed fields would be kept in typed objects
+	destValue := reflect.ValueOf(destObj).Elem()
+	destValue.Set(reflect.Zero(destValue.Type()))
 	err = jsonyaml.Unmarshal(objYaml, destObj)
 	if err != nil {
 		return errors.Wrap(err, "convert object")
@@ -75,21 +79,23 @@ func ApplyPatches(destObj, sourceObj client.Object, patchesConf []*vclusterconfi
 func applyPatch(obj1, obj2 *yaml.Node, patch *vclusterconfig.Patch, resolver NameResolver) error {
 	switch patch.Operation {
 	case vclusterconfig.PatchTypeRewriteName:
-		return RewriteName(obj1, patch, resolver)
+		return RewriteName(obj1, obj2, patch, resolver)
 	case vclusterconfig.PatchTypeRewriteLabelKey:
-		return RewriteLabelKey(obj1, patch, resolver)
+		return RewriteLabelKey(obj1, obj2, patch, resolver)
 	case vclusterconfig.PatchTypeRewriteLabelExpressionsSelector:
-		return RewriteLabelExpressionsSelector(obj1, patch, resolver)
+		return RewriteLabelExpressionsSelector(obj1, obj2, patch, resolver)
 	case vclusterconfig.PatchTypeRewriteLabelSelector:
-		return RewriteLabelSelector(obj1, patch, resolver)
+		return RewriteLabelSelector(obj1, obj2, patch, resolver)
 	case vclusterconfig.PatchTypeReplace:
-		return Replace(obj1, patch)
+		return Replace(obj1, obj2, patch)
 	case vclusterconfig.PatchTypeRemove:
-		return Remove(obj1, patch)
+		return Remove(obj1, obj2, patch)
 	case vclusterconfig.PatchTypeAdd:
-		return Add(obj1, patch)
+		return Add(obj1, obj2, patch)
 	case vclusterconfig.PatchTypeCopyFromObject:
 		return CopyFromObject(obj1, obj2, patch)
+	case vclusterconfig.PatchTypeExpression:
+		return Expression(obj1, obj2, patch)
 	}
 
 	return fmt.Errorf("patch operation is missing or is not recognized (%s)", patch.Operation)
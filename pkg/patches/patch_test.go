@@ -412,6 +412,152 @@ test2: {}`,
         - name: abc
         - name: def`,
 		},
+		{
+			name: "expression concat",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "spec.url",
+				Expression: `"https://" + source.spec.host + ":" + string(source.spec.port)`,
+			},
+			obj1: `spec:
+    name: test`,
+			obj2: `spec:
+    host: example.com
+    port: 443`,
+			expected: `spec:
+    name: test
+    url: https://example.com:443`,
+		},
+		{
+			name: "expression current value",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "spec.replicas",
+				Expression: `value * 2`,
+			},
+			obj1: `spec:
+    replicas: 2`,
+			expected: `spec:
+    replicas: 4`,
+		},
+		{
+			name: "expression array lookup",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "status.ready",
+				Expression: `source.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`,
+			},
+			obj1: `status:
+    phase: Running`,
+			obj2: `status:
+    conditions:
+        - type: Ready
+          status: "True"`,
+			expected: `status:
+    phase: Running
+    ready: true`,
+		},
+		{
+			name: "expression null removes",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "spec.test",
+				Expression: `null`,
+			},
+			obj1: `spec:
+    test: abc
+    other: def`,
+			expected: `spec:
+    other: def`,
+		},
+		{
+			name: "expression condition",
+			patch: &config.Patch{
+				Operation: config.PatchTypeReplace,
+				Path:      "test.abc",
+				Value:     "def",
+				Conditions: []*config.PatchCondition{
+					{
+						Path:       "test.items",
+						Expression: `size(value) > 1 && value.all(i, i.startsWith("a"))`,
+					},
+				},
+			},
+			obj1: `test:
+    abc: test
+    items: [a1, a2]`,
+			expected: `test:
+    abc: def
+    items: [a1, a2]`,
+		},
+		{
+			name: "expression condition not matching",
+			patch: &config.Patch{
+				Operation: config.PatchTypeReplace,
+				Path:      "test.abc",
+				Value:     "def",
+				Conditions: []*config.PatchCondition{
+					{
+						Expression: `object.test.abc != "test"`,
+					},
+				},
+			},
+			obj1: `test:
+    abc: test`,
+			expected: `test:
+    abc: test`,
+		},
+		{
+			name: "expression condition on source",
+			patch: &config.Patch{
+				Operation: config.PatchTypeRemove,
+				Path:      "metadata.annotations.managed",
+				Conditions: []*config.PatchCondition{
+					{
+						Expression: `source.metadata.labels.tier == "frontend"`,
+					},
+				},
+			},
+			obj1: `metadata:
+    annotations:
+        managed: "true"`,
+			obj2: `metadata:
+    labels:
+        tier: frontend`,
+			expected: `metadata:
+    annotations: {}`,
+		},
+		{
+			name: "expression condition on source not matching",
+			patch: &config.Patch{
+				Operation: config.PatchTypeRemove,
+				Path:      "metadata.annotations.managed",
+				Conditions: []*config.PatchCondition{
+					{
+						Expression: `source.metadata.labels.tier == "frontend"`,
+					},
+				},
+			},
+			obj1: `metadata:
+    annotations:
+        managed: "true"`,
+			obj2: `metadata:
+    labels:
+        tier: backend`,
+			expected: `metadata:
+    annotations:
+        managed: "true"`,
+		},
+		{
+			name: "expression invalid",
+			patch: &config.Patch{
+				Operation:  config.PatchTypeExpression,
+				Path:       "test",
+				Expression: `object.`,
+			},
+			obj1:        `test: abc`,
+			expectedErr: errors.New("compile expression"),
+		},
 	}
 
 	for _, testCase := range testCases {
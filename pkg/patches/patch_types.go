@@ -34,7 +34,7 @@ func CopyFromObject(obj1, obj2 *yaml.Node, patch *config.Patch) error {
 	}
 
 	if len(fromMatches) == 1 && len(matches) == 0 {
-		validated, err := ValidateAllConditions(obj1, nil, patch.Conditions)
+		validated, err := ValidateAllConditions(obj1, obj2, nil, patch.Conditions)
 		if err != nil {
 			return errors.Wrap(err, "validate conditions")
 		} else if !validated {
@@ -45,7 +45,7 @@ func CopyFromObject(obj1, obj2 *yaml.Node, patch *config.Patch) error {
 	}
 
 	for _, m := range matches {
-		validated, err := ValidateAllConditions(obj1, m, patch.Conditions)
+		validated, err := ValidateAllConditions(obj1, obj2, m, patch.Conditions)
 		if err != nil {
 			return errors.Wrap(err, "validate conditions")
 		} else if !validated {
@@ -69,38 +69,42 @@ func CopyFromObject(obj1, obj2 *yaml.Node, patch *config.Patch) error {
 	return nil
 }
 
-func Remove(obj1 *yaml.Node, patch *config.Patch) error {
+func Remove(obj1, obj2 *yaml.Node, patch *config.Patch) error {
 	matches, err := FindMatches(obj1, patch.Path)
 	if err != nil {
 		return fmt.Errorf("find matches: %w", err)
 	}
 
 	for _, m := range matches {
-		validated, err := ValidateAllConditions(obj1, m, patch.Conditions)
+		validated, err := ValidateAllConditions(obj1, obj2, m, patch.Conditions)
 		if err != nil {
 			return fmt.Errorf("validate conditions: %w", err)
 		} else if !validated {
 			continue
 		}
 
-		parent := Find(obj1, ContainsChild(m))
-		if parent == nil {
-			continue
-		}
-
-		switch parent.Kind {
-		case yaml.MappingNode:
-			parent.Content = removeProperty(parent, m)
-		case yaml.SequenceNode:
-			parent.Content = removeChild(parent, m)
-		case yaml.DocumentNode, yaml.ScalarNode, yaml.AliasNode:
-		}
+		removeNode(obj1, m)
 	}
 
 	return nil
 }
 
-func Add(obj1 *yaml.Node, patch *config.Patch) error {
+func removeNode(obj1 *yaml.Node, m *yaml.Node) {
+	parent := Find(obj1, ContainsChild(m))
+	if parent == nil {
+		return
+	}
+
+	switch parent.Kind {
+	case yaml.MappingNode:
+		parent.Content = removeProperty(parent, m)
+	case yaml.SequenceNode:
+		parent.Content = removeChild(parent, m)
+	case yaml.DocumentNode, yaml.ScalarNode, yaml.AliasNode:
+	}
+}
+
+func Add(obj1, obj2 *yaml.Node, patch *config.Patch) error {
 	matches, err := FindMatches(obj1, patch.Path)
 	if err != nil {
 		return errors.Wrap(err, "find matches")
@@ -112,7 +116,7 @@ func Add(obj1 *yaml.Node, patch *config.Patch) error {
 	}
 
 	if len(matches) == 0 {
-		validated, err := ValidateAllConditions(obj1, nil, patch.Conditions)
+		validated, err := ValidateAllConditions(obj1, obj2, nil, patch.Conditions)
 		if err != nil {
 			return errors.Wrap(err, "validate conditions")
 		} else if !validated {
@@ -125,7 +129,7 @@ func Add(obj1 *yaml.Node, patch *config.Patch) error {
 		}
 	} else {
 		for _, m := range matches {
-			validated, err := ValidateAllConditions(obj1, m, patch.Conditions)
+			validated, err := ValidateAllConditions(obj1, obj2, m, patch.Conditions)
 			if err != nil {
 				return errors.Wrap(err, "validate conditions")
 			} else if !validated {
@@ -139,7 +143,7 @@ func Add(obj1 *yaml.Node, patch *config.Patch) error {
 	return nil
 }
 
-func Replace(obj1 *yaml.Node, patch *config.Patch) error {
+func Replace(obj1, obj2 *yaml.Node, patch *config.Patch) error {
 	matches, err := FindMatches(obj1, patch.Path)
 	if err != nil {
 		return errors.Wrap(err, "find matches")
@@ -151,7 +155,7 @@ func Replace(obj1 *yaml.Node, patch *config.Patch) error {
 	}
 
 	for _, m := range matches {
-		validated, err := ValidateAllConditions(obj1, m, patch.Conditions)
+		validated, err := ValidateAllConditions(obj1, obj2, m, patch.Conditions)
 		if err != nil {
 			return errors.Wrap(err, "validate conditions")
 		} else if !validated {
@@ -164,7 +168,7 @@ func Replace(obj1 *yaml.Node, patch *config.Patch) error {
 	return nil
 }
 
-func RewriteName(obj1 *yaml.Node, patch *config.Patch, resolver NameResolver) error {
+func RewriteName(obj1, obj2 *yaml.Node, patch *config.Patch, resolver NameResolver) error {
 	matches, err := FindMatches(obj1, patch.Path)
 	if err != nil {
 		return errors.Wrap(err, "find matches")
@@ -173,16 +177,16 @@ func RewriteName(obj1 *yaml.Node, patch *config.Patch, resolver NameResolver) er
 	for _, m := range matches {
 		switch m.Kind {
 		case yaml.ScalarNode:
-			err = ValidateAndTranslateName(obj1, m, patch, resolver, "")
+			err = ValidateAndTranslateName(obj1, obj2, m, patch, resolver, "")
 		case yaml.SequenceNode:
 			for _, subNode := range m.Content {
-				err = ProcessRewrite(subNode, patch, resolver)
+				err = ProcessRewrite(subNode, obj2, patch, resolver)
 				if err != nil {
 					return err
 				}
 			}
 		case yaml.MappingNode:
-			err = ProcessRewrite(m, patch, resolver)
+			err = ProcessRewrite(m, obj2, patch, resolver)
 		case yaml.DocumentNode, yaml.AliasNode:
 		}
 
@@ -194,7 +198,7 @@ func RewriteName(obj1 *yaml.Node, patch *config.Patch, resolver NameResolver) er
 	return nil
 }
 
-func ProcessRewrite(obj *yaml.Node, patch *config.Patch, resolver NameResolver) error {
+func ProcessRewrite(obj, source *yaml.Node, patch *config.Patch, resolver NameResolver) error {
 	var namespace string
 	var err error
 
@@ -214,7 +218,7 @@ func ProcessRewrite(obj *yaml.Node, patch *config.Patch, resolver NameResolver)
 		if nameMatch.Kind != yaml.ScalarNode {
 			continue
 		}
-		err = ValidateAndTranslateName(obj, nameMatch, patch, resolver, namespace)
+		err = ValidateAndTranslateName(obj, source, nameMatch, patch, resolver, namespace)
 		if err != nil {
 			return err
 		}
@@ -231,7 +235,7 @@ func ProcessRewrite(obj *yaml.Node, patch *config.Patch, resolver NameResolver)
 			if namespaceMatch.Kind != yaml.ScalarNode {
 				continue
 			}
-			err = ValidateAndTranslateNamespace(obj, namespaceMatch, patch, resolver)
+			err = ValidateAndTranslateNamespace(obj, source, namespaceMatch, patch, resolver)
 			if err != nil {
 				return err
 			}
@@ -241,8 +245,8 @@ func ProcessRewrite(obj *yaml.Node, patch *config.Patch, resolver NameResolver)
 	return nil
 }
 
-func ValidateAndTranslateName(obj *yaml.Node, match *yaml.Node, patch *config.Patch, resolver NameResolver, namespace string) error {
-	validated, err := ValidateAllConditions(obj, match, patch.Conditions)
+func ValidateAndTranslateName(obj, source *yaml.Node, match *yaml.Node, patch *config.Patch, resolver NameResolver, namespace string) error {
+	validated, err := ValidateAllConditions(obj, source, match, patch.Conditions)
 	if err != nil {
 		return errors.Wrap(err, "validate conditions")
 	} else if !validated {
@@ -251,7 +255,8 @@ func ValidateAndTranslateName(obj *yaml.Node, match *yaml.Node, patch *config.Pa
 
 	var translatedName string
 
-	if namespace != "" {
+	// references with a namespace path or synced secrets and config maps always refer to namespaced objects
+	if namespace != "" || referencesNamespacedObject(patch) {
 		translatedName, err = resolver.TranslateNameWithNamespace(match.Value, namespace, patch.ParsedRegex, patch.FromPath)
 	} else {
 		translatedName, err = resolver.TranslateName(match.Value, patch.ParsedRegex, patch.FromPath)
@@ -271,8 +276,18 @@ func ValidateAndTranslateName(obj *yaml.Node, match *yaml.Node, patch *config.Pa
 	return nil
 }
 
-func ValidateAndTranslateNamespace(obj *yaml.Node, match *yaml.Node, patch *config.Patch, resolver NameResolver) error {
-	validated, err := ValidateAllConditions(obj, match, patch.Conditions)
+func referencesNamespacedObject(patch *config.Patch) bool {
+	if patch.NamespacePath != "" {
+		return true
+	} else if patch.Sync == nil {
+		return false
+	}
+
+	return (patch.Sync.Secret != nil && *patch.Sync.Secret) || (patch.Sync.ConfigMap != nil && *patch.Sync.ConfigMap)
+}
+
+func ValidateAndTranslateNamespace(obj, source *yaml.Node, match *yaml.Node, patch *config.Patch, resolver NameResolver) error {
+	validated, err := ValidateAllConditions(obj, source, match, patch.Conditions)
 	if err != nil {
 		return errors.Wrap(err, "validate conditions")
 	} else if !validated {
@@ -313,7 +328,7 @@ func GetNamespace(obj *yaml.Node, patch *config.Patch) (string, error) {
 	return namespace, nil
 }
 
-func RewriteLabelKey(obj1 *yaml.Node, patch *config.Patch, resolver NameResolver) error {
+func RewriteLabelKey(obj1, obj2 *yaml.Node, patch *config.Patch, resolver NameResolver) error {
 	matches, err := FindMatches(obj1, patch.Path)
 	if err != nil {
 		return errors.Wrap(err, "find matches")
@@ -321,7 +336,7 @@ func RewriteLabelKey(obj1 *yaml.Node, patch *config.Patch, resolver NameResolver
 
 	for _, m := range matches {
 		if m.Kind == yaml.ScalarNode {
-			validated, err := ValidateAllConditions(obj1, m, patch.Conditions)
+			validated, err := ValidateAllConditions(obj1, obj2, m, patch.Conditions)
 			if err != nil {
 				return errors.Wrap(err, "validate conditions")
 			} else if !validated {
@@ -351,7 +366,7 @@ func RewriteLabelKey(obj1 *yaml.Node, patch *config.Patch, resolver NameResolver
 	return nil
 }
 
-func RewriteLabelSelector(obj1 *yaml.Node, patch *config.Patch, resolver NameResolver) error {
+func RewriteLabelSelector(obj1, obj2 *yaml.Node, patch *config.Patch, resolver NameResolver) error {
 	matches, err := FindMatches(obj1, patch.Path)
 	if err != nil {
 		return errors.Wrap(err, "find matches")
@@ -359,7 +374,7 @@ func RewriteLabelSelector(obj1 *yaml.Node, patch *config.Patch, resolver NameRes
 
 	for _, m := range matches {
 		if m.Kind == yaml.MappingNode {
-			validated, err := ValidateAllConditions(obj1, m, patch.Conditions)
+			validated, err := ValidateAllConditions(obj1, obj2, m, patch.Conditions)
 			if err != nil {
 				return errors.Wrap(err, "validate conditions")
 			} else if !validated {
@@ -397,7 +412,7 @@ func RewriteLabelSelector(obj1 *yaml.Node, patch *config.Patch, resolver NameRes
 	return nil
 }
 
-func RewriteLabelExpressionsSelector(obj1 *yaml.Node, patch *config.Patch, resolver NameResolver) error {
+func RewriteLabelExpressionsSelector(obj1, obj2 *yaml.Node, patch *config.Patch, resolver NameResolver) error {
 	matches, err := FindMatches(obj1, patch.Path)
 	if err != nil {
 		return errors.Wrap(err, "find matches")
@@ -405,7 +420,7 @@ func RewriteLabelExpressionsSelector(obj1 *yaml.Node, patch *config.Patch, resol
 
 	for _, m := range matches {
 		if m.Kind == yaml.MappingNode {
-			validated, err := ValidateAllConditions(obj1, m, patch.Conditions)
+			validated, err := ValidateAllConditions(obj1, obj2, m, patch.Conditions)
 			if err != nil {
 				return errors.Wrap(err, "validate conditions")
 			} else if !validated {
@@ -0,0 +1,118 @@
+package patches
+
+import (
+	"fmt"
+	"regexp"
+
+	patchesregex "github.com/loft-sh/vcluster/pkg/patches/regex"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NewVirtualToHostNameResolver returns a name resolver that translates names of virtual objects to their host names.
+// Names without namespace are resolved within the given namespace. If clusterScoped is true, names without namespace
+// are resolved as cluster scoped objects, while references to namespaced objects require a namespace.
+func NewVirtualToHostNameResolver(namespace string, clusterScoped bool) NameResolver {
+	return &virtualToHostNameResolver{
+		namespace:     namespace,
+		clusterScoped: clusterScoped,
+	}
+}
+
+// NewHostToVirtualNameResolver returns a name resolver for patches on virtual objects, which don't support name translations
+func NewHostToVirtualNameResolver() NameResolver {
+	return &hostToVirtualNameResolver{}
+}
+
+type virtualToHostNameResolver struct {
+	namespace string
+
+	// clusterScoped is true if the object is cluster scoped, in which case names without namespace refer
+	// to other cluster scoped objects
+	clusterScoped bool
+}
+
+func (r *virtualToHostNameResolver) TranslateName(name string, regex *regexp.Regexp, _ string) (string, error) {
+	if r.clusterScoped && regex == nil {
+		return translate.Default.PhysicalNameClusterScoped(name), nil
+	}
+
+	return r.TranslateNameWithNamespace(name, r.namespace, regex, "")
+}
+
+func (r *virtualToHostNameResolver) TranslateNameWithNamespace(name string, namespace string, regex *regexp.Regexp, _ string) (string, error) {
+	if namespace == "" {
+		namespace = r.namespace
+	}
+
+	if regex != nil {
+		return patchesregex.ProcessRegex(regex, name, func(name, ns string) types.NamespacedName {
+			// if the regex match doesn't contain namespace - use the namespace set in this resolver
+			if ns == "" {
+				ns = namespace
+			}
+			if ns == "" && r.clusterScoped {
+				return types.NamespacedName{Name: translate.Default.PhysicalNameClusterScoped(name)}
+			}
+
+			return types.NamespacedName{
+				Namespace: translate.Default.PhysicalNamespace(namespace),
+				Name:      translate.Default.PhysicalName(name, ns),
+			}
+		}), nil
+	}
+
+	if namespace == "" && r.clusterScoped {
+		return "", fmt.Errorf("namespace is required to reference namespaced object %s from a cluster scoped object", name)
+	}
+
+	return translate.Default.PhysicalName(name, namespace), nil
+}
+
+func (r *virtualToHostNameResolver) TranslateLabelExpressionsSelector(selector *metav1.LabelSelector) (*metav1.LabelSelector, error) {
+	return translate.Default.TranslateLabelSelectorCluster(selector), nil
+}
+
+func (r *virtualToHostNameResolver) TranslateLabelKey(key string) (string, error) {
+	return translate.Default.ConvertLabelKey(key), nil
+}
+
+func (r *virtualToHostNameResolver) TranslateLabelSelector(selector map[string]string) (map[string]string, error) {
+	labelSelector := &metav1.LabelSelector{
+		MatchLabels: selector,
+	}
+
+	return metav1.LabelSelectorAsMap(
+		translate.Default.TranslateLabelSelector(labelSelector))
+}
+
+func (r *virtualToHostNameResolver) TranslateNamespaceRef(namespace string) (string, error) {
+	return translate.Default.PhysicalNamespace(namespace), nil
+}
+
+type hostToVirtualNameResolver struct{}
+
+func (r *hostToVirtualNameResolver) TranslateName(string, *regexp.Regexp, string) (string, error) {
+	return "", fmt.Errorf("translation not supported from host to virtual object")
+}
+
+func (r *hostToVirtualNameResolver) TranslateNameWithNamespace(string, string, *regexp.Regexp, string) (string, error) {
+	return "", fmt.Errorf("translation not supported from host to virtual object")
+}
+
+func (r *hostToVirtualNameResolver) TranslateLabelKey(string) (string, error) {
+	return "", fmt.Errorf("translation not supported from host to virtual object")
+}
+
+func (r *hostToVirtualNameResolver) TranslateLabelExpressionsSelector(*metav1.LabelSelector) (*metav1.LabelSelector, error) {
+	return nil, fmt.Errorf("translation not supported from host to virtual object")
+}
+
+func (r *hostToVirtualNameResolver) TranslateLabelSelector(map[string]string) (map[string]string, error) {
+	return nil, fmt.Errorf("translation not supported from host to virtual object")
+}
+
+func (r *hostToVirtualNameResolver) TranslateNamespaceRef(string) (string, error) {
+	return "", fmt.Errorf("translation not supported from host to virtual object")
+}
@@ -0,0 +1,105 @@
+package patches
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/loft-sh/vcluster/config"
+	patchesregex "github.com/loft-sh/vcluster/pkg/patches/regex"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	yaml "gopkg.in/yaml.v3"
+	"gotest.tools/v3/assert"
+)
+
+func TestVirtualToHostNameResolver(t *testing.T) {
+	translate.Default = translate.NewSingleNamespaceTranslator("host")
+
+	namespaced := NewVirtualToHostNameResolver("test", false)
+	name, err := namespaced.TranslateName("secret", nil, "")
+	assert.NilError(t, err)
+	assert.Equal(t, name, translate.Default.PhysicalName("secret", "test"))
+
+	name, err = namespaced.TranslateNameWithNamespace("secret", "other", nil, "")
+	assert.NilError(t, err)
+	assert.Equal(t, name, translate.Default.PhysicalName("secret", "other"))
+
+	name, err = namespaced.TranslateNameWithNamespace("secret", "", nil, "")
+	assert.NilError(t, err)
+	assert.Equal(t, name, translate.Default.PhysicalName("secret", "test"))
+
+	// names without namespace refer to other cluster scoped objects
+	clusterScoped := NewVirtualToHostNameResolver("", true)
+	name, err = clusterScoped.TranslateName("class", nil, "")
+	assert.NilError(t, err)
+	assert.Equal(t, name, translate.Default.PhysicalNameClusterScoped("class"))
+
+	// namespaced references keep their namespace
+	name, err = clusterScoped.TranslateNameWithNamespace("secret", "test", nil, "")
+	assert.NilError(t, err)
+	assert.Equal(t, name, translate.Default.PhysicalName("secret", "test"))
+
+	_, err = clusterScoped.TranslateNameWithNamespace("secret", "", nil, "")
+	assert.ErrorContains(t, err, "namespace is required")
+
+	regex, err := patchesregex.PrepareRegex("$NAMESPACE/$NAME")
+	assert.NilError(t, err)
+	name, err = clusterScoped.TranslateName("test/secret", regex, "")
+	assert.NilError(t, err)
+	assert.Equal(t, name, translate.Default.PhysicalNamespace("test")+"/"+translate.Default.PhysicalName("secret", "test"))
+}
+
+func TestRewriteNameClusterScoped(t *testing.T) {
+	translate.Default = translate.NewSingleNamespaceTranslator("host")
+	syncSecret := true
+
+	testCases := []struct {
+		name        string
+		patch       *config.Patch
+		obj         string
+		expected    string
+		expectedErr string
+	}{
+		{
+			name:     "cluster scoped reference",
+			patch:    &config.Patch{Operation: config.PatchTypeRewriteName, Path: "spec.className"},
+			obj:      `spec: {className: class}`,
+			expected: `spec: {className: ` + translate.Default.PhysicalNameClusterScoped("class") + `}`,
+		},
+		{
+			name:     "namespaced reference",
+			patch:    &config.Patch{Operation: config.PatchTypeRewriteName, Path: "spec.secretRef", NamePath: "name", NamespacePath: "namespace"},
+			obj:      `spec: {secretRef: {name: secret, namespace: test}}`,
+			expected: `spec: {secretRef: {name: ` + translate.Default.PhysicalName("secret", "test") + `, namespace: ` + translate.Default.PhysicalNamespace("test") + `}}`,
+		},
+		{
+			name:        "namespaced reference without namespace",
+			patch:       &config.Patch{Operation: config.PatchTypeRewriteName, Path: "spec.secretRef", NamePath: "name", NamespacePath: "namespace"},
+			obj:         `spec: {secretRef: {name: secret}}`,
+			expectedErr: "namespace is required",
+		},
+		{
+			name:        "synced secret without namespace",
+			patch:       &config.Patch{Operation: config.PatchTypeRewriteName, Path: "spec.secretName", Sync: &config.PatchSync{Secret: &syncSecret}},
+			obj:         `spec: {secretName: secret}`,
+			expectedErr: "namespace is required",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			obj, err := NewNodeFromString(testCase.obj)
+			assert.NilError(t, err)
+
+			err = applyPatch(obj, nil, testCase.patch, NewVirtualToHostNameResolver("", true))
+			if testCase.expectedErr != "" {
+				assert.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			assert.NilError(t, err)
+
+			out, err := yaml.Marshal(obj)
+			assert.NilError(t, err)
+			assert.Equal(t, strings.TrimSpace(string(out)), testCase.expected)
+		})
+	}
+}
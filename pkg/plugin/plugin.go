@@ -6,9 +6,11 @@ import (
 	"net/http"
 
 	"github.com/loft-sh/vcluster/pkg/config"
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
 	plugintypes "github.com/loft-sh/vcluster/pkg/plugin/types"
 	pluginv1 "github.com/loft-sh/vcluster/pkg/plugin/v1"
 	pluginv2 "github.com/loft-sh/vcluster/pkg/plugin/v2"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -87,6 +89,10 @@ func (m *manager) HasPlugins() bool {
 	return m.legacyManager.HasPlugins() || m.pluginManager.HasPlugins()
 }
 
+func (m *manager) NewSyncers(ctx *synccontext.RegisterContext) ([]syncertypes.Object, error) {
+	return m.pluginManager.NewSyncers(ctx)
+}
+
 func (m *manager) SetProFeatures(proFeatures map[string]bool) {
 	m.pluginManager.ProFeatures = proFeatures
 }
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/loft-sh/vcluster/pkg/config"
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -35,6 +37,9 @@ type Manager interface {
 	// HasPlugins returns if there are any plugins to start
 	HasPlugins() bool
 
+	// NewSyncers creates the syncers that were registered by the plugins
+	NewSyncers(ctx *synccontext.RegisterContext) ([]syncertypes.Object, error)
+
 	// SetProFeatures is used by vCluster.Pro to signal what pro features are enabled
 	SetProFeatures(proFeatures map[string]bool)
 	// WithInterceptors is a middleware that allows us to delegate some requests to out of
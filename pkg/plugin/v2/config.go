@@ -32,6 +32,7 @@ type InitConfigPro struct {
 type PluginConfig struct {
 	ClientHooks  []*ClientHook                `json:"clientHooks,omitempty"`
 	Interceptors map[string][]InterceptorRule `json:"interceptors,omitempty"`
+	Syncers      []*SyncerConfig              `json:"syncers,omitempty"`
 }
 
 type ClientHook struct {
@@ -40,6 +41,18 @@ type ClientHook struct {
 	Types      []string `json:"types,omitempty"`
 }
 
+// SyncerConfig registers a syncer whose sync logic is implemented by the plugin. The syncer itself
+// runs within vCluster and calls the plugin through the Sync rpc.
+type SyncerConfig struct {
+	Name       string `json:"name,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+
+	// NameTranslator signals that the plugin implements the TranslateName and IsManaged rpcs for this
+	// syncer. If false, the default vCluster name translation is used.
+	NameTranslator bool `json:"nameTranslator,omitempty"`
+}
+
 type InterceptorRule struct {
 	APIGroups       []string `json:"apiGroups,omitempty"`
 	Resources       []string `json:"resources,omitempty"`
@@ -0,0 +1,287 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/plugin/v2/pluginv2"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+var (
+	// HealthCheckInterval is the interval in which the plugins are checked
+	HealthCheckInterval = time.Second * 10
+
+	// RestartBackoff is the initial delay between restarts of a crashed plugin, it doubles with
+	// every failed restart up to MaxRestartBackoff
+	RestartBackoff    = time.Second * 5
+	MaxRestartBackoff = time.Minute * 5
+)
+
+type PluginPhase string
+
+const (
+	PluginPhaseRunning    PluginPhase = "Running"
+	PluginPhaseRestarting PluginPhase = "Restarting"
+	PluginPhaseFailed     PluginPhase = "Failed"
+	PluginPhaseDisabled   PluginPhase = "Disabled"
+)
+
+// PluginStatus is the status of a plugin that is written into the plugin status config map
+type PluginStatus struct {
+	Phase              PluginPhase `json:"phase,omitempty"`
+	Optional           bool        `json:"optional,omitempty"`
+	Restarts           int         `json:"restarts,omitempty"`
+	LastError          string      `json:"lastError,omitempty"`
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// StatusConfigMapName returns the name of the config map the plugin states of the given virtual cluster are written to
+func StatusConfigMapName(vClusterName string) string {
+	return "vc-plugins-" + vClusterName
+}
+
+func newVClusterPlugin(pluginPath string, vConfig *config.VirtualClusterConfig) *vClusterPlugin {
+	pluginName := filepath.Base(filepath.Dir(pluginPath))
+
+	optional := false
+	if legacyPlugin, ok := vConfig.Plugin[pluginName]; ok {
+		optional = legacyPlugin.Optional
+	}
+	if newPlugin, ok := vConfig.Plugins[pluginName]; ok {
+		optional = newPlugin.Optional
+	}
+
+	return &vClusterPlugin{
+		Path:     pluginPath,
+		Name:     pluginName,
+		Optional: optional,
+		backoff:  RestartBackoff,
+	}
+}
+
+// Phase returns the current phase of the plugin
+func (p *vClusterPlugin) Phase() PluginPhase {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return p.status.Phase
+}
+
+func (p *vClusterPlugin) getStatus() PluginStatus {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	status := p.status
+	status.Optional = p.Optional
+	return status
+}
+
+func (p *vClusterPlugin) setStatus(phase PluginPhase, err error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.status.Phase != phase {
+		p.status.LastTransitionTime = metav1.Now()
+	}
+	p.status.Phase = phase
+	if err != nil {
+		p.status.LastError = err.Error()
+	}
+}
+
+// grpcClient returns the grpc client of the plugin if the plugin is running
+func (p *vClusterPlugin) grpcClient() (pluginv2.PluginClient, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.status.Phase != PluginPhaseRunning {
+		return nil, fmt.Errorf("plugin %s is not running (%s)", p.Path, p.status.Phase)
+	}
+
+	return p.GRPCClient, nil
+}
+
+func (p *vClusterPlugin) setLeader(ctx context.Context) error {
+	grpcClient, err := p.grpcClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = grpcClient.SetLeader(ctx, &pluginv2.SetLeader_Request{})
+	if err != nil {
+		return fmt.Errorf("error setting leader in plugin %s: %w", p.Path, err)
+	}
+
+	return nil
+}
+
+// checkHealth returns an error if the plugin process exited or does not respond to pings
+func (p *vClusterPlugin) checkHealth() error {
+	p.m.Lock()
+	pluginClient, protocol := p.Client, p.protocol
+	p.m.Unlock()
+
+	if pluginClient.Exited() {
+		return fmt.Errorf("plugin process exited")
+	}
+
+	return protocol.Ping()
+}
+
+func (m *Manager) startHealthChecks(ctx context.Context, vConfig *config.VirtualClusterConfig) error {
+	if len(m.Plugins) == 0 {
+		return nil
+	}
+
+	if vConfig.WorkloadConfig != nil {
+		statusClient, err := kubernetes.NewForConfig(vConfig.WorkloadConfig)
+		if err != nil {
+			return err
+		}
+
+		m.statusClient = statusClient
+		m.statusNamespace = vConfig.WorkloadNamespace
+		m.statusConfigMapName = StatusConfigMapName(vConfig.Name)
+	}
+
+	go wait.UntilWithContext(ctx, m.checkPlugins, HealthCheckInterval)
+	return nil
+}
+
+// checkPlugins checks the health of all plugins and restarts crashed plugins with an exponential backoff
+func (m *Manager) checkPlugins(ctx context.Context) {
+	changed := false
+	for _, vClusterPlugin := range m.Plugins {
+		phase := vClusterPlugin.Phase()
+		if phase == PluginPhaseDisabled {
+			continue
+		}
+
+		if phase == PluginPhaseRunning {
+			err := vClusterPlugin.checkHealth()
+			if err == nil {
+				vClusterPlugin.m.Lock()
+				vClusterPlugin.backoff = RestartBackoff
+				vClusterPlugin.m.Unlock()
+				continue
+			}
+
+			klog.FromContext(ctx).Error(err, "Plugin is unhealthy, restarting", "plugin", vClusterPlugin.Path, "optional", vClusterPlugin.Optional)
+			vClusterPlugin.setStatus(PluginPhaseRestarting, err)
+			changed = true
+		} else {
+			// wait for the backoff after the last restart attempt before trying again
+			vClusterPlugin.m.Lock()
+			waitUntil := vClusterPlugin.lastRestart.Add(vClusterPlugin.backoff)
+			vClusterPlugin.m.Unlock()
+			if time.Now().Before(waitUntil) {
+				continue
+			}
+		}
+
+		changed = true
+		err := m.restartPlugin(ctx, vClusterPlugin)
+		if err != nil {
+			klog.FromContext(ctx).Error(err, "Error restarting plugin", "plugin", vClusterPlugin.Path)
+
+			vClusterPlugin.m.Lock()
+			vClusterPlugin.backoff = min(vClusterPlugin.backoff*2, MaxRestartBackoff)
+			vClusterPlugin.m.Unlock()
+			vClusterPlugin.setStatus(PluginPhaseFailed, err)
+			continue
+		}
+
+		klog.FromContext(ctx).Info("Successfully restarted plugin", "plugin", vClusterPlugin.Path)
+	}
+
+	if changed {
+		m.updateStatus(ctx)
+	}
+}
+
+// restartPlugin starts a new plugin process and initializes it with the original init request. The plugin
+// keeps its client hooks, syncers and interceptors, as these cannot change at runtime.
+func (m *Manager) restartPlugin(ctx context.Context, vClusterPlugin *vClusterPlugin) error {
+	vClusterPlugin.m.Lock()
+	vClusterPlugin.status.Restarts++
+	vClusterPlugin.lastRestart = time.Now()
+	oldClient := vClusterPlugin.Client
+	vClusterPlugin.m.Unlock()
+	if oldClient != nil {
+		oldClient.Kill()
+	}
+
+	err := m.loadPlugin(vClusterPlugin, m.vConfig)
+	if err != nil {
+		return fmt.Errorf("load plugin: %w", err)
+	}
+
+	vClusterPlugin.m.Lock()
+	pluginClient, grpcClient := vClusterPlugin.Client, vClusterPlugin.GRPCClient
+	vClusterPlugin.m.Unlock()
+
+	_, err = grpcClient.Initialize(ctx, vClusterPlugin.initRequest)
+	if err != nil {
+		pluginClient.Kill()
+		return fmt.Errorf("initialize plugin: %w", err)
+	}
+
+	vClusterPlugin.setStatus(PluginPhaseRunning, nil)
+	if m.isLeader.Load() {
+		err = vClusterPlugin.setLeader(ctx)
+		if err != nil {
+			pluginClient.Kill()
+			vClusterPlugin.setStatus(PluginPhaseRestarting, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateStatus writes the status of all plugins into a config map in the host namespace. Only the
+// leader writes the status to avoid conflicts between replicas.
+func (m *Manager) updateStatus(ctx context.Context) {
+	if m.statusClient == nil || !m.isLeader.Load() {
+		return
+	}
+
+	data := map[string]string{}
+	for _, vClusterPlugin := range m.Plugins {
+		out, err := json.Marshal(vClusterPlugin.getStatus())
+		if err != nil {
+			klog.FromContext(ctx).Error(err, "encode plugin status", "plugin", vClusterPlugin.Path)
+			continue
+		}
+
+		data[vClusterPlugin.Name] = string(out)
+	}
+
+	configMaps := m.statusClient.CoreV1().ConfigMaps(m.statusNamespace)
+	configMap, err := configMaps.Get(ctx, m.statusConfigMapName, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      m.statusConfigMapName,
+				Namespace: m.statusNamespace,
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+	} else if err == nil {
+		configMap.Data = data
+		_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "update plugin status config map", "configMap", m.statusNamespace+"/"+m.statusConfigMapName)
+	}
+}
@@ -0,0 +1,107 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/config"
+)
+
+func TestNewVClusterPluginOptional(t *testing.T) {
+	vConfig := &config.VirtualClusterConfig{
+		Config: vclusterconfig.Config{
+			Plugin: map[string]vclusterconfig.Plugin{
+				"legacy": {Plugins: vclusterconfig.Plugins{Optional: true}},
+			},
+			Plugins: map[string]vclusterconfig.Plugins{
+				"optional": {Optional: true},
+				"required": {},
+			},
+		},
+	}
+
+	testCases := []struct {
+		path         string
+		wantName     string
+		wantOptional bool
+	}{
+		{path: "/plugins/legacy/plugin", wantName: "legacy", wantOptional: true},
+		{path: "/plugins/optional/plugin", wantName: "optional", wantOptional: true},
+		{path: "/plugins/required/plugin", wantName: "required"},
+		{path: "/plugins/unknown/plugin", wantName: "unknown"},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.wantName, func(t *testing.T) {
+			p := newVClusterPlugin(tC.path, vConfig)
+			if p.Name != tC.wantName {
+				t.Errorf("expected name %s, got %s", tC.wantName, p.Name)
+			}
+			if p.Optional != tC.wantOptional {
+				t.Errorf("expected optional %v, got %v", tC.wantOptional, p.Optional)
+			}
+		})
+	}
+}
+
+func TestPluginStatus(t *testing.T) {
+	p := newVClusterPlugin("/plugins/test/plugin", &config.VirtualClusterConfig{})
+
+	// plugins that are not running must not be called
+	_, err := p.grpcClient()
+	if err == nil {
+		t.Fatalf("expected error for plugin that is not running")
+	}
+
+	p.setStatus(PluginPhaseRunning, nil)
+	_, err = p.grpcClient()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	runningSince := p.getStatus().LastTransitionTime
+
+	p.setStatus(PluginPhaseRestarting, errors.New("plugin process exited"))
+	status := p.getStatus()
+	if status.Phase != PluginPhaseRestarting {
+		t.Errorf("expected phase %s, got %s", PluginPhaseRestarting, status.Phase)
+	}
+	if status.LastError != "plugin process exited" {
+		t.Errorf("expected last error to be set, got %q", status.LastError)
+	}
+	if status.LastTransitionTime.Before(&runningSince) {
+		t.Errorf("expected transition time to be updated")
+	}
+	_, err = p.grpcClient()
+	if err == nil {
+		t.Fatalf("expected error for restarting plugin")
+	}
+}
+
+func TestCheckPluginsBackoff(t *testing.T) {
+	m := NewManager()
+	m.vConfig = &config.VirtualClusterConfig{}
+
+	p := newVClusterPlugin(filepath.Join(t.TempDir(), "missing", "plugin"), m.vConfig)
+	p.setStatus(PluginPhaseFailed, errors.New("plugin process exited"))
+	p.lastRestart = time.Now().Add(-RestartBackoff * 2)
+	m.Plugins = []*vClusterPlugin{p}
+
+	// the backoff is over, so the plugin is restarted and fails again
+	m.checkPlugins(context.TODO())
+	status := p.getStatus()
+	if status.Restarts != 1 || status.Phase != PluginPhaseFailed {
+		t.Fatalf("expected a failed restart, got %d restarts in phase %s", status.Restarts, status.Phase)
+	}
+
+	// the failed restart starts a new and longer backoff, even though the phase did not change
+	m.checkPlugins(context.TODO())
+	if restarts := p.getStatus().Restarts; restarts != 1 {
+		t.Fatalf("expected no restart during the backoff, got %d restarts", restarts)
+	}
+	if p.backoff != RestartBackoff*2 {
+		t.Fatalf("expected backoff %s, got %s", RestartBackoff*2, p.backoff)
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ghodss/yaml"
@@ -24,6 +26,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/util/kubeconfig"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog/v2"
@@ -42,6 +45,7 @@ func NewManager() *Manager {
 	return &Manager{
 		PluginFolder:                 pluginFolder,
 		ClientHooks:                  map[plugintypes.VersionKindType][]*vClusterPlugin{},
+		Syncers:                      map[string]*pluginSyncer{},
 		ResourceInterceptorsPorts:    map[string]map[string]map[string]map[string]portHandlerName{},
 		NonResourceInterceptorsPorts: map[string]map[string]portHandlerName{},
 	}
@@ -57,6 +61,9 @@ type Manager struct {
 	// ClientHooks that were loaded
 	ClientHooks map[plugintypes.VersionKindType][]*vClusterPlugin
 
+	// Syncers that were registered by the plugins
+	Syncers map[string]*pluginSyncer
+
 	// map to track the port that needs to be targeted for the interceptors
 	// structure is group>resource>verb>resourceName
 	ResourceInterceptorsPorts map[string]map[string]map[string]map[string]portHandlerName
@@ -64,6 +71,17 @@ type Manager struct {
 	NonResourceInterceptorsPorts map[string]map[string]portHandlerName
 	// ProFeatures are pro features to hand-over to the plugin
 	ProFeatures map[string]bool
+
+	// isLeader is true if the syncer acquired leadership
+	isLeader atomic.Bool
+
+	// vConfig is the config the plugins were started with
+	vConfig *config.VirtualClusterConfig
+
+	// statusClient is used to write the plugin status config map
+	statusClient        kubernetes.Interface
+	statusNamespace     string
+	statusConfigMapName string
 }
 
 type portHandlerName struct {
@@ -75,11 +93,28 @@ type vClusterPlugin struct {
 	// Path is the path where the plugin was loaded from
 	Path string
 
+	// Name is the name of the plugin
+	Name string
+
+	// Optional signals that vCluster keeps running if the plugin fails
+	Optional bool
+
 	// Client is the plugin client
 	Client *plugin.Client
 
 	// GRPCClient is the direct grpc client
 	GRPCClient pluginv2.PluginClient
+
+	// protocol is the go-plugin connection used for health checks
+	protocol plugin.ClientProtocol
+
+	// initRequest is the request the plugin was initialized with, it is reused when the plugin is restarted
+	initRequest *pluginv2.Initialize_Request
+
+	m           sync.Mutex
+	status      PluginStatus
+	backoff     time.Duration
+	lastRestart time.Time
 }
 
 func (m *Manager) Start(
@@ -96,55 +131,108 @@ func (m *Manager) Start(
 	}
 
 	// loop over plugins and load them
+	m.vConfig = vConfig
 	for _, pluginPath := range plugins {
-		err = m.loadPlugin(pluginPath, vConfig)
+		vClusterPlugin := newVClusterPlugin(pluginPath, vConfig)
+		m.Plugins = append(m.Plugins, vClusterPlugin)
+
+		err = m.loadPlugin(vClusterPlugin, vConfig)
 		if err != nil {
-			return fmt.Errorf("start plugin %s: %w", pluginPath, err)
+			if !vClusterPlugin.Optional {
+				return fmt.Errorf("start plugin %s: %w", pluginPath, err)
+			}
+
+			// optional plugins that cannot be loaded are disabled
+			klog.FromContext(ctx).Error(err, "Error loading optional plugin, continuing without it", "plugin", pluginPath)
+			vClusterPlugin.setStatus(PluginPhaseDisabled, err)
 		}
 	}
 
 	port := 13370
 	// after loading all plugins we start them
 	for _, vClusterPlugin := range m.Plugins {
-		// build the start request
-		initRequest, err := m.buildInitRequest(filepath.Dir(vClusterPlugin.Path), syncerConfig, vConfig, port)
-		if err != nil {
-			return fmt.Errorf("build start request: %w", err)
+		if vClusterPlugin.Phase() == PluginPhaseDisabled {
+			port++
+			continue
 		}
 
-		// start the plugin
-		_, err = vClusterPlugin.GRPCClient.Initialize(ctx, initRequest)
+		err = m.initializePlugin(ctx, vClusterPlugin, syncerConfig, vConfig, port)
 		if err != nil {
-			return fmt.Errorf("error starting plugin %s: %w", vClusterPlugin.Path, err)
-		}
+			if !vClusterPlugin.Optional {
+				return err
+			}
 
-		// get plugin config
-		pluginConfigResponse, err := vClusterPlugin.GRPCClient.GetPluginConfig(ctx, &pluginv2.GetPluginConfig_Request{})
-		if err != nil {
-			return fmt.Errorf("error retrieving client hooks for plugin %s: %w", vClusterPlugin.Path, err)
+			// the client hooks, syncers and interceptors of the plugin are unknown, so we disable it
+			klog.FromContext(ctx).Error(err, "Error initializing optional plugin, continuing without it", "plugin", vClusterPlugin.Path)
+			vClusterPlugin.Client.Kill()
+			vClusterPlugin.setStatus(PluginPhaseDisabled, err)
+			port++
+			continue
 		}
 
-		// parse plugin config
-		pluginConfig, err := parsePluginConfig(pluginConfigResponse.Config)
-		if err != nil {
-			return fmt.Errorf("error parsing plugin config: %w", err)
-		}
+		klog.FromContext(ctx).Info("Successfully loaded plugin", "plugin", vClusterPlugin.Path)
+		vClusterPlugin.setStatus(PluginPhaseRunning, nil)
 
-		// register client hooks
-		err = m.registerClientHooks(vClusterPlugin, pluginConfig.ClientHooks)
-		if err != nil {
-			return fmt.Errorf("error adding client hook for plugin %s: %w", vClusterPlugin.Path, err)
-		}
+		port++
+	}
 
-		// register Interceptors
-		err = m.registerInterceptors(pluginConfig.Interceptors, port)
-		if err != nil {
-			return fmt.Errorf("error adding interceptor for plugin %s: %w", vClusterPlugin.Path, err)
-		}
+	// watch the plugins and restart them if they crash
+	err = m.startHealthChecks(ctx, vConfig)
+	if err != nil {
+		return fmt.Errorf("start plugin health checks: %w", err)
+	}
 
-		klog.FromContext(ctx).Info("Successfully loaded plugin", "plugin", vClusterPlugin.Path)
+	return nil
+}
 
-		port++
+func (m *Manager) initializePlugin(
+	ctx context.Context,
+	vClusterPlugin *vClusterPlugin,
+	syncerConfig *clientcmdapi.Config,
+	vConfig *config.VirtualClusterConfig,
+	port int,
+) error {
+	// build the start request
+	initRequest, err := m.buildInitRequest(filepath.Dir(vClusterPlugin.Path), syncerConfig, vConfig, port)
+	if err != nil {
+		return fmt.Errorf("build start request: %w", err)
+	}
+	vClusterPlugin.initRequest = initRequest
+
+	// start the plugin
+	_, err = vClusterPlugin.GRPCClient.Initialize(ctx, initRequest)
+	if err != nil {
+		return fmt.Errorf("error starting plugin %s: %w", vClusterPlugin.Path, err)
+	}
+
+	// get plugin config
+	pluginConfigResponse, err := vClusterPlugin.GRPCClient.GetPluginConfig(ctx, &pluginv2.GetPluginConfig_Request{})
+	if err != nil {
+		return fmt.Errorf("error retrieving client hooks for plugin %s: %w", vClusterPlugin.Path, err)
+	}
+
+	// parse plugin config
+	pluginConfig, err := parsePluginConfig(pluginConfigResponse.Config)
+	if err != nil {
+		return fmt.Errorf("error parsing plugin config: %w", err)
+	}
+
+	// register client hooks
+	err = m.registerClientHooks(vClusterPlugin, pluginConfig.ClientHooks)
+	if err != nil {
+		return fmt.Errorf("error adding client hook for plugin %s: %w", vClusterPlugin.Path, err)
+	}
+
+	// register syncers
+	err = m.registerSyncers(vClusterPlugin, pluginConfig.Syncers)
+	if err != nil {
+		return fmt.Errorf("error adding syncer for plugin %s: %w", vClusterPlugin.Path, err)
+	}
+
+	// register Interceptors
+	err = m.registerInterceptors(pluginConfig.Interceptors, port)
+	if err != nil {
+		return fmt.Errorf("error adding interceptor for plugin %s: %w", vClusterPlugin.Path, err)
 	}
 
 	return nil
@@ -272,10 +360,18 @@ func (m *Manager) MutateObject(ctx context.Context, obj client.Object, hookType
 	}
 
 	for _, clientHook := range clientHooks {
-		encodedObj, err = m.mutateObject(ctx, versionKindType, encodedObj, clientHook)
+		mutatedObj, err := m.mutateObject(ctx, versionKindType, encodedObj, clientHook)
 		if err != nil {
+			// optional plugins should not block vCluster
+			if clientHook.Optional {
+				klog.FromContext(ctx).Error(err, "Skip mutation of optional plugin", "plugin", clientHook.Path)
+				continue
+			}
+
 			return err
 		}
+
+		encodedObj = mutatedObj
 	}
 
 	err = json.Unmarshal(encodedObj, obj)
@@ -290,8 +386,13 @@ func (m *Manager) mutateObject(ctx context.Context, versionKindType plugintypes.
 	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
 	defer cancel()
 
+	grpcClient, err := plugin.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+
 	klog.FromContext(ctx).V(1).Info("calling plugin to mutate object", "plugin", plugin.Path, "apiVersion", versionKindType.APIVersion, "kind", versionKindType.Kind)
-	mutateResult, err := plugin.GRPCClient.Mutate(ctx, &pluginv2.Mutate_Request{
+	mutateResult, err := grpcClient.Mutate(ctx, &pluginv2.Mutate_Request{
 		ApiVersion: versionKindType.APIVersion,
 		Kind:       versionKindType.Kind,
 		Object:     string(obj),
@@ -308,13 +409,22 @@ func (m *Manager) mutateObject(ctx context.Context, versionKindType plugintypes.
 }
 
 func (m *Manager) SetLeader(ctx context.Context) error {
+	// plugins that are restarted from now on are signaled as well
+	m.isLeader.Store(true)
+
 	for _, vClusterPlugin := range m.Plugins {
-		_, err := vClusterPlugin.GRPCClient.SetLeader(ctx, &pluginv2.SetLeader_Request{})
+		err := vClusterPlugin.setLeader(ctx)
 		if err != nil {
-			return fmt.Errorf("error setting leader in plugin %s: %w", vClusterPlugin.Path, err)
+			if vClusterPlugin.Optional {
+				klog.FromContext(ctx).Error(err, "Error setting leader in optional plugin", "plugin", vClusterPlugin.Path)
+				continue
+			}
+
+			return err
 		}
 	}
 
+	m.updateStatus(ctx)
 	return nil
 }
 
@@ -697,7 +807,7 @@ func (m *Manager) buildInitRequest(
 	}, nil
 }
 
-func (m *Manager) loadPlugin(pluginPath string, vConfig *config.VirtualClusterConfig) error {
+func (m *Manager) loadPlugin(vClusterPlugin *vClusterPlugin, vConfig *config.VirtualClusterConfig) error {
 	// Create an hclog.Logger
 	logger := hclog.New(&hclog.LoggerOptions{
 		Name:   "plugin",
@@ -706,7 +816,7 @@ func (m *Manager) loadPlugin(pluginPath string, vConfig *config.VirtualClusterCo
 	})
 
 	// build command
-	cmd, err := buildCommand(pluginPath, vConfig)
+	cmd, err := buildCommand(vClusterPlugin.Path, vConfig)
 	if err != nil {
 		return err
 	}
@@ -739,13 +849,12 @@ func (m *Manager) loadPlugin(pluginPath string, vConfig *config.VirtualClusterCo
 		return err
 	}
 
-	// add to loaded plugins
-	m.Plugins = append(m.Plugins, &vClusterPlugin{
-		Path:       pluginPath,
-		Client:     pluginClient,
-		GRPCClient: raw.(pluginv2.PluginClient),
-	})
+	vClusterPlugin.m.Lock()
+	defer vClusterPlugin.m.Unlock()
 
+	vClusterPlugin.Client = pluginClient
+	vClusterPlugin.GRPCClient = raw.(pluginv2.PluginClient)
+	vClusterPlugin.protocol = rpcClient
 	return nil
 }
 
@@ -2,7 +2,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.1
+// 	protoc-gen-go v1.34.1
 // 	protoc        v4.25.3
 // source: pluginv2.proto
 
@@ -137,6 +137,120 @@ func (*Mutate) Descriptor() ([]byte, []int) {
 	return file_pluginv2_proto_rawDescGZIP(), []int{2}
 }
 
+type Sync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Sync) Reset() {
+	*x = Sync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sync) ProtoMessage() {}
+
+func (x *Sync) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sync.ProtoReflect.Descriptor instead.
+func (*Sync) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{3}
+}
+
+type TranslateName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TranslateName) Reset() {
+	*x = TranslateName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslateName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslateName) ProtoMessage() {}
+
+func (x *TranslateName) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslateName.ProtoReflect.Descriptor instead.
+func (*TranslateName) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{4}
+}
+
+type IsManaged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *IsManaged) Reset() {
+	*x = IsManaged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsManaged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsManaged) ProtoMessage() {}
+
+func (x *IsManaged) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsManaged.ProtoReflect.Descriptor instead.
+func (*IsManaged) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{5}
+}
+
 type SetLeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -146,7 +260,7 @@ type SetLeader struct {
 func (x *SetLeader) Reset() {
 	*x = SetLeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[3]
+		mi := &file_pluginv2_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -159,7 +273,7 @@ func (x *SetLeader) String() string {
 func (*SetLeader) ProtoMessage() {}
 
 func (x *SetLeader) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[3]
+	mi := &file_pluginv2_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -172,7 +286,7 @@ func (x *SetLeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLeader.ProtoReflect.Descriptor instead.
 func (*SetLeader) Descriptor() ([]byte, []int) {
-	return file_pluginv2_proto_rawDescGZIP(), []int{3}
+	return file_pluginv2_proto_rawDescGZIP(), []int{6}
 }
 
 type Initialize_Request struct {
@@ -186,7 +300,7 @@ type Initialize_Request struct {
 func (x *Initialize_Request) Reset() {
 	*x = Initialize_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[4]
+		mi := &file_pluginv2_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -199,7 +313,7 @@ func (x *Initialize_Request) String() string {
 func (*Initialize_Request) ProtoMessage() {}
 
 func (x *Initialize_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[4]
+	mi := &file_pluginv2_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +345,7 @@ type Initialize_Response struct {
 func (x *Initialize_Response) Reset() {
 	*x = Initialize_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[5]
+		mi := &file_pluginv2_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -244,7 +358,7 @@ func (x *Initialize_Response) String() string {
 func (*Initialize_Response) ProtoMessage() {}
 
 func (x *Initialize_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[5]
+	mi := &file_pluginv2_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +383,7 @@ type GetPluginConfig_Request struct {
 func (x *GetPluginConfig_Request) Reset() {
 	*x = GetPluginConfig_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[6]
+		mi := &file_pluginv2_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -282,7 +396,7 @@ func (x *GetPluginConfig_Request) String() string {
 func (*GetPluginConfig_Request) ProtoMessage() {}
 
 func (x *GetPluginConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[6]
+	mi := &file_pluginv2_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +423,7 @@ type GetPluginConfig_Response struct {
 func (x *GetPluginConfig_Response) Reset() {
 	*x = GetPluginConfig_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[7]
+		mi := &file_pluginv2_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -322,7 +436,7 @@ func (x *GetPluginConfig_Response) String() string {
 func (*GetPluginConfig_Response) ProtoMessage() {}
 
 func (x *GetPluginConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[7]
+	mi := &file_pluginv2_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,15 +465,15 @@ type Mutate_Request struct {
 	unknownFields protoimpl.UnknownFields
 
 	ApiVersion string `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
-	Kind       string `protobuf:"bytes,2,opt,name=kind,proto3"       json:"kind,omitempty"`
-	Object     string `protobuf:"bytes,3,opt,name=object,proto3"     json:"object,omitempty"`
-	Type       string `protobuf:"bytes,4,opt,name=type,proto3"       json:"type,omitempty"`
+	Kind       string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Object     string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	Type       string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
 }
 
 func (x *Mutate_Request) Reset() {
 	*x = Mutate_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[8]
+		mi := &file_pluginv2_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -372,7 +486,7 @@ func (x *Mutate_Request) String() string {
 func (*Mutate_Request) ProtoMessage() {}
 
 func (x *Mutate_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[8]
+	mi := &file_pluginv2_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,14 +535,14 @@ type Mutate_Response struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Object  string `protobuf:"bytes,1,opt,name=object,proto3"   json:"object,omitempty"`
+	Object  string `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	Mutated bool   `protobuf:"varint,2,opt,name=mutated,proto3" json:"mutated,omitempty"`
 }
 
 func (x *Mutate_Response) Reset() {
 	*x = Mutate_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[9]
+		mi := &file_pluginv2_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -441,7 +555,7 @@ func (x *Mutate_Response) String() string {
 func (*Mutate_Response) ProtoMessage() {}
 
 func (x *Mutate_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[9]
+	mi := &file_pluginv2_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,6 +585,400 @@ func (x *Mutate_Response) GetMutated() bool {
 	return false
 }
 
+type Sync_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Syncer        string `protobuf:"bytes,1,opt,name=syncer,proto3" json:"syncer,omitempty"`
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	VirtualObject string `protobuf:"bytes,3,opt,name=virtualObject,proto3" json:"virtualObject,omitempty"`
+	HostObject    string `protobuf:"bytes,4,opt,name=hostObject,proto3" json:"hostObject,omitempty"`
+}
+
+func (x *Sync_Request) Reset() {
+	*x = Sync_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sync_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sync_Request) ProtoMessage() {}
+
+func (x *Sync_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sync_Request.ProtoReflect.Descriptor instead.
+func (*Sync_Request) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Sync_Request) GetSyncer() string {
+	if x != nil {
+		return x.Syncer
+	}
+	return ""
+}
+
+func (x *Sync_Request) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Sync_Request) GetVirtualObject() string {
+	if x != nil {
+		return x.VirtualObject
+	}
+	return ""
+}
+
+func (x *Sync_Request) GetHostObject() string {
+	if x != nil {
+		return x.HostObject
+	}
+	return ""
+}
+
+type Sync_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VirtualObject       string `protobuf:"bytes,1,opt,name=virtualObject,proto3" json:"virtualObject,omitempty"`
+	HostObject          string `protobuf:"bytes,2,opt,name=hostObject,proto3" json:"hostObject,omitempty"`
+	DeleteVirtual       bool   `protobuf:"varint,3,opt,name=deleteVirtual,proto3" json:"deleteVirtual,omitempty"`
+	DeleteHost          bool   `protobuf:"varint,4,opt,name=deleteHost,proto3" json:"deleteHost,omitempty"`
+	Requeue             bool   `protobuf:"varint,5,opt,name=requeue,proto3" json:"requeue,omitempty"`
+	RequeueAfterSeconds int64  `protobuf:"varint,6,opt,name=requeueAfterSeconds,proto3" json:"requeueAfterSeconds,omitempty"`
+}
+
+func (x *Sync_Response) Reset() {
+	*x = Sync_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sync_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sync_Response) ProtoMessage() {}
+
+func (x *Sync_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sync_Response.ProtoReflect.Descriptor instead.
+func (*Sync_Response) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *Sync_Response) GetVirtualObject() string {
+	if x != nil {
+		return x.VirtualObject
+	}
+	return ""
+}
+
+func (x *Sync_Response) GetHostObject() string {
+	if x != nil {
+		return x.HostObject
+	}
+	return ""
+}
+
+func (x *Sync_Response) GetDeleteVirtual() bool {
+	if x != nil {
+		return x.DeleteVirtual
+	}
+	return false
+}
+
+func (x *Sync_Response) GetDeleteHost() bool {
+	if x != nil {
+		return x.DeleteHost
+	}
+	return false
+}
+
+func (x *Sync_Response) GetRequeue() bool {
+	if x != nil {
+		return x.Requeue
+	}
+	return false
+}
+
+func (x *Sync_Response) GetRequeueAfterSeconds() int64 {
+	if x != nil {
+		return x.RequeueAfterSeconds
+	}
+	return 0
+}
+
+type TranslateName_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Syncer    string `protobuf:"bytes,1,opt,name=syncer,proto3" json:"syncer,omitempty"`
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Object    string `protobuf:"bytes,5,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *TranslateName_Request) Reset() {
+	*x = TranslateName_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslateName_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslateName_Request) ProtoMessage() {}
+
+func (x *TranslateName_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslateName_Request.ProtoReflect.Descriptor instead.
+func (*TranslateName_Request) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *TranslateName_Request) GetSyncer() string {
+	if x != nil {
+		return x.Syncer
+	}
+	return ""
+}
+
+func (x *TranslateName_Request) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *TranslateName_Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TranslateName_Request) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *TranslateName_Request) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+type TranslateName_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *TranslateName_Response) Reset() {
+	*x = TranslateName_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranslateName_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslateName_Response) ProtoMessage() {}
+
+func (x *TranslateName_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslateName_Response.ProtoReflect.Descriptor instead.
+func (*TranslateName_Response) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *TranslateName_Response) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TranslateName_Response) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type IsManaged_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Syncer string `protobuf:"bytes,1,opt,name=syncer,proto3" json:"syncer,omitempty"`
+	Object string `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *IsManaged_Request) Reset() {
+	*x = IsManaged_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsManaged_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsManaged_Request) ProtoMessage() {}
+
+func (x *IsManaged_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsManaged_Request.ProtoReflect.Descriptor instead.
+func (*IsManaged_Request) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *IsManaged_Request) GetSyncer() string {
+	if x != nil {
+		return x.Syncer
+	}
+	return ""
+}
+
+func (x *IsManaged_Request) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+type IsManaged_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Managed bool `protobuf:"varint,1,opt,name=managed,proto3" json:"managed,omitempty"`
+}
+
+func (x *IsManaged_Response) Reset() {
+	*x = IsManaged_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluginv2_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsManaged_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsManaged_Response) ProtoMessage() {}
+
+func (x *IsManaged_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_pluginv2_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsManaged_Response.ProtoReflect.Descriptor instead.
+func (*IsManaged_Response) Descriptor() ([]byte, []int) {
+	return file_pluginv2_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *IsManaged_Response) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
+}
+
 type SetLeader_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -480,7 +988,7 @@ type SetLeader_Request struct {
 func (x *SetLeader_Request) Reset() {
 	*x = SetLeader_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[10]
+		mi := &file_pluginv2_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -493,7 +1001,7 @@ func (x *SetLeader_Request) String() string {
 func (*SetLeader_Request) ProtoMessage() {}
 
 func (x *SetLeader_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[10]
+	mi := &file_pluginv2_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,7 +1014,7 @@ func (x *SetLeader_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLeader_Request.ProtoReflect.Descriptor instead.
 func (*SetLeader_Request) Descriptor() ([]byte, []int) {
-	return file_pluginv2_proto_rawDescGZIP(), []int{3, 0}
+	return file_pluginv2_proto_rawDescGZIP(), []int{6, 0}
 }
 
 type SetLeader_Response struct {
@@ -518,7 +1026,7 @@ type SetLeader_Response struct {
 func (x *SetLeader_Response) Reset() {
 	*x = SetLeader_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pluginv2_proto_msgTypes[11]
+		mi := &file_pluginv2_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -531,7 +1039,7 @@ func (x *SetLeader_Response) String() string {
 func (*SetLeader_Response) ProtoMessage() {}
 
 func (x *SetLeader_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_pluginv2_proto_msgTypes[11]
+	mi := &file_pluginv2_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +1052,7 @@ func (x *SetLeader_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLeader_Response.ProtoReflect.Descriptor instead.
 func (*SetLeader_Response) Descriptor() ([]byte, []int) {
-	return file_pluginv2_proto_rawDescGZIP(), []int{3, 1}
+	return file_pluginv2_proto_rawDescGZIP(), []int{6, 1}
 }
 
 var File_pluginv2_proto protoreflect.FileDescriptor
@@ -570,33 +1078,89 @@ var file_pluginv2_proto_rawDesc = []byte{
 	0x3c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
 	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a,
 	0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x22, 0x22, 0x0a,
-	0x09, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x32, 0xb4, 0x02, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x49, 0x0a, 0x0a,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e,
-	0x53, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x21, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x4d, 0x75, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x4d,
-	0x75, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6f, 0x66, 0x74, 0x2d, 0x73, 0x68, 0x2f, 0x76,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x22, 0xe8, 0x02,
+	0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x7b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x1a, 0xe2, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x89, 0x01, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x3c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x22, 0x6c, 0x0a, 0x09, 0x49, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x64, 0x1a, 0x39, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
+	0x6e, 0x63, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x24, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x64, 0x22, 0x22, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a,
+	0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x89, 0x04, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x12, 0x49, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09,
+	0x53, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76,
+	0x32, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x06, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x4d, 0x75,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x49, 0x73,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x76, 0x32, 0x2e, 0x49, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x2e,
+	0x49, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x6f, 0x66, 0x74, 0x2d, 0x73, 0x68, 0x2f, 0x76, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x32, 0x2f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -611,32 +1175,47 @@ func file_pluginv2_proto_rawDescGZIP() []byte {
 	return file_pluginv2_proto_rawDescData
 }
 
-var file_pluginv2_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_pluginv2_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_pluginv2_proto_goTypes = []interface{}{
 	(*Initialize)(nil),               // 0: pluginv2.Initialize
 	(*GetPluginConfig)(nil),          // 1: pluginv2.GetPluginConfig
 	(*Mutate)(nil),                   // 2: pluginv2.Mutate
-	(*SetLeader)(nil),                // 3: pluginv2.SetLeader
-	(*Initialize_Request)(nil),       // 4: pluginv2.Initialize.Request
-	(*Initialize_Response)(nil),      // 5: pluginv2.Initialize.Response
-	(*GetPluginConfig_Request)(nil),  // 6: pluginv2.GetPluginConfig.Request
-	(*GetPluginConfig_Response)(nil), // 7: pluginv2.GetPluginConfig.Response
-	(*Mutate_Request)(nil),           // 8: pluginv2.Mutate.Request
-	(*Mutate_Response)(nil),          // 9: pluginv2.Mutate.Response
-	(*SetLeader_Request)(nil),        // 10: pluginv2.SetLeader.Request
-	(*SetLeader_Response)(nil),       // 11: pluginv2.SetLeader.Response
+	(*Sync)(nil),                     // 3: pluginv2.Sync
+	(*TranslateName)(nil),            // 4: pluginv2.TranslateName
+	(*IsManaged)(nil),                // 5: pluginv2.IsManaged
+	(*SetLeader)(nil),                // 6: pluginv2.SetLeader
+	(*Initialize_Request)(nil),       // 7: pluginv2.Initialize.Request
+	(*Initialize_Response)(nil),      // 8: pluginv2.Initialize.Response
+	(*GetPluginConfig_Request)(nil),  // 9: pluginv2.GetPluginConfig.Request
+	(*GetPluginConfig_Response)(nil), // 10: pluginv2.GetPluginConfig.Response
+	(*Mutate_Request)(nil),           // 11: pluginv2.Mutate.Request
+	(*Mutate_Response)(nil),          // 12: pluginv2.Mutate.Response
+	(*Sync_Request)(nil),             // 13: pluginv2.Sync.Request
+	(*Sync_Response)(nil),            // 14: pluginv2.Sync.Response
+	(*TranslateName_Request)(nil),    // 15: pluginv2.TranslateName.Request
+	(*TranslateName_Response)(nil),   // 16: pluginv2.TranslateName.Response
+	(*IsManaged_Request)(nil),        // 17: pluginv2.IsManaged.Request
+	(*IsManaged_Response)(nil),       // 18: pluginv2.IsManaged.Response
+	(*SetLeader_Request)(nil),        // 19: pluginv2.SetLeader.Request
+	(*SetLeader_Response)(nil),       // 20: pluginv2.SetLeader.Response
 }
 var file_pluginv2_proto_depIdxs = []int32{
-	4,  // 0: pluginv2.Plugin.Initialize:input_type -> pluginv2.Initialize.Request
-	10, // 1: pluginv2.Plugin.SetLeader:input_type -> pluginv2.SetLeader.Request
-	6,  // 2: pluginv2.Plugin.GetPluginConfig:input_type -> pluginv2.GetPluginConfig.Request
-	8,  // 3: pluginv2.Plugin.Mutate:input_type -> pluginv2.Mutate.Request
-	5,  // 4: pluginv2.Plugin.Initialize:output_type -> pluginv2.Initialize.Response
-	11, // 5: pluginv2.Plugin.SetLeader:output_type -> pluginv2.SetLeader.Response
-	7,  // 6: pluginv2.Plugin.GetPluginConfig:output_type -> pluginv2.GetPluginConfig.Response
-	9,  // 7: pluginv2.Plugin.Mutate:output_type -> pluginv2.Mutate.Response
-	4,  // [4:8] is the sub-list for method output_type
-	0,  // [0:4] is the sub-list for method input_type
+	7,  // 0: pluginv2.Plugin.Initialize:input_type -> pluginv2.Initialize.Request
+	19, // 1: pluginv2.Plugin.SetLeader:input_type -> pluginv2.SetLeader.Request
+	9,  // 2: pluginv2.Plugin.GetPluginConfig:input_type -> pluginv2.GetPluginConfig.Request
+	11, // 3: pluginv2.Plugin.Mutate:input_type -> pluginv2.Mutate.Request
+	13, // 4: pluginv2.Plugin.Sync:input_type -> pluginv2.Sync.Request
+	15, // 5: pluginv2.Plugin.TranslateName:input_type -> pluginv2.TranslateName.Request
+	17, // 6: pluginv2.Plugin.IsManaged:input_type -> pluginv2.IsManaged.Request
+	8,  // 7: pluginv2.Plugin.Initialize:output_type -> pluginv2.Initialize.Response
+	20, // 8: pluginv2.Plugin.SetLeader:output_type -> pluginv2.SetLeader.Response
+	10, // 9: pluginv2.Plugin.GetPluginConfig:output_type -> pluginv2.GetPluginConfig.Response
+	12, // 10: pluginv2.Plugin.Mutate:output_type -> pluginv2.Mutate.Response
+	14, // 11: pluginv2.Plugin.Sync:output_type -> pluginv2.Sync.Response
+	16, // 12: pluginv2.Plugin.TranslateName:output_type -> pluginv2.TranslateName.Response
+	18, // 13: pluginv2.Plugin.IsManaged:output_type -> pluginv2.IsManaged.Response
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -685,7 +1264,7 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLeader); i {
+			switch v := v.(*Sync); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -697,7 +1276,7 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Initialize_Request); i {
+			switch v := v.(*TranslateName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -709,7 +1288,7 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Initialize_Response); i {
+			switch v := v.(*IsManaged); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -721,7 +1300,7 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPluginConfig_Request); i {
+			switch v := v.(*SetLeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -733,7 +1312,7 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPluginConfig_Response); i {
+			switch v := v.(*Initialize_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -745,7 +1324,7 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mutate_Request); i {
+			switch v := v.(*Initialize_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -757,7 +1336,7 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mutate_Response); i {
+			switch v := v.(*GetPluginConfig_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -769,7 +1348,7 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLeader_Request); i {
+			switch v := v.(*GetPluginConfig_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -781,6 +1360,114 @@ func file_pluginv2_proto_init() {
 			}
 		}
 		file_pluginv2_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mutate_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mutate_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sync_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sync_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslateName_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranslateName_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsManaged_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsManaged_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLeader_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluginv2_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLeader_Response); i {
 			case 0:
 				return &v.state
@@ -799,7 +1486,7 @@ func file_pluginv2_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pluginv2_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
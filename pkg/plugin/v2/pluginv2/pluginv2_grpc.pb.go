@@ -27,6 +27,9 @@ type PluginClient interface {
 	SetLeader(ctx context.Context, in *SetLeader_Request, opts ...grpc.CallOption) (*SetLeader_Response, error)
 	GetPluginConfig(ctx context.Context, in *GetPluginConfig_Request, opts ...grpc.CallOption) (*GetPluginConfig_Response, error)
 	Mutate(ctx context.Context, in *Mutate_Request, opts ...grpc.CallOption) (*Mutate_Response, error)
+	Sync(ctx context.Context, in *Sync_Request, opts ...grpc.CallOption) (*Sync_Response, error)
+	TranslateName(ctx context.Context, in *TranslateName_Request, opts ...grpc.CallOption) (*TranslateName_Response, error)
+	IsManaged(ctx context.Context, in *IsManaged_Request, opts ...grpc.CallOption) (*IsManaged_Response, error)
 }
 
 type pluginClient struct {
@@ -73,6 +76,33 @@ func (c *pluginClient) Mutate(ctx context.Context, in *Mutate_Request, opts ...g
 	return out, nil
 }
 
+func (c *pluginClient) Sync(ctx context.Context, in *Sync_Request, opts ...grpc.CallOption) (*Sync_Response, error) {
+	out := new(Sync_Response)
+	err := c.cc.Invoke(ctx, "/pluginv2.Plugin/Sync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) TranslateName(ctx context.Context, in *TranslateName_Request, opts ...grpc.CallOption) (*TranslateName_Response, error) {
+	out := new(TranslateName_Response)
+	err := c.cc.Invoke(ctx, "/pluginv2.Plugin/TranslateName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) IsManaged(ctx context.Context, in *IsManaged_Request, opts ...grpc.CallOption) (*IsManaged_Response, error) {
+	out := new(IsManaged_Response)
+	err := c.cc.Invoke(ctx, "/pluginv2.Plugin/IsManaged", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PluginServer is the server API for Plugin service.
 // All implementations must embed UnimplementedPluginServer
 // for forward compatibility
@@ -81,6 +111,9 @@ type PluginServer interface {
 	SetLeader(context.Context, *SetLeader_Request) (*SetLeader_Response, error)
 	GetPluginConfig(context.Context, *GetPluginConfig_Request) (*GetPluginConfig_Response, error)
 	Mutate(context.Context, *Mutate_Request) (*Mutate_Response, error)
+	Sync(context.Context, *Sync_Request) (*Sync_Response, error)
+	TranslateName(context.Context, *TranslateName_Request) (*TranslateName_Response, error)
+	IsManaged(context.Context, *IsManaged_Request) (*IsManaged_Response, error)
 	mustEmbedUnimplementedPluginServer()
 }
 
@@ -100,6 +133,15 @@ func (UnimplementedPluginServer) GetPluginConfig(context.Context, *GetPluginConf
 func (UnimplementedPluginServer) Mutate(context.Context, *Mutate_Request) (*Mutate_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Mutate not implemented")
 }
+func (UnimplementedPluginServer) Sync(context.Context, *Sync_Request) (*Sync_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (UnimplementedPluginServer) TranslateName(context.Context, *TranslateName_Request) (*TranslateName_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TranslateName not implemented")
+}
+func (UnimplementedPluginServer) IsManaged(context.Context, *IsManaged_Request) (*IsManaged_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsManaged not implemented")
+}
 func (UnimplementedPluginServer) mustEmbedUnimplementedPluginServer() {}
 
 // UnsafePluginServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +227,60 @@ func _Plugin_Mutate_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Sync_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pluginv2.Plugin/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).Sync(ctx, req.(*Sync_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_TranslateName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TranslateName_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).TranslateName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pluginv2.Plugin/TranslateName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).TranslateName(ctx, req.(*TranslateName_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_IsManaged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsManaged_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).IsManaged(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pluginv2.Plugin/IsManaged",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).IsManaged(ctx, req.(*IsManaged_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Plugin_ServiceDesc is the grpc.ServiceDesc for Plugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -208,6 +304,18 @@ var Plugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Mutate",
 			Handler:    _Plugin_Mutate_Handler,
 		},
+		{
+			MethodName: "Sync",
+			Handler:    _Plugin_Sync_Handler,
+		},
+		{
+			MethodName: "TranslateName",
+			Handler:    _Plugin_TranslateName_Handler,
+		},
+		{
+			MethodName: "IsManaged",
+			Handler:    _Plugin_IsManaged_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pluginv2.proto",
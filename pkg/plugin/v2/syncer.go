@@ -0,0 +1,406 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer"
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/controllers/syncer/translator"
+	"github.com/loft-sh/vcluster/pkg/patcher"
+	"github.com/loft-sh/vcluster/pkg/plugin/v2/pluginv2"
+	syncertypes "github.com/loft-sh/vcluster/pkg/types"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	SyncTypeSyncToHost    = "SyncToHost"
+	SyncTypeSync          = "Sync"
+	SyncTypeSyncToVirtual = "SyncToVirtual"
+
+	TranslateDirectionVirtualToHost = "VirtualToHost"
+	TranslateDirectionHostToVirtual = "HostToVirtual"
+)
+
+type pluginSyncer struct {
+	plugin *vClusterPlugin
+	config *SyncerConfig
+}
+
+func (m *Manager) registerSyncers(vClusterPlugin *vClusterPlugin, syncers []*SyncerConfig) error {
+	for _, syncerConfig := range syncers {
+		if syncerConfig.Name == "" {
+			return fmt.Errorf("name is empty in plugin %s syncer", vClusterPlugin.Path)
+		} else if syncerConfig.APIVersion == "" {
+			return fmt.Errorf("api version is empty in plugin %s syncer %s", vClusterPlugin.Path, syncerConfig.Name)
+		} else if syncerConfig.Kind == "" {
+			return fmt.Errorf("kind is empty in plugin %s syncer %s", vClusterPlugin.Path, syncerConfig.Name)
+		} else if existing, ok := m.Syncers[syncerConfig.Name]; ok {
+			return fmt.Errorf("syncer %s is already registered by plugin %s", syncerConfig.Name, existing.plugin.Path)
+		}
+
+		m.Syncers[syncerConfig.Name] = &pluginSyncer{
+			plugin: vClusterPlugin,
+			config: syncerConfig,
+		}
+
+		klog.Infof("Register syncer %s for %s %s in plugin %s", syncerConfig.Name, syncerConfig.APIVersion, syncerConfig.Kind, vClusterPlugin.Path)
+	}
+
+	return nil
+}
+
+// NewSyncers creates the syncers that were registered by the plugins
+func (m *Manager) NewSyncers(ctx *synccontext.RegisterContext) ([]syncertypes.Object, error) {
+	syncers := []syncertypes.Object{}
+	for _, pluginSyncer := range m.Syncers {
+		s, err := newRemoteSyncer(ctx, pluginSyncer)
+		if err != nil {
+			return nil, fmt.Errorf("create syncer %s of plugin %s: %w", pluginSyncer.config.Name, pluginSyncer.plugin.Path, err)
+		}
+
+		syncers = append(syncers, s)
+	}
+
+	return syncers, nil
+}
+
+func newRemoteSyncer(ctx *synccontext.RegisterContext, pluginSyncer *pluginSyncer) (syncertypes.Object, error) {
+	gvk := schema.FromAPIVersionAndKind(pluginSyncer.config.APIVersion, pluginSyncer.config.Kind)
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	mapping, err := ctx.VirtualManager.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("find resource for %s: %w", gvk.String(), err)
+	}
+
+	var defaultTranslator translator.Translator
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		defaultTranslator = translator.NewNamespacedTranslator(ctx, pluginSyncer.config.Name, obj)
+	} else {
+		defaultTranslator = translator.NewClusterTranslator(ctx, pluginSyncer.config.Name, obj, func(vName string, _ client.Object) string {
+			return translate.Default.PhysicalNameClusterScoped(vName)
+		})
+	}
+
+	return &remoteSyncer{
+		Translator: defaultTranslator,
+
+		plugin: pluginSyncer.plugin,
+		config: pluginSyncer.config,
+	}, nil
+}
+
+// remoteSyncer is a syncer that delegates the sync logic and optionally the name translation to a plugin
+type remoteSyncer struct {
+	translator.Translator
+
+	plugin *vClusterPlugin
+	config *SyncerConfig
+}
+
+var _ syncertypes.Syncer = &remoteSyncer{}
+
+var _ syncertypes.ToVirtualSyncer = &remoteSyncer{}
+
+var _ syncertypes.IndicesRegisterer = &remoteSyncer{}
+
+func (s *remoteSyncer) RegisterIndices(ctx *synccontext.RegisterContext) error {
+	// custom name translators are resolved through the plugin, so there is no index to build
+	if s.config.NameTranslator {
+		return nil
+	}
+
+	indicesRegisterer, ok := s.Translator.(syncertypes.IndicesRegisterer)
+	if !ok {
+		return nil
+	}
+
+	return indicesRegisterer.RegisterIndices(ctx)
+}
+
+func (s *remoteSyncer) SyncToHost(ctx *synccontext.SyncContext, vObj client.Object) (ctrl.Result, error) {
+	// the plugin receives a host object with already translated metadata it can modify
+	pObj := s.TranslateMetadata(ctx.Context, vObj)
+	if s.config.NameTranslator {
+		pName := s.VirtualToHost(ctx.Context, types.NamespacedName{Name: vObj.GetName(), Namespace: vObj.GetNamespace()}, vObj)
+		if pName.Name == "" {
+			return ctrl.Result{}, nil
+		}
+
+		pObj.SetName(pName.Name)
+		pObj.SetNamespace(pName.Namespace)
+	}
+
+	response, err := s.sync(ctx.Context, SyncTypeSyncToHost, vObj, pObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if response.DeleteVirtual {
+		ctx.Log.Infof("delete virtual %s %s, because plugin syncer %s requested it", s.config.Kind, objectName(vObj), s.config.Name)
+		return ctrl.Result{}, client.IgnoreNotFound(ctx.VirtualClient.Delete(ctx.Context, vObj))
+	} else if response.HostObject == "" {
+		return toResult(response), nil
+	}
+
+	newPObj, err := s.decode(response.HostObject)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	ctx.Log.Infof("create physical %s %s", s.config.Kind, objectName(newPObj))
+	err = ctx.PhysicalClient.Create(ctx.Context, newPObj)
+	if err != nil {
+		ctx.Log.Infof("error syncing %s %s to physical cluster: %v", s.config.Kind, objectName(vObj), err)
+		return ctrl.Result{}, err
+	}
+
+	return toResult(response), nil
+}
+
+func (s *remoteSyncer) Sync(ctx *synccontext.SyncContext, pObj client.Object, vObj client.Object) (_ ctrl.Result, retErr error) {
+	response, err := s.sync(ctx.Context, SyncTypeSync, vObj, pObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if response.DeleteHost {
+		return syncer.DeleteObject(ctx, pObj, "plugin syncer "+s.config.Name+" requested it")
+	} else if response.DeleteVirtual {
+		ctx.Log.Infof("delete virtual %s %s, because plugin syncer %s requested it", s.config.Kind, objectName(vObj), s.config.Name)
+		return ctrl.Result{}, client.IgnoreNotFound(ctx.VirtualClient.Delete(ctx.Context, vObj))
+	}
+
+	// patch objects
+	patch, err := patcher.NewSyncerPatcher(ctx, pObj, vObj)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("new syncer patcher: %w", err)
+	}
+	defer func() {
+		if err := patch.Patch(ctx, pObj, vObj); err != nil {
+			retErr = utilerrors.NewAggregate([]error{retErr, err})
+		}
+	}()
+
+	// apply the changes of the plugin, unset objects are left as they are
+	err = s.decodeInto(response.VirtualObject, vObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	err = s.decodeInto(response.HostObject, pObj)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return toResult(response), nil
+}
+
+// SyncToVirtual is called for managed host objects without a virtual object. As with the built-in syncers, the
+// host object is deleted unless the plugin creates a virtual object for it, otherwise it would be orphaned.
+func (s *remoteSyncer) SyncToVirtual(ctx *synccontext.SyncContext, pObj client.Object) (ctrl.Result, error) {
+	response, err := s.sync(ctx.Context, SyncTypeSyncToVirtual, nil, pObj)
+	if err != nil {
+		// an error doesn't tell us anything about the object, so we retry instead of deleting it
+		return ctrl.Result{}, err
+	} else if response.DeleteHost {
+		return syncer.DeleteObject(ctx, pObj, "plugin syncer "+s.config.Name+" requested it")
+	} else if response.VirtualObject == "" {
+		// the plugin might want to wait for something before it creates the virtual object
+		if response.Requeue || response.RequeueAfterSeconds > 0 {
+			return toResult(response), nil
+		}
+
+		return syncer.DeleteObject(ctx, pObj, "plugin syncer "+s.config.Name+" did not sync it to the virtual cluster")
+	}
+
+	newVObj, err := s.decode(response.VirtualObject)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	ctx.Log.Infof("create virtual %s %s", s.config.Kind, objectName(newVObj))
+	err = ctx.VirtualClient.Create(ctx.Context, newVObj)
+	if err != nil {
+		ctx.Log.Infof("error syncing %s %s to virtual cluster: %v", s.config.Kind, objectName(pObj), err)
+		return ctrl.Result{}, err
+	}
+
+	return toResult(response), nil
+}
+
+func (s *remoteSyncer) IsManaged(ctx context.Context, pObj client.Object) (bool, error) {
+	if !s.config.NameTranslator {
+		return s.Translator.IsManaged(ctx, pObj)
+	}
+
+	grpcClient, err := s.plugin.grpcClient()
+	if err != nil {
+		return false, err
+	}
+
+	encodedObj, err := encodeObject(pObj)
+	if err != nil {
+		return false, fmt.Errorf("encode object: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	response, err := grpcClient.IsManaged(ctx, &pluginv2.IsManaged_Request{
+		Syncer: s.config.Name,
+		Object: encodedObj,
+	})
+	if err != nil {
+		return false, fmt.Errorf("call plugin is managed %s: %w", s.plugin.Path, err)
+	}
+
+	return response.Managed, nil
+}
+
+func (s *remoteSyncer) VirtualToHost(ctx context.Context, req types.NamespacedName, vObj client.Object) types.NamespacedName {
+	if !s.config.NameTranslator {
+		return s.Translator.VirtualToHost(ctx, req, vObj)
+	}
+
+	return s.translateName(ctx, TranslateDirectionVirtualToHost, req, vObj)
+}
+
+func (s *remoteSyncer) HostToVirtual(ctx context.Context, req types.NamespacedName, pObj client.Object) types.NamespacedName {
+	if !s.config.NameTranslator {
+		return s.Translator.HostToVirtual(ctx, req, pObj)
+	}
+
+	return s.translateName(ctx, TranslateDirectionHostToVirtual, req, pObj)
+}
+
+// translateName asks the plugin to translate the name. As the name translator cannot return an error, failures
+// are logged and an empty name is returned, which the syncer treats as an object that does not exist.
+func (s *remoteSyncer) translateName(ctx context.Context, direction string, req types.NamespacedName, obj client.Object) types.NamespacedName {
+	grpcClient, err := s.plugin.grpcClient()
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "translate name", "syncer", s.config.Name)
+		return types.NamespacedName{}
+	}
+
+	encodedObj, err := encodeObject(obj)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "encode object", "syncer", s.config.Name)
+		return types.NamespacedName{}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	response, err := grpcClient.TranslateName(ctx, &pluginv2.TranslateName_Request{
+		Syncer:    s.config.Name,
+		Direction: direction,
+		Name:      req.Name,
+		Namespace: req.Namespace,
+		Object:    encodedObj,
+	})
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "call plugin translate name", "plugin", s.plugin.Path, "syncer", s.config.Name)
+		return types.NamespacedName{}
+	}
+
+	return types.NamespacedName{
+		Name:      response.Name,
+		Namespace: response.Namespace,
+	}
+}
+
+func (s *remoteSyncer) sync(ctx context.Context, syncType string, vObj, pObj client.Object) (*pluginv2.Sync_Response, error) {
+	grpcClient, err := s.plugin.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+
+	encodedVObj, err := encodeObject(vObj)
+	if err != nil {
+		return nil, fmt.Errorf("encode virtual object: %w", err)
+	}
+	encodedPObj, err := encodeObject(pObj)
+	if err != nil {
+		return nil, fmt.Errorf("encode host object: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second*30)
+	defer cancel()
+
+	klog.FromContext(ctx).V(1).Info("calling plugin to sync object", "plugin", s.plugin.Path, "syncer", s.config.Name, "type", syncType)
+	response, err := grpcClient.Sync(ctx, &pluginv2.Sync_Request{
+		Syncer:        s.config.Name,
+		Type:          syncType,
+		VirtualObject: encodedVObj,
+		HostObject:    encodedPObj,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("call plugin sync %s: %w", s.plugin.Path, err)
+	}
+
+	return response, nil
+}
+
+func (s *remoteSyncer) decode(encodedObj string) (client.Object, error) {
+	obj := s.Resource()
+	err := s.decodeInto(encodedObj, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+func (s *remoteSyncer) decodeInto(encodedObj string, obj client.Object) error {
+	if encodedObj == "" {
+		return nil
+	}
+
+	// reset the object so that fields removed by the plugin are removed here as well
+	unstructuredObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unexpected object type %T", obj)
+	}
+	unstructuredObj.Object = map[string]interface{}{}
+
+	err := json.Unmarshal([]byte(encodedObj), unstructuredObj)
+	if err != nil {
+		return fmt.Errorf("decode object returned by plugin syncer %s: %w", s.config.Name, err)
+	}
+
+	return nil
+}
+
+func encodeObject(obj client.Object) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	encodedObj, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encodedObj), nil
+}
+
+func toResult(response *pluginv2.Sync_Response) ctrl.Result {
+	return ctrl.Result{
+		Requeue:      response.Requeue,
+		RequeueAfter: time.Duration(response.RequeueAfterSeconds) * time.Second,
+	}
+}
+
+func objectName(obj client.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
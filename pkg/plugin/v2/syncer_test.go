@@ -0,0 +1,184 @@
+package v2
+
+import (
+	"context"
+	"testing"
+
+	synccontext "github.com/loft-sh/vcluster/pkg/controllers/syncer/context"
+	"github.com/loft-sh/vcluster/pkg/plugin/v2/pluginv2"
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	"github.com/loft-sh/vcluster/pkg/util/loghelper"
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRegisterSyncers(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		syncers [][]*SyncerConfig
+		wantErr bool
+		want    []string
+	}{
+		{
+			desc: "single syncer",
+			syncers: [][]*SyncerConfig{
+				{{Name: "cert-manager-certificates", APIVersion: "cert-manager.io/v1", Kind: "Certificate"}},
+			},
+			want: []string{"cert-manager-certificates"},
+		},
+		{
+			desc: "syncers from multiple plugins",
+			syncers: [][]*SyncerConfig{
+				{{Name: "certificates", APIVersion: "cert-manager.io/v1", Kind: "Certificate"}},
+				{{Name: "issuers", APIVersion: "cert-manager.io/v1", Kind: "Issuer", NameTranslator: true}},
+			},
+			want: []string{"certificates", "issuers"},
+		},
+		{
+			desc: "missing kind",
+			syncers: [][]*SyncerConfig{
+				{{Name: "certificates", APIVersion: "cert-manager.io/v1"}},
+			},
+			wantErr: true,
+		},
+		{
+			desc: "missing name",
+			syncers: [][]*SyncerConfig{
+				{{APIVersion: "cert-manager.io/v1", Kind: "Certificate"}},
+			},
+			wantErr: true,
+		},
+		{
+			desc: "duplicate name",
+			syncers: [][]*SyncerConfig{
+				{{Name: "certificates", APIVersion: "cert-manager.io/v1", Kind: "Certificate"}},
+				{{Name: "certificates", APIVersion: "cert-manager.io/v1", Kind: "Issuer"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			m := NewManager()
+
+			var err error
+			for _, syncers := range tC.syncers {
+				err = m.registerSyncers(&vClusterPlugin{Path: "/plugins/" + tC.desc}, syncers)
+				if err != nil {
+					break
+				}
+			}
+			if tC.wantErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tC.wantErr, err)
+			} else if tC.wantErr {
+				return
+			}
+
+			if len(m.Syncers) != len(tC.want) {
+				t.Fatalf("expected %d syncers, got %d", len(tC.want), len(m.Syncers))
+			}
+			for _, name := range tC.want {
+				if _, ok := m.Syncers[name]; !ok {
+					t.Errorf("expected syncer %s to be registered", name)
+				}
+			}
+		})
+	}
+}
+
+type fakePluginClient struct {
+	pluginv2.PluginClient
+
+	response *pluginv2.Sync_Response
+	err      error
+}
+
+func (f *fakePluginClient) Sync(_ context.Context, _ *pluginv2.Sync_Request, _ ...grpc.CallOption) (*pluginv2.Sync_Response, error) {
+	return f.response, f.err
+}
+
+func TestSyncToVirtual(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		phase       PluginPhase
+		response    *pluginv2.Sync_Response
+		err         error
+		wantErr     bool
+		wantDeleted bool
+	}{
+		{
+			desc:        "plugin did not sync the object",
+			phase:       PluginPhaseRunning,
+			response:    &pluginv2.Sync_Response{},
+			wantDeleted: true,
+		},
+		{
+			desc:        "plugin requested deletion",
+			phase:       PluginPhaseRunning,
+			response:    &pluginv2.Sync_Response{DeleteHost: true},
+			wantDeleted: true,
+		},
+		{
+			desc:     "plugin requested requeue",
+			phase:    PluginPhaseRunning,
+			response: &pluginv2.Sync_Response{Requeue: true},
+		},
+		{
+			desc:    "plugin failed",
+			phase:   PluginPhaseRunning,
+			err:     status.Error(codes.Internal, "boom"),
+			wantErr: true,
+		},
+		{
+			desc:    "plugin unavailable",
+			phase:   PluginPhaseRunning,
+			err:     status.Error(codes.Unavailable, "connection refused"),
+			wantErr: true,
+		},
+		{
+			desc:    "plugin not running",
+			phase:   PluginPhaseFailed,
+			wantErr: true,
+		},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			pObj := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "host",
+				},
+			}
+			pClient := testingutil.NewFakeClient(scheme.Scheme, pObj.DeepCopy())
+			syncCtx := &synccontext.SyncContext{
+				Context:        context.TODO(),
+				Log:            loghelper.New("test"),
+				PhysicalClient: pClient,
+			}
+			s := &remoteSyncer{
+				plugin: &vClusterPlugin{
+					Path:       "/plugins/test",
+					GRPCClient: &fakePluginClient{response: tC.response, err: tC.err},
+					status:     PluginStatus{Phase: tC.phase},
+				},
+				config: &SyncerConfig{Name: "configmaps", APIVersion: "v1", Kind: "ConfigMap"},
+			}
+
+			_, err := s.SyncToVirtual(syncCtx, pObj)
+			if tC.wantErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tC.wantErr, err)
+			}
+
+			err = pClient.Get(syncCtx.Context, client.ObjectKeyFromObject(pObj), &corev1.ConfigMap{})
+			if deleted := kerrors.IsNotFound(err); deleted != tC.wantDeleted {
+				t.Fatalf("expected host object deleted %v, got %v (%v)", tC.wantDeleted, deleted, err)
+			}
+		})
+	}
+}
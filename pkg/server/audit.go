@@ -0,0 +1,153 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	servertypes "github.com/loft-sh/vcluster/pkg/server/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/audit/policy"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/server"
+	koptions "k8s.io/apiserver/pkg/server/options"
+)
+
+const (
+	AuditAnnotationVClusterName      = "vcluster.loft.sh/name"
+	AuditAnnotationVClusterNamespace = "vcluster.loft.sh/namespace"
+	AuditAnnotationOriginalUser      = "vcluster.loft.sh/original-user"
+	AuditAnnotationOriginalGroups    = "vcluster.loft.sh/original-groups"
+)
+
+// applyAuditConfig configures the audit policy and backends of the server config. The backends need to be
+// started by the caller.
+func applyAuditConfig(serverConfig *server.Config, auditConfig vclusterconfig.ControlPlaneAudit) error {
+	if !auditConfig.Enabled {
+		return nil
+	}
+
+	auditDir, err := os.MkdirTemp("", "vcluster-audit-")
+	if err != nil {
+		return fmt.Errorf("create audit config dir: %w", err)
+	}
+
+	// write the policy, the audit options only accept files
+	auditPolicy, err := json.Marshal(TranslateAuditPolicy(auditConfig.Policy))
+	if err != nil {
+		return fmt.Errorf("encode audit policy: %w", err)
+	}
+	_, err = policy.LoadPolicyFromBytes(auditPolicy)
+	if err != nil {
+		return fmt.Errorf("invalid audit policy: %w", err)
+	}
+
+	auditOptions := koptions.NewAuditOptions()
+	auditOptions.PolicyFile = filepath.Join(auditDir, "policy.json")
+	err = os.WriteFile(auditOptions.PolicyFile, auditPolicy, 0600)
+	if err != nil {
+		return fmt.Errorf("write audit policy: %w", err)
+	}
+
+	// log backend
+	if auditConfig.Log.Enabled {
+		auditOptions.LogOptions.Path = auditConfig.Log.Path
+		auditOptions.LogOptions.MaxAge = auditConfig.Log.MaxAge
+		auditOptions.LogOptions.MaxBackups = auditConfig.Log.MaxBackups
+		auditOptions.LogOptions.MaxSize = auditConfig.Log.MaxSize
+		if auditConfig.Log.Path != "-" {
+			err = os.MkdirAll(filepath.Dir(auditConfig.Log.Path), 0755)
+			if err != nil {
+				return fmt.Errorf("create audit log dir: %w", err)
+			}
+		}
+	}
+
+	// webhook backend
+	if auditConfig.Webhook.Enabled {
+		auditOptions.WebhookOptions.ConfigFile = filepath.Join(auditDir, "webhook-kubeconfig.yaml")
+		err = os.WriteFile(auditOptions.WebhookOptions.ConfigFile, []byte(auditConfig.Webhook.Config), 0600)
+		if err != nil {
+			return fmt.Errorf("write audit webhook config: %w", err)
+		}
+	}
+
+	if errs := auditOptions.Validate(); len(errs) > 0 {
+		return fmt.Errorf("invalid audit config: %v", errs)
+	}
+
+	return auditOptions.ApplyTo(serverConfig)
+}
+
+// TranslateAuditPolicy converts the vCluster audit policy into an audit.k8s.io/v1 policy
+func TranslateAuditPolicy(auditPolicy vclusterconfig.AuditPolicy) *auditv1.Policy {
+	retPolicy := &auditv1.Policy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: auditv1.SchemeGroupVersion.String(),
+			Kind:       "Policy",
+		},
+	}
+	for _, stage := range auditPolicy.OmitStages {
+		retPolicy.OmitStages = append(retPolicy.OmitStages, auditv1.Stage(stage))
+	}
+
+	// by default we record the metadata of all requests
+	if len(auditPolicy.Rules) == 0 {
+		retPolicy.Rules = []auditv1.PolicyRule{{Level: auditv1.LevelMetadata}}
+		return retPolicy
+	}
+
+	for _, rule := range auditPolicy.Rules {
+		policyRule := auditv1.PolicyRule{
+			Level:           auditv1.Level(rule.Level),
+			Users:           rule.Users,
+			UserGroups:      rule.UserGroups,
+			Verbs:           rule.Verbs,
+			Namespaces:      rule.Namespaces,
+			NonResourceURLs: rule.NonResourceURLs,
+		}
+		for _, resources := range rule.Resources {
+			policyRule.Resources = append(policyRule.Resources, auditv1.GroupResources{
+				Group:         resources.Group,
+				Resources:     resources.Resources,
+				ResourceNames: resources.ResourceNames,
+			})
+		}
+		for _, stage := range rule.OmitStages {
+			policyRule.OmitStages = append(policyRule.OmitStages, auditv1.Stage(stage))
+		}
+		if rule.OmitManagedFields {
+			omitManagedFields := true
+			policyRule.OmitManagedFields = &omitManagedFields
+		}
+
+		retPolicy.Rules = append(retPolicy.Rules, policyRule)
+	}
+
+	return retPolicy
+}
+
+// WithAuditAnnotations adds the vCluster the request was served by as well as the original user
+// before impersonation to the audit event
+func WithAuditAnnotations(h http.Handler, annotations map[string]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		audit.AddAuditAnnotationsMap(ctx, annotations)
+
+		originalUser, ok := ctx.Value(servertypes.OriginalUserKey).(user.Info)
+		if ok {
+			groups, _ := json.Marshal(originalUser.GetGroups())
+			audit.AddAuditAnnotations(ctx,
+				AuditAnnotationOriginalUser, originalUser.GetName(),
+				AuditAnnotationOriginalGroups, string(groups),
+			)
+		}
+
+		h.ServeHTTP(w, req)
+	})
+}
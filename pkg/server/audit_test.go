@@ -0,0 +1,129 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"gotest.tools/v3/assert"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"k8s.io/apiserver/pkg/server"
+)
+
+func TestTranslateAuditPolicy(t *testing.T) {
+	omitManagedFields := true
+	testCases := []struct {
+		name     string
+		policy   vclusterconfig.AuditPolicy
+		expected []auditv1.PolicyRule
+		stages   []auditv1.Stage
+	}{
+		{
+			name:     "default",
+			expected: []auditv1.PolicyRule{{Level: auditv1.LevelMetadata}},
+		},
+		{
+			name: "default with omitted stages",
+			policy: vclusterconfig.AuditPolicy{
+				OmitStages: []string{"RequestReceived"},
+			},
+			expected: []auditv1.PolicyRule{{Level: auditv1.LevelMetadata}},
+			stages:   []auditv1.Stage{auditv1.StageRequestReceived},
+		},
+		{
+			name: "rules",
+			policy: vclusterconfig.AuditPolicy{
+				Rules: []vclusterconfig.AuditPolicyRule{
+					{
+						Level:      "None",
+						UserGroups: []string{"system:nodes"},
+					},
+					{
+						Level:      "RequestResponse",
+						Users:      []string{"admin"},
+						Verbs:      []string{"create", "delete"},
+						Namespaces: []string{"default"},
+						Resources: []vclusterconfig.AuditGroupResources{
+							{Group: "", Resources: []string{"pods", "pods/log"}, ResourceNames: []string{"my-pod"}},
+							{Group: "apps", Resources: []string{"deployments"}},
+						},
+						OmitStages:        []string{"ResponseStarted"},
+						OmitManagedFields: true,
+					},
+					{
+						Level:           "Metadata",
+						NonResourceURLs: []string{"/healthz*"},
+					},
+				},
+			},
+			expected: []auditv1.PolicyRule{
+				{
+					Level:      auditv1.LevelNone,
+					UserGroups: []string{"system:nodes"},
+				},
+				{
+					Level:      auditv1.LevelRequestResponse,
+					Users:      []string{"admin"},
+					Verbs:      []string{"create", "delete"},
+					Namespaces: []string{"default"},
+					Resources: []auditv1.GroupResources{
+						{Group: "", Resources: []string{"pods", "pods/log"}, ResourceNames: []string{"my-pod"}},
+						{Group: "apps", Resources: []string{"deployments"}},
+					},
+					OmitStages:        []auditv1.Stage{auditv1.StageResponseStarted},
+					OmitManagedFields: &omitManagedFields,
+				},
+				{
+					Level:           auditv1.LevelMetadata,
+					NonResourceURLs: []string{"/healthz*"},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			auditPolicy := TranslateAuditPolicy(testCase.policy)
+			assert.Equal(t, auditPolicy.APIVersion, "audit.k8s.io/v1")
+			assert.Equal(t, auditPolicy.Kind, "Policy")
+			assert.DeepEqual(t, auditPolicy.Rules, testCase.expected)
+			assert.DeepEqual(t, auditPolicy.OmitStages, testCase.stages)
+		})
+	}
+}
+
+func TestApplyAuditConfig(t *testing.T) {
+	// disabled
+	serverConfig := &server.Config{}
+	assert.NilError(t, applyAuditConfig(serverConfig, vclusterconfig.ControlPlaneAudit{}))
+	assert.Assert(t, serverConfig.AuditBackend == nil)
+
+	// log backend
+	logPath := filepath.Join(t.TempDir(), "audit", "audit.log")
+	serverConfig = &server.Config{}
+	assert.NilError(t, applyAuditConfig(serverConfig, vclusterconfig.ControlPlaneAudit{
+		Enabled: true,
+		Log: vclusterconfig.AuditLog{
+			Enabled: true,
+			Path:    logPath,
+		},
+	}))
+	assert.Assert(t, serverConfig.AuditBackend != nil)
+	assert.Assert(t, serverConfig.AuditPolicyRuleEvaluator != nil)
+	_, err := os.Stat(filepath.Dir(logPath))
+	assert.NilError(t, err)
+
+	// invalid policy
+	err = applyAuditConfig(&server.Config{}, vclusterconfig.ControlPlaneAudit{
+		Enabled: true,
+		Policy: vclusterconfig.AuditPolicy{
+			Rules: []vclusterconfig.AuditPolicyRule{{Level: "Everything"}},
+		},
+		Log: vclusterconfig.AuditLog{
+			Enabled: true,
+			Path:    "-",
+		},
+	})
+	assert.ErrorContains(t, err, "invalid audit policy")
+}
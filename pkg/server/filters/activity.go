@@ -0,0 +1,37 @@
+package filters
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/loft-sh/vcluster/pkg/lifecycle"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// WithActivity records every authenticated request to the virtual cluster as activity, which keeps the
+// virtual cluster from going to sleep
+func WithActivity(h http.Handler, tracker *lifecycle.ActivityTracker, ignoreServiceAccounts bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, ok := request.UserFrom(req.Context())
+		if ok && isActivity(info, req.URL.Path, ignoreServiceAccounts) {
+			tracker.Record()
+		}
+
+		h.ServeHTTP(w, req)
+	})
+}
+
+func isActivity(info user.Info, path string, ignoreServiceAccounts bool) bool {
+	switch {
+	case info.GetName() == "" || info.GetName() == user.Anonymous:
+		return false
+	case ignoreServiceAccounts && strings.HasPrefix(info.GetName(), serviceaccount.ServiceAccountUsernamePrefix):
+		return false
+	case path == "/healthz" || path == "/readyz" || path == "/livez":
+		return false
+	}
+
+	return true
+}
@@ -3,7 +3,9 @@ package filters
 import (
 	"net/http"
 
+	"github.com/loft-sh/vcluster/pkg/util/translate"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 	"k8s.io/client-go/rest"
@@ -15,13 +17,20 @@ func WithFakeKubelet(h http.Handler, localConfig *rest.Config, cachedVirtualClie
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		nodeName, found := NodeNameFrom(req.Context())
 		if found {
+			// get the name of the host node
+			hostName, ok := translate.Nodes.HostName(nodeName)
+			if !ok {
+				responsewriters.ErrorNegotiated(kerrors.NewNotFound(corev1.Resource("nodes"), nodeName), s, corev1.SchemeGroupVersion, w, req)
+				return
+			}
+
 			// make sure there is a leading slash
 			if req.URL.Path[0] != '/' {
 				req.URL.Path = "/" + req.URL.Path
 			}
 
 			// construct the actual path
-			req.URL.Path = "/api/v1/nodes/" + nodeName + "/proxy" + req.URL.Path
+			req.URL.Path = "/api/v1/nodes/" + hostName + "/proxy" + req.URL.Path
 
 			// execute the request
 			_, err := handleNodeRequest(localConfig, cachedVirtualClient, w, req)
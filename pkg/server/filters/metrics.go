@@ -17,6 +17,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/server/handler"
 	"github.com/loft-sh/vcluster/pkg/util/clienthelper"
 	requestpkg "github.com/loft-sh/vcluster/pkg/util/request"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
 	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/expfmt"
 	corev1 "k8s.io/api/core/v1"
@@ -73,6 +74,11 @@ func WithMetricsProxy(h http.Handler, localConfig *rest.Config, cachedVirtualCli
 			}
 
 			// exchange node name
+			targetNode, ok = translateProxyNodeName(targetNode)
+			if !ok {
+				responsewriters.ErrorNegotiated(kerrors.NewNotFound(corev1.Resource("nodes"), splitted[4]), s, corev1.SchemeGroupVersion, w, req)
+				return
+			}
 			splitted[4] = targetNode
 			req.URL.Path = strings.Join(splitted, "/")
 
@@ -89,6 +95,24 @@ func WithMetricsProxy(h http.Handler, localConfig *rest.Config, cachedVirtualCli
 	})
 }
 
+// translateProxyNodeName replaces the virtual node name within the given [scheme:]name[:port] proxy target with
+// the host node name
+func translateProxyNodeName(targetNode string) (string, bool) {
+	splittedName := strings.Split(targetNode, ":")
+	nameIndex := 0
+	if len(splittedName) == 3 {
+		nameIndex = 1
+	}
+
+	hostName, ok := translate.Nodes.HostName(splittedName[nameIndex])
+	if !ok {
+		return "", false
+	}
+
+	splittedName[nameIndex] = hostName
+	return strings.Join(splittedName, ":"), true
+}
+
 func rewritePrometheusMetrics(req *http.Request, data []byte, vClient client.Client) ([]byte, error) {
 	metricsFamilies, err := MetricsDecode(data)
 	if err != nil {
@@ -146,6 +170,9 @@ func rewriteStats(ctx context.Context, data []byte, vClient client.Client) ([]by
 		return nil, err
 	}
 
+	// rewrite node
+	stats.Node.NodeName = translate.Nodes.VirtualName(stats.Node.NodeName)
+
 	// rewrite pods
 	newPods := []statsv1alpha1.PodStats{}
 	for _, pod := range stats.Pods {
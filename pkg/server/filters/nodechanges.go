@@ -10,6 +10,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/server/handler"
 	"github.com/loft-sh/vcluster/pkg/util/encoding"
 	requestpkg "github.com/loft-sh/vcluster/pkg/util/request"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metainternalversionscheme "k8s.io/apimachinery/pkg/apis/meta/internalversion/scheme"
@@ -128,8 +129,12 @@ func updateNode(ctx context.Context, decoder encoding.Decoder, localClient clien
 	}
 
 	// get the corresponding physical node
+	hostName, ok := translate.Nodes.HostName(vNode.Name)
+	if !ok {
+		return nil, kerrors.NewNotFound(corev1.Resource("nodes"), vNode.Name)
+	}
 	pNode := &corev1.Node{}
-	err = localClient.Get(ctx, client.ObjectKey{Name: vNode.Name}, pNode)
+	err = localClient.Get(ctx, client.ObjectKey{Name: hostName}, pNode)
 	if err != nil {
 		if kerrors.IsNotFound(err) {
 			return nil, kerrors.NewNotFound(corev1.Resource("nodes"), vNode.Name)
@@ -141,6 +146,14 @@ func updateNode(ctx context.Context, decoder encoding.Decoder, localClient clien
 	// apply the changes to from the vNode
 	newNode := pNode.DeepCopy()
 	newNode.Labels = vNode.Labels
+	if translate.Nodes.Private() && pNode.Labels[corev1.LabelHostname] != "" {
+		// keep the real hostname, as the virtual node only knows the alias
+		newNode.Labels = map[string]string{}
+		for k, v := range vNode.Labels {
+			newNode.Labels[k] = v
+		}
+		newNode.Labels[corev1.LabelHostname] = pNode.Labels[corev1.LabelHostname]
+	}
 	newNode.Spec.Taints = vNode.Spec.Taints
 	newNode.Status.Capacity = vNode.Status.Capacity
 
@@ -0,0 +1,26 @@
+package filters
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+)
+
+// WithReadOnly rejects all requests that could change the virtual cluster, which is used while the
+// virtual cluster is frozen to take a consistent snapshot
+func WithReadOnly(h http.Handler) http.Handler {
+	s := serializer.NewCodecFactory(scheme.Scheme)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			h.ServeHTTP(w, req)
+		default:
+			responsewriters.ErrorNegotiated(kerrors.NewServiceUnavailable(fmt.Sprintf("vcluster is frozen, %s requests are not allowed", req.Method)), s, corev1.SchemeGroupVersion, w, req)
+		}
+	})
+}
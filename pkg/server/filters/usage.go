@@ -0,0 +1,86 @@
+package filters
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/loft-sh/vcluster/pkg/usage"
+	"github.com/loft-sh/vcluster/pkg/util/clienthelper"
+	requestpkg "github.com/loft-sh/vcluster/pkg/util/request"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	metricsv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	UsagePath = "/vcluster/usage"
+)
+
+// WithUsage serves the host resource usage of the virtual cluster. Access is granted to virtual users
+// that are allowed to get the non resource url.
+func WithUsage(h http.Handler, localConfig *rest.Config, uncachedVirtualClient client.Client, options usage.Options) http.Handler {
+	hostClient, err := kubernetes.NewForConfig(localConfig)
+	if err != nil {
+		return h
+	}
+	metricsClient, err := metricsv1beta1.NewForConfig(localConfig)
+	if err != nil {
+		return h
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != UsagePath {
+			h.ServeHTTP(w, req)
+			return
+		} else if req.Method != http.MethodGet {
+			requestpkg.FailWithStatus(w, req, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", req.Method))
+			return
+		}
+
+		// check if the user is allowed to see the usage
+		user, ok := request.UserFrom(req.Context())
+		if !ok {
+			requestpkg.FailWithStatus(w, req, http.StatusUnauthorized, fmt.Errorf("user is missing"))
+			return
+		}
+		accessReview := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User:   user.GetName(),
+				UID:    user.GetUID(),
+				Groups: user.GetGroups(),
+				Extra:  clienthelper.ConvertExtra(user.GetExtra()),
+				NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+					Path: UsagePath,
+					Verb: "get",
+				},
+			},
+		}
+		err := uncachedVirtualClient.Create(req.Context(), accessReview)
+		if err != nil {
+			requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+			return
+		} else if !accessReview.Status.Allowed || accessReview.Status.Denied {
+			requestpkg.FailWithStatus(w, req, http.StatusForbidden, fmt.Errorf("user %s is not allowed to get %s", user.GetName(), UsagePath))
+			return
+		}
+
+		report, err := usage.Collect(req.Context(), hostClient, metricsClient, options)
+		if err != nil {
+			requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+			return
+		}
+
+		out, err := json.Marshal(report)
+		if err != nil {
+			requestpkg.FailWithStatus(w, req, http.StatusInternalServerError, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(out)
+	})
+}
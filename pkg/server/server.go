@@ -9,7 +9,9 @@ import (
 	"strconv"
 	"time"
 
+	vclusterconfig "github.com/loft-sh/vcluster/config"
 	"github.com/loft-sh/vcluster/pkg/authentication/delegatingauthenticator"
+	"github.com/loft-sh/vcluster/pkg/authentication/oidcauthenticator"
 	"github.com/loft-sh/vcluster/pkg/authorization/allowall"
 	"github.com/loft-sh/vcluster/pkg/authorization/delegatingauthorizer"
 	"github.com/loft-sh/vcluster/pkg/authorization/impersonationauthorizer"
@@ -23,6 +25,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/server/filters"
 	"github.com/loft-sh/vcluster/pkg/server/handler"
 	servertypes "github.com/loft-sh/vcluster/pkg/server/types"
+	"github.com/loft-sh/vcluster/pkg/usage"
 	"github.com/loft-sh/vcluster/pkg/util/blockingcacheclient"
 	"github.com/loft-sh/vcluster/pkg/util/pluginhookclient"
 	"github.com/loft-sh/vcluster/pkg/util/serverhelper"
@@ -71,6 +74,9 @@ type Server struct {
 	clientCaFile           string
 	redirectResources      []delegatingauthorizer.GroupVersionResourceVerb
 	fakeKubeletIPs         bool
+	audit                  vclusterconfig.ControlPlaneAudit
+	oidc                   vclusterconfig.ControlPlaneOIDC
+	auditAnnotations       map[string]string
 }
 
 // NewServer creates and installs a new Server.
@@ -159,6 +165,13 @@ func NewServer(ctx *config.ControllerContext, requestHeaderCaFile, clientCaFile
 
 		fakeKubeletIPs: ctx.Config.Networking.Advanced.ProxyKubelets.ByIP,
 
+		audit: ctx.Config.ControlPlane.Advanced.Audit,
+		oidc:  ctx.Config.ControlPlane.Advanced.OIDC,
+		auditAnnotations: map[string]string{
+			AuditAnnotationVClusterName:      ctx.Config.Name,
+			AuditAnnotationVClusterNamespace: ctx.Config.WorkloadNamespace,
+		},
+
 		currentNamespace:       ctx.Config.WorkloadNamespace,
 		currentNamespaceClient: cachedLocalClient,
 
@@ -224,6 +237,11 @@ func NewServer(ctx *config.ControllerContext, requestHeaderCaFile, clientCaFile
 	}
 	h = filters.WithFakeKubelet(h, localConfig, cachedVirtualClient)
 	h = filters.WithK3sConnect(h)
+	h = filters.WithUsage(h, localConfig, uncachedVirtualClient, usage.Options{
+		Name:           ctx.Config.Name,
+		Namespace:      ctx.Config.WorkloadNamespace,
+		MultiNamespace: ctx.Config.Experimental.MultiNamespaceMode.Enabled,
+	})
 
 	if os.Getenv("DEBUG") == "true" {
 		h = filters.WithPprof(h)
@@ -289,6 +307,38 @@ func (s *Server) ServeOnListenerTLS(address string, port int, stopChan <-chan st
 	// make sure the tokens are correctly authenticated
 	serverConfig.Authentication.Authenticator = unionauthentication.NewFailOnError(delegatingauthenticator.New(s.uncachedVirtualClient), serverConfig.Authentication.Authenticator)
 
+	// accept oidc id tokens, the oidc authenticator ignores tokens from other issuers
+	if s.oidc.Enabled {
+		oidcAuthenticator, err := oidcauthenticator.New(oidcauthenticator.Options{
+			IssuerURL:      s.oidc.IssuerURL,
+			ClientID:       s.oidc.ClientID,
+			UsernameClaim:  s.oidc.UsernameClaim,
+			UsernamePrefix: s.oidc.UsernamePrefix,
+			GroupsClaim:    s.oidc.GroupsClaim,
+			GroupsPrefix:   s.oidc.GroupsPrefix,
+			RequiredClaims: s.oidc.RequiredClaims,
+			CA:             []byte(s.oidc.CA),
+		})
+		if err != nil {
+			return errors.Wrap(err, "create oidc authenticator")
+		}
+
+		serverConfig.Authentication.Authenticator = unionauthentication.New(oidcAuthenticator, serverConfig.Authentication.Authenticator)
+	}
+
+	// configure audit logging
+	err = applyAuditConfig(serverConfig, s.audit)
+	if err != nil {
+		return errors.Wrap(err, "apply audit config")
+	}
+	if serverConfig.AuditBackend != nil {
+		err = serverConfig.AuditBackend.Run(stopChan)
+		if err != nil {
+			return errors.Wrap(err, "start audit backend")
+		}
+		defer serverConfig.AuditBackend.Shutdown()
+	}
+
 	// create server
 	klog.Info("Starting tls proxy server at " + address + ":" + strconv.Itoa(port))
 	stopped, _, err := serverConfig.SecureServing.Serve(s.buildHandlerChain(serverConfig), serverConfig.RequestTimeout, stopChan)
@@ -349,13 +399,13 @@ func createCachedClient(ctx context.Context, config *rest.Config, namespace stri
 }
 
 func (s *Server) buildHandlerChain(serverConfig *server.Config) http.Handler {
-	defaultHandler := DefaultBuildHandlerChain(s.handler, serverConfig)
+	defaultHandler := DefaultBuildHandlerChain(s.handler, serverConfig, s.auditAnnotations)
 	defaultHandler = filters.WithNodeName(defaultHandler, s.currentNamespace, s.fakeKubeletIPs, s.cachedVirtualClient, s.currentNamespaceClient)
 	return defaultHandler
 }
 
 // Copied from "k8s.io/apiserver/pkg/server" package
-func DefaultBuildHandlerChain(apiHandler http.Handler, c *server.Config) http.Handler {
+func DefaultBuildHandlerChain(apiHandler http.Handler, c *server.Config, auditAnnotations map[string]string) http.Handler {
 	// adding here for plugins that request the req to be authorized
 	handler := plugin.DefaultManager.WithInterceptors(apiHandler)
 
@@ -376,6 +426,7 @@ func DefaultBuildHandlerChain(apiHandler http.Handler, c *server.Config) http.Ha
 
 	handler = filterlatency.TrackCompleted(handler)
 	handler = genericapifilters.WithImpersonation(handler, c.Authorization.Authorizer, c.Serializer)
+	handler = WithAuditAnnotations(handler, auditAnnotations)
 	// @matskiv: save the user.Info object before impersonation which might override it
 	handler = WithOriginalUser(handler)
 	handler = filterlatency.TrackStarted(handler, c.TracerProvider, "impersonation")
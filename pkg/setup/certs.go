@@ -0,0 +1,59 @@
+package setup
+
+import (
+	"os"
+	"time"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/certs"
+	"github.com/loft-sh/vcluster/pkg/config"
+	"k8s.io/klog/v2"
+)
+
+// StartCertRotation renews the control plane certificates before they expire or warns about expiring certificates
+// if the renewal is disabled. This needs to run in the leader.
+func StartCertRotation(ctx *config.ControllerContext) {
+	if !certRotationEnabled(ctx.Config) {
+		if ctx.Config.Distro() != vclusterconfig.K0SDistro {
+			certs.StartExpiryWarning(ctx.Context, ctx.Config.ControlPlaneClient, ctx.Config.ControlPlaneNamespace, ctx.Config.Name, CertRotateOptions(ctx.Config.ControlPlane.Advanced.CertRotation).RenewBefore)
+		}
+		return
+	}
+
+	certs.StartRotationController(ctx.Context, ctx.Config.ControlPlaneClient, ctx.Config.ControlPlaneNamespace, ctx.Config.Name, CertRotateOptions(ctx.Config.ControlPlane.Advanced.CertRotation))
+}
+
+// WatchCertificates restarts the control plane as soon as its certificates were renewed, so the new
+// certificates are picked up by all components. This needs to run in every replica, replicas restart
+// one after another.
+func WatchCertificates(ctx *config.ControllerContext) error {
+	if !certRotationEnabled(ctx.Config) {
+		return nil
+	}
+
+	podName, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+
+	return certs.WatchCertsSecret(ctx.Context, ctx.Config.ControlPlaneClient, ctx.Config.ControlPlaneNamespace, ctx.Config.Name, func() {
+		// restart the replicas one after another, so the control plane stays available
+		certs.WaitForPreviousReplica(ctx.Context, ctx.Config.ControlPlaneClient, ctx.Config.ControlPlaneNamespace, ctx.Config.Name, podName, time.Now())
+
+		klog.Info("Certificates were renewed, restarting vCluster to pick up the new certificates")
+		os.Exit(0)
+	})
+}
+
+// CertRotateOptions converts the cert rotation config into rotate options
+func CertRotateOptions(certRotation vclusterconfig.ControlPlaneCertRotation) certs.RotateOptions {
+	return certs.RotateOptions{
+		RenewBefore: time.Duration(certRotation.RenewBeforeDays) * time.Hour * 24,
+		RotateCA:    certRotation.CA.Enabled,
+		CAOverlap:   time.Duration(certRotation.CA.OverlapDays) * time.Hour * 24,
+	}
+}
+
+func certRotationEnabled(vConfig *config.VirtualClusterConfig) bool {
+	return certs.WatchEnabled(&vConfig.Config)
+}
@@ -17,8 +17,9 @@ import (
 )
 
 const (
-	AnnotationDistro = "vcluster.loft.sh/distro"
-	AnnotationStore  = "vcluster.loft.sh/store"
+	AnnotationDistro  = "vcluster.loft.sh/distro"
+	AnnotationStore   = "vcluster.loft.sh/store"
+	AnnotationRestore = "vcluster.loft.sh/restore-pending"
 )
 
 func InitAndValidateConfig(ctx context.Context, vConfig *config.VirtualClusterConfig) error {
@@ -58,13 +59,51 @@ func InitAndValidateConfig(ctx context.Context, vConfig *config.VirtualClusterCo
 		translate.Default = translate.NewSingleNamespaceTranslator(vConfig.WorkloadTargetNamespace)
 	}
 
-	if err := EnsureBackingStoreChanges(
+	// hide host node names if node privacy is enabled
+	if vConfig.Sync.FromHost.Nodes.Privacy.Enabled {
+		translate.Nodes = translate.NewPrivateNodeTranslator(vConfig.WorkloadNamespace + "/" + vConfig.Name)
+		err = translate.RegisterHostNodes(ctx, vConfig.WorkloadClient)
+		if err != nil {
+			return fmt.Errorf("register host nodes: %w", err)
+		}
+	}
+
+	backingStoreType := vConfig.BackingStoreType()
+	if vConfig.ControlPlane.BackingStore.Migration.Enabled {
+		previousBackingStoreType, err := GetAnnotatedBackingStoreType(ctx, vConfig.ControlPlaneClient, vConfig.Name, vConfig.ControlPlaneNamespace)
+		if err != nil {
+			return err
+		}
+
+		// the store annotation is updated by MigrateBackingStore after the data was migrated
+		if previousBackingStoreType != "" && previousBackingStoreType != backingStoreType {
+			if err := vclusterconfig.ValidateStoreMigration(previousBackingStoreType, backingStoreType); err != nil {
+				return err
+			}
+
+			backingStoreType = previousBackingStoreType
+		}
+	}
+
+	if vConfig.DryRun {
+		// only validate, the annotations are updated once the vCluster actually starts
+		if err := ValidateBackingStoreChanges(
+			ctx,
+			vConfig.ControlPlaneClient,
+			vConfig.Name,
+			vConfig.ControlPlaneNamespace,
+			vConfig.Distro(),
+			backingStoreType,
+		); err != nil {
+			return err
+		}
+	} else if err := EnsureBackingStoreChanges(
 		ctx,
 		vConfig.ControlPlaneClient,
 		vConfig.Name,
 		vConfig.ControlPlaneNamespace,
 		vConfig.Distro(),
-		vConfig.BackingStoreType(),
+		backingStoreType,
 	); err != nil {
 		return err
 	}
@@ -106,6 +145,21 @@ func EnsureBackingStoreChanges(ctx context.Context, client kubernetes.Interface,
 	return nil
 }
 
+// ValidateBackingStoreChanges runs the same checks as EnsureBackingStoreChanges without updating the secret annotations.
+func ValidateBackingStoreChanges(ctx context.Context, client kubernetes.Interface, name, namespace, distro string, backingStoreType vclusterconfig.StoreType) error {
+	if ok, err := CheckUsingSecretAnnotation(ctx, client, name, namespace, distro, backingStoreType); err != nil {
+		return fmt.Errorf("using secret annotations: %w", err)
+	} else if ok {
+		return nil
+	}
+
+	if _, err := CheckUsingHeuristic(distro); err != nil {
+		return fmt.Errorf("using heuristic: %w", err)
+	}
+
+	return nil
+}
+
 // CheckUsingHeuristic checks for known file path indicating the existence of a previous distro.
 //
 // It checks for the existence of the default K3s token path or the K0s data directory.
@@ -13,6 +13,7 @@ import (
 	"github.com/loft-sh/vcluster/pkg/scheme"
 	"github.com/loft-sh/vcluster/pkg/telemetry"
 	"github.com/loft-sh/vcluster/pkg/util/blockingcacheclient"
+	"github.com/loft-sh/vcluster/pkg/util/dryrunclient"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -101,6 +102,51 @@ func NewControllerContext(ctx context.Context, options *config.VirtualClusterCon
 	return controllerContext, nil
 }
 
+// NewDryRunControllerContext builds a controller context whose clients record all writes with the given
+// recorder instead of sending them to the host or virtual cluster. Plugins and metrics are not started and syncers
+// that would create CRDs in the virtual cluster only look them up.
+func NewDryRunControllerContext(ctx context.Context, options *config.VirtualClusterConfig, recorder *dryrunclient.Recorder) (*config.ControllerContext, error) {
+	options.DryRun = true
+
+	// load virtual config
+	virtualConfig, virtualRawConfig, err := loadVirtualConfig(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// create physical manager
+	localManager, err := NewLocalManager(options.WorkloadConfig, ctrl.Options{
+		Scheme:         scheme.Scheme,
+		Metrics:        metricsserver.Options{BindAddress: "0"},
+		LeaderElection: false,
+		Cache:          getLocalCacheOptions(options),
+		NewClient:      dryrunclient.NewClientFactory(recorder, dryrunclient.ClusterHost, pro.NewPhysicalClient(options)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// create virtual manager
+	virtualClusterManager, err := NewVirtualManager(virtualConfig, ctrl.Options{
+		Scheme:         scheme.Scheme,
+		Metrics:        metricsserver.Options{BindAddress: "0"},
+		LeaderElection: false,
+		NewClient:      dryrunclient.NewClientFactory(recorder, dryrunclient.ClusterVirtual, pro.NewVirtualClient(options)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// init controller context
+	controllerContext, err := initControllerContext(ctx, localManager, virtualClusterManager, virtualRawConfig, options)
+	if err != nil {
+		return nil, fmt.Errorf("init controller context: %w", err)
+	}
+	controllerContext.WorkloadNamespaceClient = dryrunclient.WrapClient(recorder, dryrunclient.ClusterHost, controllerContext.WorkloadNamespaceClient)
+
+	return controllerContext, nil
+}
+
 func getLocalCacheOptions(options *config.VirtualClusterConfig) cache.Options {
 	// is multi namespace mode?
 	defaultNamespaces := make(map[string]cache.Config)
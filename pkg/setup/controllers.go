@@ -151,6 +151,9 @@ func StartControllers(controllerContext *config.ControllerContext) error {
 		}, time.Minute, controllerContext.StopChan)
 	}()
 
+	// renew the control plane certificates before they expire
+	StartCertRotation(controllerContext)
+
 	// set leader
 	err = plugin.DefaultManager.SetLeader(controllerContext.Context)
 	if err != nil {
@@ -0,0 +1,25 @@
+package setup
+
+import (
+	"net/http"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/lifecycle"
+	"github.com/loft-sh/vcluster/pkg/server/filters"
+	"k8s.io/klog/v2"
+)
+
+// StartFrozen makes the vCluster read-only if it was started frozen and returns true in that case, which means
+// controllers must not be started. This is used to take a consistent snapshot before moving the vCluster.
+func StartFrozen(ctx *config.ControllerContext) (bool, error) {
+	frozen, err := lifecycle.IsFrozen(ctx.Context, ctx.Config.ControlPlaneClient, ctx.Config.Name, ctx.Config.ControlPlaneNamespace)
+	if err != nil || !frozen {
+		return false, err
+	}
+
+	klog.Info("vCluster is frozen, syncing is disabled and write requests are rejected")
+	ctx.PostServerHooks = append(ctx.PostServerHooks, func(h http.Handler, _ config.Clients) http.Handler {
+		return filters.WithReadOnly(h)
+	})
+	return true, nil
+}
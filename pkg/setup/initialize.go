@@ -93,6 +93,12 @@ func initialize(ctx context.Context, parentCtx context.Context, options *config.
 			return err
 		}
 
+		// migrate the data of the previous backing store
+		err = MigrateBackingStore(parentCtx, options)
+		if err != nil {
+			return err
+		}
+
 		// should start embedded etcd?
 		if options.ControlPlane.BackingStore.Etcd.Embedded.Enabled {
 			err = pro.StartEmbeddedEtcd(
@@ -108,6 +114,12 @@ func initialize(ctx context.Context, parentCtx context.Context, options *config.
 			}
 		}
 
+		// apply a staged snapshot before the api server starts
+		err = RestoreBackingStore(parentCtx, options)
+		if err != nil {
+			return err
+		}
+
 		// start k0s
 		parentCtxWithCancel, cancel := context.WithCancel(parentCtx)
 		go func() {
@@ -139,6 +151,12 @@ func initialize(ctx context.Context, parentCtx context.Context, options *config.
 			return err
 		}
 
+		// migrate the data of the previous backing store
+		err = MigrateBackingStore(parentCtx, options)
+		if err != nil {
+			return err
+		}
+
 		// should start embedded etcd?
 		if options.ControlPlane.BackingStore.Etcd.Embedded.Enabled {
 			// we need to run this with the parent ctx as otherwise this context
@@ -156,6 +174,12 @@ func initialize(ctx context.Context, parentCtx context.Context, options *config.
 			}
 		}
 
+		// apply a staged snapshot before the api server starts
+		err = RestoreBackingStore(parentCtx, options)
+		if err != nil {
+			return err
+		}
+
 		// start k3s
 		go func() {
 			// we need to run this with the parent ctx as otherwise this context will be cancelled by the wait
@@ -175,10 +199,16 @@ func initialize(ctx context.Context, parentCtx context.Context, options *config.
 			}
 		}
 
+		// migrate the data of the previous backing store
+		err := MigrateBackingStore(parentCtx, options)
+		if err != nil {
+			return err
+		}
+
 		// should start embedded etcd?
 		if options.ControlPlane.BackingStore.Etcd.Embedded.Enabled {
 			// start embedded etcd
-			err := pro.StartEmbeddedEtcd(
+			err = pro.StartEmbeddedEtcd(
 				parentCtx,
 				options.Name,
 				options.ControlPlaneNamespace,
@@ -191,6 +221,12 @@ func initialize(ctx context.Context, parentCtx context.Context, options *config.
 			}
 		}
 
+		// apply a staged snapshot before the api server starts
+		err = RestoreBackingStore(parentCtx, options)
+		if err != nil {
+			return err
+		}
+
 		// start k8s
 		go func() {
 			// we need to run this with the parent ctx as otherwise this context will be cancelled by the wait
@@ -0,0 +1,96 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	vclusterconfig "github.com/loft-sh/vcluster/config"
+	"github.com/loft-sh/vcluster/pkg/backingstore"
+	"github.com/loft-sh/vcluster/pkg/config"
+	"golang.org/x/exp/maps"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+const (
+	migrationSourceKineSocket = "/tmp/vcluster-migration-source.sock"
+	migrationTargetKineSocket = "/tmp/vcluster-migration-target.sock"
+)
+
+// MigrateBackingStore copies the data of the previously used backing store into the configured one, if the backing
+// store has changed and migration is enabled. The store annotation of the config secret is only updated after the
+// migration succeeded, which means a failed migration will be retried on the next start.
+func MigrateBackingStore(ctx context.Context, options *config.VirtualClusterConfig) error {
+	migration := options.ControlPlane.BackingStore.Migration
+	if !migration.Enabled {
+		return nil
+	}
+
+	previousStoreType, err := GetAnnotatedBackingStoreType(ctx, options.ControlPlaneClient, options.Name, options.ControlPlaneNamespace)
+	if err != nil {
+		return err
+	}
+
+	currentStoreType := options.BackingStoreType()
+	if previousStoreType == "" || previousStoreType == currentStoreType {
+		return nil
+	} else if err := vclusterconfig.ValidateStoreMigration(previousStoreType, currentStoreType); err != nil {
+		return err
+	}
+
+	// connect to both backing stores
+	klog.Infof("Migrating backing store from %s to %s (dry run: %v)", previousStoreType, currentStoreType, migration.DryRun)
+	sourceClient, closeSource, err := backingstore.NewClient(ctx, options, backingstore.Store{
+		Type: previousStoreType,
+		Database: vclusterconfig.DatabaseKine{
+			DataSource: migration.Source.DataSource,
+			KeyFile:    migration.Source.KeyFile,
+			CertFile:   migration.Source.CertFile,
+			CaFile:     migration.Source.CaFile,
+		},
+	}, backingstore.ClientOptions{KineSocket: migrationSourceKineSocket})
+	if err != nil {
+		return fmt.Errorf("connect to previous backing store %s: %w", previousStoreType, err)
+	}
+	defer closeSource()
+
+	targetClient, closeTarget, err := backingstore.NewClient(ctx, options, backingstore.StoreFromConfig(options), backingstore.ClientOptions{KineSocket: migrationTargetKineSocket})
+	if err != nil {
+		return fmt.Errorf("connect to backing store %s: %w", currentStoreType, err)
+	}
+	defer closeTarget()
+
+	// migrate the data
+	summary, err := backingstore.Migrate(ctx, sourceClient, targetClient, backingstore.MigrateOptions{
+		DryRun:     migration.DryRun,
+		SkipVerify: migration.SkipVerify,
+	})
+	if err != nil {
+		return fmt.Errorf("migrate backing store from %s to %s: %w", previousStoreType, currentStoreType, err)
+	}
+
+	resources := maps.Keys(summary)
+	slices.Sort(resources)
+	for _, resource := range resources {
+		klog.Infof("Backing store migration: %d %s", summary[resource], resource)
+	}
+	if migration.DryRun {
+		return fmt.Errorf("dry run of backing store migration from %s to %s finished, please disable controlPlane.backingStore.migration.dryRun to migrate the data", previousStoreType, currentStoreType)
+	}
+
+	klog.Infof("Successfully migrated backing store from %s to %s", previousStoreType, currentStoreType)
+	return updateSecretAnnotations(ctx, options.ControlPlaneClient, options.Name, options.ControlPlaneNamespace, options.Distro(), currentStoreType)
+}
+
+// GetAnnotatedBackingStoreType returns the backing store type recorded on the vCluster's config secret or an empty
+// string if there is none.
+func GetAnnotatedBackingStoreType(ctx context.Context, client kubernetes.Interface, name, namespace string) (vclusterconfig.StoreType, error) {
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, "vc-config-"+name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("get secret: %w", err)
+	}
+
+	return vclusterconfig.StoreType(secret.Annotations[AnnotationStore]), nil
+}
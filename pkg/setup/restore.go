@@ -0,0 +1,85 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/loft-sh/vcluster/pkg/backingstore"
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/snapshot"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+const restoreKineSocket = "/tmp/vcluster-restore-kine.sock"
+
+// RestoreBackingStore applies a snapshot that was staged by `vcluster restore`. It has to run before the api
+// server is started, so no running api server writes into the backing store while it is replaced. The restore
+// annotation of the config secret is only removed after the snapshot was applied, which means a failed restore
+// will be retried on the next start.
+func RestoreBackingStore(ctx context.Context, options *config.VirtualClusterConfig) error {
+	pending, err := IsRestorePending(ctx, options.ControlPlaneClient, options.Name, options.ControlPlaneNamespace)
+	if err != nil || !pending {
+		return err
+	}
+
+	etcdClient, closeClient, err := backingstore.NewClient(ctx, options, backingstore.StoreFromConfig(options), backingstore.ClientOptions{KineSocket: restoreKineSocket})
+	if err != nil {
+		return fmt.Errorf("connect to backing store: %w", err)
+	}
+	defer closeClient()
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("get hostname: %w", err)
+	}
+
+	klog.Info("Restoring staged snapshot...")
+	_, err = snapshot.Apply(ctx, etcdClient, hostname)
+	if err != nil {
+		return fmt.Errorf("restore snapshot: %w", err)
+	}
+
+	return SetRestorePending(ctx, options.ControlPlaneClient, options.Name, options.ControlPlaneNamespace, false)
+}
+
+// IsRestorePending returns true if a snapshot was staged and waits to be applied on the next start
+func IsRestorePending(ctx context.Context, client kubernetes.Interface, name, namespace string) (bool, error) {
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, "vc-config-"+name, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("get secret: %w", err)
+	}
+
+	return secret.Annotations[AnnotationRestore] == "true", nil
+}
+
+// SetRestorePending marks on the config secret whether a staged snapshot should be applied on the next start
+func SetRestorePending(ctx context.Context, client kubernetes.Interface, name, namespace string, pending bool) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		secret, err := client.CoreV1().Secrets(namespace).Get(ctx, "vc-config-"+name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("get secret: %w", err)
+		}
+
+		if (secret.Annotations[AnnotationRestore] == "true") == pending {
+			return nil
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		if pending {
+			secret.Annotations[AnnotationRestore] = "true"
+		} else {
+			delete(secret.Annotations, AnnotationRestore)
+		}
+
+		if _, err := client.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("update secret: %w", err)
+		}
+
+		return nil
+	})
+}
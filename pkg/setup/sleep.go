@@ -0,0 +1,24 @@
+package setup
+
+import (
+	"net/http"
+
+	"github.com/loft-sh/vcluster/pkg/config"
+	"github.com/loft-sh/vcluster/pkg/lifecycle"
+	"github.com/loft-sh/vcluster/pkg/server/filters"
+)
+
+// StartActivityTracking records the requests to the vCluster and publishes the last activity for the sleep mode
+// proxy. This needs to run in every replica before the proxy is started.
+func StartActivityTracking(ctx *config.ControllerContext) {
+	if !ctx.Config.Experimental.SleepMode.Enabled {
+		return
+	}
+
+	tracker := lifecycle.NewActivityTracker()
+	ctx.PostServerHooks = append(ctx.PostServerHooks, func(h http.Handler, _ config.Clients) http.Handler {
+		return filters.WithActivity(h, tracker, ctx.Config.Experimental.SleepMode.IgnoreServiceAccounts)
+	})
+
+	lifecycle.StartActivityPublisher(ctx.Context, ctx.Config.ControlPlaneClient, ctx.Config.Name, ctx.Config.ControlPlaneNamespace, tracker)
+}
@@ -0,0 +1,207 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/backingstore"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// StagingPrefix is the key prefix a snapshot is staged under until it is applied. It is outside of the
+	// kubernetes registry, so the api server ignores it.
+	StagingPrefix = "/vcluster/restore/"
+
+	stagingDataPrefix = StagingPrefix + "data"
+	stagingPendingKey = StagingPrefix + "pending"
+)
+
+// pendingRestore is stored at the pending key once a snapshot was staged completely
+type pendingRestore struct {
+	Metadata Metadata `json:"metadata"`
+
+	// Keys is the amount of staged keys
+	Keys int64 `json:"keys"`
+
+	// Owner is the control plane instance that applies the snapshot
+	Owner string `json:"owner,omitempty"`
+}
+
+// Stage reads and validates the complete snapshot from r and stages its keys within the backing store. The
+// kubernetes keys of the backing store are left untouched until Apply is called, which has to happen while
+// the api server is stopped. Stage returns the decoded metadata and certificates of the archive.
+func Stage(ctx context.Context, etcdClient *clientv3.Client, r io.Reader) (*Archive, error) {
+	// remove leftovers of a previous restore
+	err := backingstore.DeletePrefix(ctx, etcdClient, StagingPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, count, err := stage(ctx, etcdClient, r)
+	if err != nil {
+		_ = backingstore.DeletePrefix(ctx, etcdClient, StagingPrefix)
+		return nil, err
+	}
+
+	// only mark the snapshot as pending after it was read completely
+	pending, err := json.Marshal(&pendingRestore{Metadata: archive.Metadata, Keys: count})
+	if err != nil {
+		return nil, err
+	}
+	err = backingstore.Put(ctx, etcdClient, []byte(stagingPendingKey), pending)
+	if err != nil {
+		_ = backingstore.DeletePrefix(ctx, etcdClient, StagingPrefix)
+		return nil, err
+	}
+
+	klog.FromContext(ctx).Info("Staged snapshot", "keys", count, "name", archive.Metadata.Name, "created", archive.Metadata.Created)
+	return archive, nil
+}
+
+func stage(ctx context.Context, etcdClient *clientv3.Client, r io.Reader) (*Archive, int64, error) {
+	reader, err := NewReader(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer reader.Close()
+
+	// the first record has to be the metadata
+	record, err := reader.Next()
+	if err != nil {
+		return nil, 0, fmt.Errorf("read snapshot metadata: %w", err)
+	} else if record.Type != RecordTypeMetadata || record.Metadata == nil {
+		return nil, 0, errors.New("snapshot is missing metadata")
+	} else if record.Metadata.Version != FormatVersion {
+		return nil, 0, fmt.Errorf("unsupported snapshot version %d, expected %d", record.Metadata.Version, FormatVersion)
+	}
+
+	archive := &Archive{
+		Metadata: *record.Metadata,
+		Certs:    map[string][]byte{},
+	}
+
+	count := int64(0)
+	for {
+		record, err = reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, 0, fmt.Errorf("read snapshot: %w", err)
+		}
+
+		switch record.Type {
+		case RecordTypeCert:
+			archive.Certs[record.Name] = record.Data
+		case RecordTypeKeyValue:
+			if !strings.HasPrefix(string(record.Key), backingstore.RegistryPrefix) {
+				return nil, 0, fmt.Errorf("unexpected snapshot key %q", string(record.Key))
+			}
+
+			err = backingstore.Put(ctx, etcdClient, append([]byte(stagingDataPrefix), record.Key...), record.Data)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			count++
+		default:
+			return nil, 0, fmt.Errorf("unexpected snapshot record type %q", record.Type)
+		}
+	}
+
+	return archive, count, nil
+}
+
+// Apply replaces all kubernetes keys within the backing store with the ones of the staged snapshot and
+// removes the staged snapshot afterwards. It returns nil if there is no staged snapshot. Apply must only
+// be called while the api server is stopped. If it fails, the staged snapshot is kept, so it can be retried.
+// If multiple control plane instances start at the same time, the first one applies the snapshot and the
+// others wait until it is done.
+func Apply(ctx context.Context, etcdClient *clientv3.Client, owner string) (*Metadata, error) {
+	for {
+		resp, err := etcdClient.Get(ctx, stagingPendingKey)
+		if err != nil {
+			return nil, fmt.Errorf("get staged snapshot: %w", err)
+		} else if len(resp.Kvs) == 0 {
+			return nil, nil
+		}
+
+		pending := &pendingRestore{}
+		err = json.Unmarshal(resp.Kvs[0].Value, pending)
+		if err != nil {
+			return nil, fmt.Errorf("parse staged snapshot: %w", err)
+		}
+
+		switch pending.Owner {
+		case owner:
+			err = apply(ctx, etcdClient, pending)
+			if err != nil {
+				return nil, err
+			}
+
+			return &pending.Metadata, nil
+		case "":
+			pending.Owner = owner
+			claimed, err := json.Marshal(pending)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = etcdClient.Txn(ctx).
+				If(clientv3.Compare(clientv3.ModRevision(stagingPendingKey), "=", resp.Kvs[0].ModRevision)).
+				Then(clientv3.OpPut(stagingPendingKey, string(claimed))).
+				Else(clientv3.OpGet(stagingPendingKey)).
+				Commit()
+			if err != nil {
+				return nil, fmt.Errorf("claim staged snapshot: %w", err)
+			}
+		default:
+			klog.FromContext(ctx).Info("Waiting for staged snapshot to be applied", "owner", pending.Owner)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(2 * time.Second):
+			}
+		}
+	}
+}
+
+func apply(ctx context.Context, etcdClient *clientv3.Client, pending *pendingRestore) error {
+	resp, err := etcdClient.Get(ctx, stagingDataPrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return fmt.Errorf("count staged keys: %w", err)
+	} else if resp.Count != pending.Keys {
+		return fmt.Errorf("staged snapshot has %d keys, but expected %d", resp.Count, pending.Keys)
+	}
+
+	err = backingstore.DeleteAll(ctx, etcdClient)
+	if err != nil {
+		return err
+	}
+
+	err = backingstore.ListPrefix(ctx, etcdClient, stagingDataPrefix, 0, func(key, value []byte) error {
+		return backingstore.Put(ctx, etcdClient, key[len(stagingDataPrefix):], value)
+	})
+	if err != nil {
+		return fmt.Errorf("apply staged snapshot: %w", err)
+	}
+
+	// remove the pending key first, so a retry never applies a partially removed staging area
+	err = backingstore.DeletePrefix(ctx, etcdClient, stagingPendingKey)
+	if err != nil {
+		return err
+	}
+	err = backingstore.DeletePrefix(ctx, etcdClient, StagingPrefix)
+	if err != nil {
+		return err
+	}
+
+	klog.FromContext(ctx).Info("Restored snapshot", "keys", pending.Keys, "name", pending.Metadata.Name, "created", pending.Metadata.Created)
+	return nil
+}
@@ -0,0 +1,120 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"gotest.tools/v3/assert"
+)
+
+func TestStageApply(t *testing.T) {
+	ctx := context.Background()
+	etcdClient, stop, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stop()
+
+	// existing keys of the running virtual cluster
+	putKeys(t, etcdClient, map[string]string{
+		"/registry/pods/default/a":     "old",
+		"/registry/pods/default/stale": "stale",
+	})
+
+	archive := &bytes.Buffer{}
+	writer := NewWriter(archive)
+	assert.NilError(t, writer.WriteMetadata(&Metadata{Version: FormatVersion, Name: "test"}))
+	assert.NilError(t, writer.WriteCert("ca.crt", []byte("ca")))
+	assert.NilError(t, writer.WriteKeyValue([]byte("/registry/pods/default/a"), []byte("new")))
+	assert.NilError(t, writer.WriteKeyValue([]byte("/registry/namespaces/default"), []byte("ns")))
+	assert.NilError(t, writer.Close())
+
+	staged, err := Stage(ctx, etcdClient, archive)
+	assert.NilError(t, err)
+	assert.Equal(t, staged.Metadata.Name, "test")
+	assert.DeepEqual(t, staged.Certs, map[string][]byte{"ca.crt": []byte("ca")})
+
+	// staging must not touch the kubernetes keys
+	assert.DeepEqual(t, getKeys(t, etcdClient, "/registry/"), map[string]string{
+		"/registry/pods/default/a":     "old",
+		"/registry/pods/default/stale": "stale",
+	})
+
+	// simulate the api server recreating a key after staging
+	putKeys(t, etcdClient, map[string]string{"/registry/namespaces/default": "recreated"})
+
+	metadata, err := Apply(ctx, etcdClient, "vcluster-0")
+	assert.NilError(t, err)
+	assert.Equal(t, metadata.Name, "test")
+	assert.DeepEqual(t, getKeys(t, etcdClient, "/registry/"), map[string]string{
+		"/registry/pods/default/a":     "new",
+		"/registry/namespaces/default": "ns",
+	})
+	assert.Equal(t, len(getKeys(t, etcdClient, StagingPrefix)), 0)
+
+	// nothing left to apply
+	metadata, err = Apply(ctx, etcdClient, "vcluster-0")
+	assert.NilError(t, err)
+	assert.Assert(t, metadata == nil)
+}
+
+func TestStageInvalidArchive(t *testing.T) {
+	ctx := context.Background()
+	etcdClient, stop, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stop()
+
+	putKeys(t, etcdClient, map[string]string{"/registry/pods/default/a": "old"})
+
+	archive := &bytes.Buffer{}
+	writer := NewWriter(archive)
+	assert.NilError(t, writer.WriteMetadata(&Metadata{Version: FormatVersion, Name: "test"}))
+	assert.NilError(t, writer.WriteKeyValue([]byte("/registry/pods/default/a"), []byte("new")))
+	assert.NilError(t, writer.WriteKeyValue([]byte("/other/key"), []byte("invalid")))
+	assert.NilError(t, writer.Close())
+
+	_, err = Stage(ctx, etcdClient, archive)
+	assert.ErrorContains(t, err, "unexpected snapshot key")
+
+	// neither the kubernetes keys nor a partially staged snapshot must be left behind
+	assert.DeepEqual(t, getKeys(t, etcdClient, "/registry/"), map[string]string{"/registry/pods/default/a": "old"})
+	assert.Equal(t, len(getKeys(t, etcdClient, StagingPrefix)), 0)
+
+	metadata, err := Apply(ctx, etcdClient, "vcluster-0")
+	assert.NilError(t, err)
+	assert.Assert(t, metadata == nil)
+}
+
+func TestApplyWaitsForOwner(t *testing.T) {
+	etcdClient, stop, err := testingutil.StartEtcd()
+	assert.NilError(t, err)
+	defer stop()
+
+	putKeys(t, etcdClient, map[string]string{stagingPendingKey: `{"metadata":{"version":1},"keys":0,"owner":"vcluster-0"}`})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = Apply(ctx, etcdClient, "vcluster-1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func putKeys(t *testing.T, etcdClient *clientv3.Client, keys map[string]string) {
+	for key, value := range keys {
+		_, err := etcdClient.Put(context.Background(), key, value)
+		assert.NilError(t, err)
+	}
+}
+
+func getKeys(t *testing.T, etcdClient *clientv3.Client, prefix string) map[string]string {
+	resp, err := etcdClient.Get(context.Background(), prefix, clientv3.WithPrefix())
+	assert.NilError(t, err)
+
+	keys := map[string]string{}
+	for _, kv := range resp.Kvs {
+		keys[string(kv.Key)] = string(kv.Value)
+	}
+
+	return keys
+}
@@ -0,0 +1,200 @@
+package snapshot
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/loft-sh/vcluster/pkg/backingstore"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/klog/v2"
+)
+
+// FormatVersion is the current version of the snapshot archive format
+const FormatVersion = 1
+
+type RecordType string
+
+const (
+	RecordTypeMetadata RecordType = "metadata"
+	RecordTypeCert     RecordType = "cert"
+	RecordTypeKeyValue RecordType = "kv"
+)
+
+// Metadata describes where a snapshot was taken from
+type Metadata struct {
+	// Version is the archive format version
+	Version int `json:"version"`
+
+	// Name is the name of the virtual cluster the snapshot was taken from
+	Name string `json:"name,omitempty"`
+
+	// Distro is the distro of the virtual cluster
+	Distro string `json:"distro,omitempty"`
+
+	// BackingStore is the backing store type of the virtual cluster
+	BackingStore string `json:"backingStore,omitempty"`
+
+	// Revision is the backing store revision the snapshot was taken at
+	Revision int64 `json:"revision,omitempty"`
+
+	// Created is the time the snapshot was taken
+	Created time.Time `json:"created"`
+}
+
+// Record is a single line within a snapshot archive. Archives are gzip compressed
+// json lines, where the first record always holds the metadata.
+type Record struct {
+	Type RecordType `json:"type"`
+
+	Metadata *Metadata `json:"metadata,omitempty"`
+
+	Name string `json:"name,omitempty"`
+	Key  []byte `json:"key,omitempty"`
+	Data []byte `json:"data,omitempty"`
+}
+
+// Archive is the decoded content of a snapshot
+type Archive struct {
+	Metadata Metadata
+	Certs    map[string][]byte
+}
+
+// Writer writes snapshot records into a compressed stream
+type Writer struct {
+	gzipWriter *gzip.Writer
+	encoder    *json.Encoder
+}
+
+func NewWriter(w io.Writer) *Writer {
+	gzipWriter := gzip.NewWriter(w)
+	return &Writer{
+		gzipWriter: gzipWriter,
+		encoder:    json.NewEncoder(gzipWriter),
+	}
+}
+
+func (w *Writer) WriteMetadata(metadata *Metadata) error {
+	return w.encoder.Encode(&Record{Type: RecordTypeMetadata, Metadata: metadata})
+}
+
+func (w *Writer) WriteCert(name string, data []byte) error {
+	return w.encoder.Encode(&Record{Type: RecordTypeCert, Name: name, Data: data})
+}
+
+func (w *Writer) WriteKeyValue(key, value []byte) error {
+	return w.encoder.Encode(&Record{Type: RecordTypeKeyValue, Key: key, Data: value})
+}
+
+func (w *Writer) Close() error {
+	return w.gzipWriter.Close()
+}
+
+// Reader reads snapshot records from a compressed stream
+type Reader struct {
+	gzipReader *gzip.Reader
+	decoder    *json.Decoder
+}
+
+func NewReader(r io.Reader) (*Reader, error) {
+	gzipReader, err := gzip.NewReader(bufio.NewReader(r))
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot: %w", err)
+	}
+
+	return &Reader{
+		gzipReader: gzipReader,
+		decoder:    json.NewDecoder(gzipReader),
+	}, nil
+}
+
+// Next returns the next record or io.EOF if there are no more records
+func (r *Reader) Next() (*Record, error) {
+	record := &Record{}
+	err := r.decoder.Decode(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (r *Reader) Close() error {
+	return r.gzipReader.Close()
+}
+
+// Filter copies the snapshot from r into w and leaves out all keys for which exclude returns true.
+// It returns the amount of keys that were left out.
+func Filter(r io.Reader, w io.Writer, exclude func(key, value []byte) bool) (int, error) {
+	reader, err := NewReader(r)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	writer := NewWriter(w)
+	excluded := 0
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return 0, fmt.Errorf("read snapshot: %w", err)
+		} else if record.Type == RecordTypeKeyValue && exclude(record.Key, record.Data) {
+			excluded++
+			continue
+		}
+
+		err = writer.encoder.Encode(record)
+		if err != nil {
+			return 0, fmt.Errorf("write snapshot: %w", err)
+		}
+	}
+
+	return excluded, writer.Close()
+}
+
+// Save writes all kubernetes keys of the backing store as well as the given certificates into w
+func Save(ctx context.Context, etcdClient *clientv3.Client, metadata *Metadata, certs map[string][]byte, w io.Writer) error {
+	writer := NewWriter(w)
+
+	// get the current revision so we read a consistent state across pages
+	resp, err := etcdClient.Get(ctx, backingstore.RegistryPrefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return fmt.Errorf("get backing store revision: %w", err)
+	}
+
+	metadata.Version = FormatVersion
+	metadata.Revision = resp.Header.Revision
+	if metadata.Created.IsZero() {
+		metadata.Created = time.Now()
+	}
+	err = writer.WriteMetadata(metadata)
+	if err != nil {
+		return err
+	}
+
+	for name, data := range certs {
+		err = writer.WriteCert(name, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	count := 0
+	err = backingstore.List(ctx, etcdClient, metadata.Revision, func(key, value []byte) error {
+		count++
+		return writer.WriteKeyValue(key, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	klog.FromContext(ctx).Info("Saved snapshot", "keys", count, "revision", metadata.Revision)
+	return writer.Close()
+}
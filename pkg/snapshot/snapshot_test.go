@@ -0,0 +1,88 @@
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWriterReaderRoundTrip(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	writer := NewWriter(buffer)
+	assert.NilError(t, writer.WriteMetadata(&Metadata{Version: FormatVersion, Name: "test", Distro: "k8s", Created: created}))
+	assert.NilError(t, writer.WriteCert("ca.crt", []byte("ca")))
+	assert.NilError(t, writer.WriteKeyValue([]byte("/registry/pods/default/a"), []byte{0x00, 0x01, 0xff}))
+	assert.NilError(t, writer.Close())
+
+	reader, err := NewReader(buffer)
+	assert.NilError(t, err)
+	defer reader.Close()
+
+	record, err := reader.Next()
+	assert.NilError(t, err)
+	assert.Equal(t, record.Type, RecordTypeMetadata)
+	assert.Equal(t, record.Metadata.Name, "test")
+	assert.Equal(t, record.Metadata.Distro, "k8s")
+	assert.Assert(t, record.Metadata.Created.Equal(created))
+
+	record, err = reader.Next()
+	assert.NilError(t, err)
+	assert.Equal(t, record.Type, RecordTypeCert)
+	assert.Equal(t, record.Name, "ca.crt")
+	assert.DeepEqual(t, record.Data, []byte("ca"))
+
+	record, err = reader.Next()
+	assert.NilError(t, err)
+	assert.Equal(t, record.Type, RecordTypeKeyValue)
+	assert.Equal(t, string(record.Key), "/registry/pods/default/a")
+	assert.DeepEqual(t, record.Data, []byte{0x00, 0x01, 0xff})
+
+	_, err = reader.Next()
+	assert.Assert(t, errors.Is(err, io.EOF))
+}
+
+func TestReaderInvalidArchive(t *testing.T) {
+	_, err := NewReader(bytes.NewBufferString("not a snapshot"))
+	assert.ErrorContains(t, err, "open snapshot")
+}
+
+func TestFilter(t *testing.T) {
+	input := &bytes.Buffer{}
+	writer := NewWriter(input)
+	assert.NilError(t, writer.WriteMetadata(&Metadata{Version: FormatVersion, Name: "test"}))
+	assert.NilError(t, writer.WriteCert("ca.crt", []byte("ca")))
+	assert.NilError(t, writer.WriteKeyValue([]byte("/registry/pods/default/a"), []byte("a")))
+	assert.NilError(t, writer.WriteKeyValue([]byte("/registry/secrets/default/b"), []byte("b")))
+	assert.NilError(t, writer.Close())
+
+	output := &bytes.Buffer{}
+	excluded, err := Filter(input, output, func(key, _ []byte) bool {
+		return bytes.HasPrefix(key, []byte("/registry/secrets/"))
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, excluded, 1)
+
+	reader, err := NewReader(output)
+	assert.NilError(t, err)
+	defer reader.Close()
+
+	types := []RecordType{}
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		assert.NilError(t, err)
+		types = append(types, record.Type)
+		if record.Type == RecordTypeKeyValue {
+			assert.Equal(t, string(record.Key), "/registry/pods/default/a")
+		}
+	}
+	assert.DeepEqual(t, types, []RecordType{RecordTypeMetadata, RecordTypeCert, RecordTypeKeyValue})
+}
@@ -0,0 +1,304 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	podtranslate "github.com/loft-sh/vcluster/pkg/controllers/resources/pods/translate"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	metricsv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
+)
+
+// Report is the host resource usage of a single virtual cluster
+type Report struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+
+	// MetricsAvailable is true if the actual usage could be retrieved from the host metrics api
+	MetricsAvailable bool `json:"metricsAvailable"`
+
+	// Total is the usage summed up over all virtual namespaces
+	Total Usage `json:"total"`
+
+	// Namespaces is the usage grouped by virtual namespace
+	Namespaces []NamespaceUsage `json:"namespaces,omitempty"`
+}
+
+type NamespaceUsage struct {
+	Namespace string `json:"namespace"`
+
+	Usage `json:",inline"`
+}
+
+// Usage is the host capacity consumed by synced objects
+type Usage struct {
+	Pods     int                 `json:"pods"`
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+	Limits   corev1.ResourceList `json:"limits,omitempty"`
+	Usage    corev1.ResourceList `json:"usage,omitempty"`
+
+	PersistentVolumeClaims int               `json:"persistentVolumeClaims"`
+	Storage                resource.Quantity `json:"storage"`
+
+	LoadBalancers int `json:"loadBalancers"`
+}
+
+// Options define which host objects belong to the virtual cluster
+type Options struct {
+	// Name is the name of the virtual cluster
+	Name string
+
+	// Namespace is the host namespace the virtual cluster is deployed in
+	Namespace string
+
+	// MultiNamespace is true if the virtual cluster syncs objects into multiple host namespaces
+	MultiNamespace bool
+}
+
+// Collect aggregates the host resources of all objects synced by the virtual cluster. The metrics client
+// is optional, if it is nil or the metrics api is not reachable the actual usage is omitted.
+func Collect(ctx context.Context, hostClient kubernetes.Interface, metricsClient metricsv1beta1.MetricsV1beta1Interface, options Options) (*Report, error) {
+	hostNamespaces, err := hostNamespaces(ctx, hostClient, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// single namespace mode shares the host namespace with other objects
+	listOptions := metav1.ListOptions{}
+	if !options.MultiNamespace {
+		listOptions.LabelSelector = labels.Set{translate.MarkerLabel: options.Name}.String()
+	}
+
+	report := &Report{
+		Name:             options.Name,
+		Namespace:        options.Namespace,
+		MetricsAvailable: metricsClient != nil,
+	}
+	namespaces := map[string]*Usage{}
+	getUsage := func(vNamespace string) *Usage {
+		if namespaces[vNamespace] == nil {
+			namespaces[vNamespace] = &Usage{}
+		}
+
+		return namespaces[vNamespace]
+	}
+
+	for _, hostNamespace := range hostNamespaces {
+		podList, err := hostClient.CoreV1().Pods(hostNamespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("list pods: %w", err)
+		}
+
+		podNamespaces := map[string]string{}
+		for _, pod := range podList.Items {
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+
+			vNamespace := pod.Annotations[podtranslate.NamespaceAnnotation]
+			if vNamespace == "" {
+				continue
+			}
+			podNamespaces[pod.Name] = vNamespace
+
+			vUsage := getUsage(vNamespace)
+			vUsage.Pods++
+			requests, limits := PodRequestsAndLimits(&pod)
+			vUsage.Requests = addResourceList(vUsage.Requests, requests)
+			vUsage.Limits = addResourceList(vUsage.Limits, limits)
+		}
+
+		if report.MetricsAvailable {
+			podMetricsList, err := metricsClient.PodMetricses(hostNamespace).List(ctx, listOptions)
+			if err != nil {
+				klog.FromContext(ctx).V(1).Info("Error retrieving pod metrics, skipping actual usage", "error", err)
+				report.MetricsAvailable = false
+			} else {
+				for _, podMetrics := range podMetricsList.Items {
+					vNamespace, ok := podNamespaces[podMetrics.Name]
+					if !ok {
+						continue
+					}
+
+					vUsage := getUsage(vNamespace)
+					for _, container := range podMetrics.Containers {
+						vUsage.Usage = addResourceList(vUsage.Usage, container.Usage)
+					}
+				}
+			}
+		}
+
+		pvcList, err := hostClient.CoreV1().PersistentVolumeClaims(hostNamespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("list persistent volume claims: %w", err)
+		}
+		for _, pvc := range pvcList.Items {
+			vNamespace := pvc.Annotations[translate.NamespaceAnnotation]
+			if vNamespace == "" {
+				continue
+			}
+
+			// prefer the actual capacity over the requested storage
+			storage, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+			if !ok {
+				storage = pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			}
+
+			vUsage := getUsage(vNamespace)
+			vUsage.PersistentVolumeClaims++
+			vUsage.Storage.Add(storage)
+		}
+
+		serviceList, err := hostClient.CoreV1().Services(hostNamespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("list services: %w", err)
+		}
+		for _, service := range serviceList.Items {
+			vNamespace := service.Annotations[translate.NamespaceAnnotation]
+			if vNamespace == "" || service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+				continue
+			}
+
+			getUsage(vNamespace).LoadBalancers++
+		}
+	}
+
+	for vNamespace, vUsage := range namespaces {
+		report.Namespaces = append(report.Namespaces, NamespaceUsage{
+			Namespace: vNamespace,
+			Usage:     *vUsage,
+		})
+		report.Total.add(vUsage)
+	}
+	sort.Slice(report.Namespaces, func(i, j int) bool {
+		return report.Namespaces[i].Namespace < report.Namespaces[j].Namespace
+	})
+	for i := range report.Namespaces {
+		report.Namespaces[i].Usage.Usage = usageOrNil(report.Namespaces[i].Usage.Usage, report.MetricsAvailable)
+	}
+	report.Total.Usage = usageOrNil(report.Total.Usage, report.MetricsAvailable)
+
+	return report, nil
+}
+
+// IsMultiNamespace checks if the given virtual cluster syncs into multiple host namespaces
+func IsMultiNamespace(ctx context.Context, hostClient kubernetes.Interface, name, namespace string) (bool, error) {
+	namespaceList, err := hostClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{translate.MarkerLabel: translate.SafeConcatName(namespace, "x", name)}.String(),
+		Limit:         1,
+	})
+	if kerrors.IsForbidden(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("list namespaces: %w", err)
+	}
+
+	return len(namespaceList.Items) > 0, nil
+}
+
+func hostNamespaces(ctx context.Context, hostClient kubernetes.Interface, options Options) ([]string, error) {
+	if !options.MultiNamespace {
+		return []string{options.Namespace}, nil
+	}
+
+	namespaceList, err := hostClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{translate.MarkerLabel: translate.SafeConcatName(options.Namespace, "x", options.Name)}.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list namespaces: %w", err)
+	}
+
+	retNamespaces := []string{}
+	for _, namespace := range namespaceList.Items {
+		retNamespaces = append(retNamespaces, namespace.Name)
+	}
+
+	return retNamespaces, nil
+}
+
+// PodRequestsAndLimits returns the effective requests and limits of a pod, which is the maximum of the
+// sum of all containers and any init container plus the pod overhead
+func PodRequestsAndLimits(pod *corev1.Pod) (corev1.ResourceList, corev1.ResourceList) {
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		requests = addResourceList(requests, container.Resources.Requests)
+		limits = addResourceList(limits, container.Resources.Limits)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		requests = maxResourceList(requests, container.Resources.Requests)
+		limits = maxResourceList(limits, container.Resources.Limits)
+	}
+	if pod.Spec.Overhead != nil {
+		requests = addResourceList(requests, pod.Spec.Overhead)
+		for name, quantity := range pod.Spec.Overhead {
+			// only add the overhead to limits that are set
+			if value, ok := limits[name]; ok {
+				value.Add(quantity)
+				limits[name] = value
+			}
+		}
+	}
+
+	return requests, limits
+}
+
+// usageOrNil returns nil if the metrics are not available to distinguish missing metrics from no usage
+func usageOrNil(list corev1.ResourceList, metricsAvailable bool) corev1.ResourceList {
+	if !metricsAvailable {
+		return nil
+	} else if list == nil {
+		return corev1.ResourceList{}
+	}
+
+	return list
+}
+
+func (u *Usage) add(other *Usage) {
+	u.Pods += other.Pods
+	u.Requests = addResourceList(u.Requests, other.Requests)
+	u.Limits = addResourceList(u.Limits, other.Limits)
+	u.Usage = addResourceList(u.Usage, other.Usage)
+	u.PersistentVolumeClaims += other.PersistentVolumeClaims
+	u.Storage.Add(other.Storage)
+	u.LoadBalancers += other.LoadBalancers
+}
+
+func addResourceList(list, other corev1.ResourceList) corev1.ResourceList {
+	if len(other) == 0 {
+		return list
+	} else if list == nil {
+		list = corev1.ResourceList{}
+	}
+
+	for name, quantity := range other {
+		value := list[name]
+		value.Add(quantity)
+		list[name] = value
+	}
+
+	return list
+}
+
+func maxResourceList(list, other corev1.ResourceList) corev1.ResourceList {
+	if len(other) == 0 {
+		return list
+	} else if list == nil {
+		list = corev1.ResourceList{}
+	}
+
+	for name, quantity := range other {
+		if value, ok := list[name]; !ok || quantity.Cmp(value) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+
+	return list
+}
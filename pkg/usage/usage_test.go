@@ -0,0 +1,114 @@
+package usage
+
+import (
+	"context"
+	"testing"
+
+	podtranslate "github.com/loft-sh/vcluster/pkg/controllers/resources/pods/translate"
+	"github.com/loft-sh/vcluster/pkg/util/translate"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPodRequestsAndLimits(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}}},
+			},
+			Containers: []corev1.Container{
+				{Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+				}},
+				{Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+				}},
+			},
+			Overhead: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+		},
+	}
+
+	requests, limits := PodRequestsAndLimits(pod)
+	assert.Equal(t, requests.Cpu().String(), "2")
+	assert.Equal(t, requests.Memory().String(), "320Mi")
+	assert.Equal(t, limits.Memory().String(), "320Mi")
+	_, ok := limits[corev1.ResourceCPU]
+	assert.Assert(t, !ok, "cpu limit should not be set")
+}
+
+func TestCollect(t *testing.T) {
+	managedLabels := map[string]string{translate.MarkerLabel: "my-vcluster"}
+	hostClient := fake.NewSimpleClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "a-x-default-x-my-vcluster",
+				Namespace:   "vcluster",
+				Labels:      managedLabels,
+				Annotations: map[string]string{podtranslate.NamespaceAnnotation: "default"},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}}},
+			}},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "b-x-default-x-my-vcluster",
+				Namespace:   "vcluster",
+				Labels:      managedLabels,
+				Annotations: map[string]string{podtranslate.NamespaceAnnotation: "default"},
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}}},
+			}},
+			Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "unmanaged",
+				Namespace: "vcluster",
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}}},
+			}},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "data-x-test-x-my-vcluster",
+				Namespace:   "vcluster",
+				Labels:      managedLabels,
+				Annotations: map[string]string{translate.NamespaceAnnotation: "test"},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5Gi")},
+			}},
+			Status: corev1.PersistentVolumeClaimStatus{Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")}},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "lb-x-test-x-my-vcluster",
+				Namespace:   "vcluster",
+				Labels:      managedLabels,
+				Annotations: map[string]string{translate.NamespaceAnnotation: "test"},
+			},
+			Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		},
+	)
+
+	report, err := Collect(context.Background(), hostClient, nil, Options{Name: "my-vcluster", Namespace: "vcluster"})
+	assert.NilError(t, err)
+	assert.Equal(t, report.MetricsAvailable, false)
+	assert.Equal(t, len(report.Namespaces), 2)
+	assert.Equal(t, report.Namespaces[0].Namespace, "default")
+	assert.Equal(t, report.Namespaces[0].Pods, 1)
+	assert.Equal(t, report.Namespaces[1].Namespace, "test")
+	assert.Equal(t, report.Namespaces[1].Storage.String(), "10Gi")
+	assert.Equal(t, report.Namespaces[1].LoadBalancers, 1)
+	assert.Equal(t, report.Total.Pods, 1)
+	assert.Equal(t, report.Total.Requests.Cpu().String(), "1")
+	assert.Assert(t, report.Total.Usage == nil)
+}
@@ -0,0 +1,173 @@
+package dryrunclient
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+type Cluster string
+
+const (
+	ClusterHost    Cluster = "host"
+	ClusterVirtual Cluster = "virtual"
+)
+
+type Operation string
+
+const (
+	OperationCreate      Operation = "create"
+	OperationUpdate      Operation = "update"
+	OperationPatch       Operation = "patch"
+	OperationDelete      Operation = "delete"
+	OperationDeleteAllOf Operation = "delete-all-of"
+)
+
+// Change is a write that was recorded instead of being sent to the api server
+type Change struct {
+	Cluster     Cluster
+	Operation   Operation
+	SubResource string
+	Object      client.Object
+}
+
+// Recorder collects the changes of all clients created with it
+type Recorder struct {
+	m       sync.Mutex
+	changes []Change
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Changes returns the recorded changes
+func (r *Recorder) Changes() []Change {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return append([]Change{}, r.changes...)
+}
+
+// Reset drops all recorded changes
+func (r *Recorder) Reset() {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.changes = nil
+}
+
+func (r *Recorder) record(cluster Cluster, operation Operation, subResource string, obj client.Object, scheme *runtime.Scheme) {
+	obj = obj.DeepCopyObject().(client.Object)
+	if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.changes = append(r.changes, Change{
+		Cluster:     cluster,
+		Operation:   operation,
+		SubResource: subResource,
+		Object:      obj,
+	})
+}
+
+// NewClientFactory returns a client factory whose clients read from the api server, but record writes
+func NewClientFactory(recorder *Recorder, cluster Cluster, delegate client.NewClientFunc) client.NewClientFunc {
+	return func(config *rest.Config, options client.Options) (client.Client, error) {
+		innerClient, err := delegate(config, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return WrapClient(recorder, cluster, innerClient), nil
+	}
+}
+
+func WrapClient(recorder *Recorder, cluster Cluster, innerClient client.Client) client.Client {
+	if innerClient == nil {
+		panic("nil innerClient")
+	}
+
+	return &Client{
+		Client: innerClient,
+
+		recorder: recorder,
+		cluster:  cluster,
+	}
+}
+
+// Client passes reads to the wrapped client and records Create/Update/Patch/Delete calls instead of executing them
+type Client struct {
+	client.Client
+
+	recorder *Recorder
+	cluster  Cluster
+}
+
+func (c *Client) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	c.recorder.record(c.cluster, OperationCreate, "", obj, c.Scheme())
+	return nil
+}
+
+func (c *Client) Update(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	c.recorder.record(c.cluster, OperationUpdate, "", obj, c.Scheme())
+	return nil
+}
+
+func (c *Client) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	c.recorder.record(c.cluster, OperationPatch, "", obj, c.Scheme())
+	return nil
+}
+
+func (c *Client) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	c.recorder.record(c.cluster, OperationDelete, "", obj, c.Scheme())
+	return nil
+}
+
+func (c *Client) DeleteAllOf(_ context.Context, obj client.Object, _ ...client.DeleteAllOfOption) error {
+	c.recorder.record(c.cluster, OperationDeleteAllOf, "", obj, c.Scheme())
+	return nil
+}
+
+func (c *Client) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *Client) SubResource(subResource string) client.SubResourceClient {
+	return &SubResourceClient{
+		SubResourceClient: c.Client.SubResource(subResource),
+
+		client:      c,
+		subResource: subResource,
+	}
+}
+
+// SubResourceClient passes reads to the wrapped client and records writes instead of executing them
+type SubResourceClient struct {
+	client.SubResourceClient
+
+	client      *Client
+	subResource string
+}
+
+func (c *SubResourceClient) Create(_ context.Context, obj client.Object, _ client.Object, _ ...client.SubResourceCreateOption) error {
+	c.client.recorder.record(c.client.cluster, OperationCreate, c.subResource, obj, c.client.Scheme())
+	return nil
+}
+
+func (c *SubResourceClient) Update(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+	c.client.recorder.record(c.client.cluster, OperationUpdate, c.subResource, obj, c.client.Scheme())
+	return nil
+}
+
+func (c *SubResourceClient) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+	c.client.recorder.record(c.client.cluster, OperationPatch, c.subResource, obj, c.client.Scheme())
+	return nil
+}
@@ -0,0 +1,49 @@
+package dryrunclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loft-sh/vcluster/pkg/scheme"
+	testingutil "github.com/loft-sh/vcluster/pkg/util/testing"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "test"}}
+	recorder := NewRecorder()
+	c := WrapClient(recorder, ClusterHost, testingutil.NewFakeClient(scheme.Scheme, existing))
+
+	// reads are passed through
+	err := c.Get(ctx, client.ObjectKeyFromObject(existing), &corev1.ConfigMap{})
+	assert.NilError(t, err)
+
+	// writes are recorded
+	created := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "created", Namespace: "test"}}
+	assert.NilError(t, c.Create(ctx, created))
+	assert.NilError(t, c.Status().Update(ctx, existing))
+	assert.NilError(t, c.Delete(ctx, existing))
+
+	err = c.Get(ctx, client.ObjectKeyFromObject(created), &corev1.ConfigMap{})
+	assert.Assert(t, kerrors.IsNotFound(err))
+	err = c.Get(ctx, client.ObjectKeyFromObject(existing), &corev1.ConfigMap{})
+	assert.NilError(t, err)
+
+	changes := recorder.Changes()
+	assert.Equal(t, len(changes), 3)
+	assert.Equal(t, changes[0].Operation, OperationCreate)
+	assert.Equal(t, changes[0].Cluster, ClusterHost)
+	assert.Equal(t, changes[0].Object.GetName(), "created")
+	assert.Equal(t, changes[0].Object.GetObjectKind().GroupVersionKind().Kind, "ConfigMap")
+	assert.Equal(t, changes[1].Operation, OperationUpdate)
+	assert.Equal(t, changes[1].SubResource, "status")
+	assert.Equal(t, changes[2].Operation, OperationDelete)
+
+	recorder.Reset()
+	assert.Equal(t, len(recorder.Changes()), 0)
+}
@@ -0,0 +1,144 @@
+package redact
+
+import (
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Redacted is the replacement for redacted values
+const Redacted = "REDACTED"
+
+// lastAppliedConfigAnnotation holds a full copy of an applied object and is dropped from all objects
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+var (
+	// DefaultKeyPatterns match keys whose string values are redacted in structured data
+	DefaultKeyPatterns = []string{
+		`(?i)password`,
+		`(?i)passwd`,
+		`(?i)token`,
+		`(?i)secret`,
+		`(?i)credential`,
+		`(?i)private.?key`,
+		`(?i)api.?key`,
+		`(?i)access.?key`,
+		`(?i)datasource`,
+	}
+
+	// DefaultValuePatterns match values that are redacted in any text, such as logs
+	DefaultValuePatterns = []string{
+		`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`,
+		`(?i)bearer [a-z0-9\-._~+/]+=*`,
+		`eyJ[a-zA-Z0-9_-]+\.eyJ[a-zA-Z0-9_-]+\.[a-zA-Z0-9_-]+`,
+	}
+)
+
+// Redactor removes sensitive information from structured data and text
+type Redactor struct {
+	keys   []*regexp.Regexp
+	values []*regexp.Regexp
+}
+
+// New creates a new redactor with the default patterns and the given additional key and value patterns
+func New(extraKeyPatterns, extraValuePatterns []string) (*Redactor, error) {
+	keys, err := compile(append(append([]string{}, DefaultKeyPatterns...), extraKeyPatterns...))
+	if err != nil {
+		return nil, fmt.Errorf("compile key patterns: %w", err)
+	}
+
+	values, err := compile(append(append([]string{}, DefaultValuePatterns...), extraValuePatterns...))
+	if err != nil {
+		return nil, fmt.Errorf("compile value patterns: %w", err)
+	}
+
+	return &Redactor{
+		keys:   keys,
+		values: values,
+	}, nil
+}
+
+// Text redacts all values matching the value patterns within the given text
+func (r *Redactor) Text(text []byte) []byte {
+	for _, value := range r.values {
+		text = value.ReplaceAll(text, []byte(Redacted))
+	}
+
+	return text
+}
+
+// Object redacts the scalar values of all keys matching the key patterns within the given decoded json or yaml.
+// Name value pairs, such as container environment variables, are redacted if their name matches a key pattern.
+// Last applied configuration annotations are removed, as they contain an unredacted copy of the object.
+func (r *Redactor) Object(obj interface{}) interface{} {
+	switch typedObj := obj.(type) {
+	case map[string]interface{}:
+		if annotations, ok := typedObj["annotations"].(map[string]interface{}); ok {
+			delete(annotations, lastAppliedConfigAnnotation)
+		}
+		if name, ok := typedObj["name"].(string); ok && r.matchesKey(name) {
+			if _, ok := typedObj["value"].(string); ok {
+				typedObj["value"] = Redacted
+			}
+		}
+
+		for key, value := range typedObj {
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				typedObj[key] = r.Object(value)
+			case nil, bool:
+			default:
+				if r.matchesKey(key) {
+					typedObj[key] = Redacted
+				}
+			}
+		}
+	case []interface{}:
+		for i := range typedObj {
+			typedObj[i] = r.Object(typedObj[i])
+		}
+	}
+
+	return obj
+}
+
+// YAML redacts the given yaml document by key and value patterns
+func (r *Redactor) YAML(data []byte) ([]byte, error) {
+	var obj interface{}
+	err := yaml.Unmarshal(data, &obj)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := yaml.Marshal(r.Object(obj))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Text(out), nil
+}
+
+func (r *Redactor) matchesKey(key string) bool {
+	for _, pattern := range r.keys {
+		if pattern.MatchString(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func compile(patterns []string) ([]*regexp.Regexp, error) {
+	retPatterns := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pattern, err)
+		}
+
+		retPatterns = append(retPatterns, compiled)
+	}
+
+	return retPatterns, nil
+}
@@ -0,0 +1,254 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc"
+)
+
+// StartEtcd starts an in-memory server that speaks the etcd kv api and returns a client connected to it.
+// The server keeps the full revision history, so reads at older revisions, ranges, limits and
+// transactions behave like they do against etcd or kine. The returned func stops client and server.
+func StartEtcd() (*clientv3.Client, func(), error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, nil, fmt.Errorf("listen: %w", err)
+	}
+
+	server := grpc.NewServer()
+	etcdserverpb.RegisterKVServer(server, &etcdServer{history: map[string][]mvccpb.KeyValue{}})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	etcdClient, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{listener.Addr().String()},
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		server.Stop()
+		return nil, nil, fmt.Errorf("create etcd client: %w", err)
+	}
+
+	return etcdClient, func() {
+		_ = etcdClient.Close()
+		server.Stop()
+	}, nil
+}
+
+type etcdServer struct {
+	etcdserverpb.UnimplementedKVServer
+
+	m        sync.Mutex
+	revision int64
+
+	// history holds all revisions of a key, a revision with version 0 marks a deletion
+	history map[string][]mvccpb.KeyValue
+}
+
+func (s *etcdServer) Range(_ context.Context, req *etcdserverpb.RangeRequest) (*etcdserverpb.RangeResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return s.rangeLocked(req), nil
+}
+
+func (s *etcdServer) Put(_ context.Context, req *etcdserverpb.PutRequest) (*etcdserverpb.PutResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.revision++
+	s.putLocked(req)
+	return &etcdserverpb.PutResponse{Header: s.header()}, nil
+}
+
+func (s *etcdServer) DeleteRange(_ context.Context, req *etcdserverpb.DeleteRangeRequest) (*etcdserverpb.DeleteRangeResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if len(s.matchingKeys(req.Key, req.RangeEnd, 0)) > 0 {
+		s.revision++
+	}
+	deleted := s.deleteLocked(req)
+	return &etcdserverpb.DeleteRangeResponse{Header: s.header(), Deleted: deleted}, nil
+}
+
+func (s *etcdServer) Txn(_ context.Context, req *etcdserverpb.TxnRequest) (*etcdserverpb.TxnResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	succeeded := true
+	for _, compare := range req.Compare {
+		if !s.compareLocked(compare) {
+			succeeded = false
+			break
+		}
+	}
+
+	ops := req.Success
+	if !succeeded {
+		ops = req.Failure
+	}
+
+	// all writes within a transaction share a single revision
+	revision := s.revision
+	s.revision++
+	wrote := false
+	responses := make([]*etcdserverpb.ResponseOp, 0, len(ops))
+	for _, op := range ops {
+		switch typedOp := op.Request.(type) {
+		case *etcdserverpb.RequestOp_RequestRange:
+			responses = append(responses, &etcdserverpb.ResponseOp{Response: &etcdserverpb.ResponseOp_ResponseRange{ResponseRange: s.rangeLocked(typedOp.RequestRange)}})
+		case *etcdserverpb.RequestOp_RequestPut:
+			wrote = true
+			s.putLocked(typedOp.RequestPut)
+			responses = append(responses, &etcdserverpb.ResponseOp{Response: &etcdserverpb.ResponseOp_ResponsePut{ResponsePut: &etcdserverpb.PutResponse{Header: s.header()}}})
+		case *etcdserverpb.RequestOp_RequestDeleteRange:
+			deleted := s.deleteLocked(typedOp.RequestDeleteRange)
+			wrote = wrote || deleted > 0
+			responses = append(responses, &etcdserverpb.ResponseOp{Response: &etcdserverpb.ResponseOp_ResponseDeleteRange{ResponseDeleteRange: &etcdserverpb.DeleteRangeResponse{Header: s.header(), Deleted: deleted}}})
+		default:
+			return nil, fmt.Errorf("unsupported txn operation %T", op.Request)
+		}
+	}
+	if !wrote {
+		s.revision = revision
+	}
+
+	return &etcdserverpb.TxnResponse{Header: s.header(), Succeeded: succeeded, Responses: responses}, nil
+}
+
+func (s *etcdServer) header() *etcdserverpb.ResponseHeader {
+	return &etcdserverpb.ResponseHeader{Revision: s.revision}
+}
+
+func (s *etcdServer) rangeLocked(req *etcdserverpb.RangeRequest) *etcdserverpb.RangeResponse {
+	keys := s.matchingKeys(req.Key, req.RangeEnd, req.Revision)
+	resp := &etcdserverpb.RangeResponse{Header: s.header(), Count: int64(len(keys))}
+	if req.CountOnly {
+		return resp
+	}
+	if req.Limit > 0 && int64(len(keys)) > req.Limit {
+		keys = keys[:req.Limit]
+		resp.More = true
+	}
+
+	for _, key := range keys {
+		kv, _ := s.get(key, req.Revision)
+		if req.KeysOnly {
+			kv.Value = nil
+		}
+		resp.Kvs = append(resp.Kvs, &kv)
+	}
+
+	return resp
+}
+
+func (s *etcdServer) putLocked(req *etcdserverpb.PutRequest) {
+	key := string(req.Key)
+	kv, found := s.get(key, 0)
+	if !found {
+		kv = mvccpb.KeyValue{Key: req.Key, CreateRevision: s.revision}
+	}
+
+	kv.ModRevision = s.revision
+	kv.Version++
+	kv.Value = req.Value
+	s.history[key] = append(s.history[key], kv)
+}
+
+func (s *etcdServer) deleteLocked(req *etcdserverpb.DeleteRangeRequest) int64 {
+	keys := s.matchingKeys(req.Key, req.RangeEnd, 0)
+	for _, key := range keys {
+		s.history[key] = append(s.history[key], mvccpb.KeyValue{Key: []byte(key), ModRevision: s.revision})
+	}
+
+	return int64(len(keys))
+}
+
+func (s *etcdServer) compareLocked(compare *etcdserverpb.Compare) bool {
+	kv, _ := s.get(string(compare.Key), 0)
+
+	var result int
+	switch compare.Target {
+	case etcdserverpb.Compare_VERSION:
+		result = compareInt(kv.Version, compare.GetVersion())
+	case etcdserverpb.Compare_CREATE:
+		result = compareInt(kv.CreateRevision, compare.GetCreateRevision())
+	case etcdserverpb.Compare_MOD:
+		result = compareInt(kv.ModRevision, compare.GetModRevision())
+	case etcdserverpb.Compare_VALUE:
+		result = bytes.Compare(kv.Value, compare.GetValue())
+	default:
+		return false
+	}
+
+	switch compare.Result {
+	case etcdserverpb.Compare_EQUAL:
+		return result == 0
+	case etcdserverpb.Compare_NOT_EQUAL:
+		return result != 0
+	case etcdserverpb.Compare_GREATER:
+		return result > 0
+	case etcdserverpb.Compare_LESS:
+		return result < 0
+	}
+
+	return false
+}
+
+// get returns the key at the given revision or the latest revision if revision is 0
+func (s *etcdServer) get(key string, revision int64) (mvccpb.KeyValue, bool) {
+	history := s.history[key]
+	for i := len(history) - 1; i >= 0; i-- {
+		if revision > 0 && history[i].ModRevision > revision {
+			continue
+		} else if history[i].Version == 0 {
+			return mvccpb.KeyValue{}, false
+		}
+
+		return history[i], true
+	}
+
+	return mvccpb.KeyValue{}, false
+}
+
+// matchingKeys returns the sorted keys that exist at the given revision within [key, rangeEnd)
+func (s *etcdServer) matchingKeys(key, rangeEnd []byte, revision int64) []string {
+	keys := []string{}
+	for candidate := range s.history {
+		if len(rangeEnd) == 0 {
+			if candidate != string(key) {
+				continue
+			}
+		} else if candidate < string(key) || (!bytes.Equal(rangeEnd, []byte{0}) && candidate >= string(rangeEnd)) {
+			continue
+		}
+
+		if _, found := s.get(candidate, revision); found {
+			keys = append(keys, candidate)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+func compareInt(a, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+
+	return 0
+}
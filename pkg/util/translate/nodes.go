@@ -0,0 +1,170 @@
+package translate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PrivateNodePrefix is the prefix of node names within the virtual cluster if node privacy is enabled
+const PrivateNodePrefix = "node-"
+
+// Nodes translates host node names to the node names used within the virtual cluster, usually set at start time
+var Nodes NodeTranslator = &mirrorNodeTranslator{}
+
+type NodeTranslator interface {
+	// Private returns true if host node names and addresses should be hidden from the virtual cluster
+	Private() bool
+
+	// VirtualName returns the name of the given host node within the virtual cluster
+	VirtualName(hostName string) string
+
+	// HostName returns the name of the host node for the given virtual node name. Returns false if the node is unknown.
+	HostName(virtualName string) (string, bool)
+}
+
+// NewPrivateNodeTranslator creates a new node translator that hides host node names behind hashed aliases. The salt
+// makes sure that the same host node has different aliases in different virtual clusters.
+func NewPrivateNodeTranslator(salt string) NodeTranslator {
+	return &privateNodeTranslator{
+		salt:      salt,
+		hostNames: map[string]string{},
+	}
+}
+
+type mirrorNodeTranslator struct{}
+
+func (m *mirrorNodeTranslator) Private() bool {
+	return false
+}
+
+func (m *mirrorNodeTranslator) VirtualName(hostName string) string {
+	return hostName
+}
+
+func (m *mirrorNodeTranslator) HostName(virtualName string) (string, bool) {
+	return virtualName, true
+}
+
+type privateNodeTranslator struct {
+	salt string
+
+	hostNamesMutex sync.RWMutex
+	hostNames      map[string]string
+}
+
+func (p *privateNodeTranslator) Private() bool {
+	return true
+}
+
+func (p *privateNodeTranslator) VirtualName(hostName string) string {
+	if hostName == "" {
+		return ""
+	}
+
+	digest := sha256.Sum256([]byte(p.salt + "/" + hostName))
+	virtualName := PrivateNodePrefix + hex.EncodeToString(digest[0:])[0:16]
+
+	// remember the host name, so we can translate the virtual name back
+	p.hostNamesMutex.RLock()
+	_, ok := p.hostNames[virtualName]
+	p.hostNamesMutex.RUnlock()
+	if !ok {
+		p.hostNamesMutex.Lock()
+		p.hostNames[virtualName] = hostName
+		p.hostNamesMutex.Unlock()
+	}
+
+	return virtualName
+}
+
+func (p *privateNodeTranslator) HostName(virtualName string) (string, bool) {
+	p.hostNamesMutex.RLock()
+	defer p.hostNamesMutex.RUnlock()
+
+	hostName, ok := p.hostNames[virtualName]
+	return hostName, ok
+}
+
+// RegisterHostNodes registers the virtual names of all host nodes, so that virtual node names can be translated back
+// right after a restart, before the host nodes are seen again by the syncers. If vCluster is not allowed to list the
+// host nodes, the names are only registered as the syncers encounter them.
+func RegisterHostNodes(ctx context.Context, kubeClient kubernetes.Interface) error {
+	if !Nodes.Private() {
+		return nil
+	}
+
+	nodeList, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if kerrors.IsForbidden(err) {
+		klog.FromContext(ctx).Info("Not allowed to list host nodes, virtual node names will be resolved as host nodes are synced")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, node := range nodeList.Items {
+		Nodes.VirtualName(node.Name)
+	}
+
+	return nil
+}
+
+// HostHostname returns the kubernetes.io/hostname label of the host node behind the given virtual node name. If node
+// privacy is enabled, the label is replaced with the virtual node name within the virtual cluster, so selectors on it
+// need to be translated before they reach the host cluster. Returns false if the node is unknown.
+func HostHostname(ctx context.Context, pClient client.Client, virtualName string) (string, bool) {
+	hostName, ok := Nodes.HostName(virtualName)
+	if !ok {
+		return "", false
+	} else if !Nodes.Private() {
+		return hostName, true
+	}
+
+	// the hostname label usually equals the node name, so fall back to it if we cannot retrieve the node
+	pNode := &corev1.Node{}
+	err := pClient.Get(ctx, client.ObjectKey{Name: hostName}, pNode)
+	if err != nil || pNode.Labels[corev1.LabelHostname] == "" {
+		return hostName, true
+	}
+
+	return pNode.Labels[corev1.LabelHostname], true
+}
+
+// HostNodeSelector translates the values of all kubernetes.io/hostname requirements within the given node selector
+// to the host hostnames. Unknown values are kept, as they do not match any node in the virtual cluster either.
+func HostNodeSelector(ctx context.Context, pClient client.Client, nodeSelector *corev1.NodeSelector) {
+	if nodeSelector == nil || !Nodes.Private() {
+		return
+	}
+
+	for i := range nodeSelector.NodeSelectorTerms {
+		HostNodeSelectorRequirements(ctx, pClient, nodeSelector.NodeSelectorTerms[i].MatchExpressions)
+	}
+}
+
+// HostNodeSelectorRequirements translates the values of all kubernetes.io/hostname requirements to the host hostnames
+func HostNodeSelectorRequirements(ctx context.Context, pClient client.Client, requirements []corev1.NodeSelectorRequirement) {
+	if !Nodes.Private() {
+		return
+	}
+
+	for i := range requirements {
+		if requirements[i].Key != corev1.LabelHostname {
+			continue
+		}
+
+		for j, value := range requirements[i].Values {
+			if hostname, ok := HostHostname(ctx, pClient, value); ok {
+				requirements[i].Values[j] = hostname
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package translate
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRegisterHostNodes(t *testing.T) {
+	oldNodes := Nodes
+	defer func() { Nodes = oldNodes }()
+
+	// a restarted vCluster has to resolve virtual node names it didn't translate yet
+	virtualName := NewPrivateNodeTranslator("vcluster/test").VirtualName("host-node")
+	Nodes = NewPrivateNodeTranslator("vcluster/test")
+	_, ok := Nodes.HostName(virtualName)
+	assert.Assert(t, !ok)
+
+	kubeClient := fake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "host-node"}})
+	err := RegisterHostNodes(context.Background(), kubeClient)
+	assert.NilError(t, err)
+
+	hostName, ok := Nodes.HostName(virtualName)
+	assert.Assert(t, ok)
+	assert.Equal(t, hostName, "host-node")
+}
@@ -213,6 +213,16 @@ func applyMaps(fromMap map[string]string, toMap map[string]string, opts ApplyMap
 }
 
 func EnsureCRDFromPhysicalCluster(ctx context.Context, pConfig *rest.Config, vConfig *rest.Config, groupVersionKind schema.GroupVersionKind) (bool, bool, error) {
+	return ensureCRDFromPhysicalCluster(ctx, pConfig, vConfig, groupVersionKind, true)
+}
+
+// DescribeCRDFromPhysicalCluster returns if the given kind is cluster scoped and has a status subresource the same
+// way EnsureCRDFromPhysicalCluster does, but without creating a missing CRD in the virtual cluster.
+func DescribeCRDFromPhysicalCluster(ctx context.Context, pConfig *rest.Config, vConfig *rest.Config, groupVersionKind schema.GroupVersionKind) (bool, bool, error) {
+	return ensureCRDFromPhysicalCluster(ctx, pConfig, vConfig, groupVersionKind, false)
+}
+
+func ensureCRDFromPhysicalCluster(ctx context.Context, pConfig *rest.Config, vConfig *rest.Config, groupVersionKind schema.GroupVersionKind, create bool) (bool, bool, error) {
 	var isClusterScoped, hasStatusSubresource bool
 
 	vClient, err := apiextensionsv1clientset.NewForConfig(vConfig)
@@ -297,6 +307,9 @@ func EnsureCRDFromPhysicalCluster(ctx context.Context, pConfig *rest.Config, vCo
 		}
 	}
 	crdDefinition.Spec.Versions = newVersions
+	if !create {
+		return crdDefinition.Spec.Scope == apiextensionsv1.ClusterScoped, hasStatusSubresource, nil
+	}
 
 	// apply the crd
 	klog.FromContext(ctx).Info("Create crd in virtual cluster", "crd", groupVersionKind.String())